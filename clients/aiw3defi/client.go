@@ -6,194 +6,107 @@ import (
 	"math/big"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/crypto/hd"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	"github.com/cosmos/go-bip39"
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
 )
 
 // AIW3DefiClientFactory creates instances of AIW3DefiClient for load testing
 type AIW3DefiClientFactory struct {
-	txConfig client.TxConfig
+	cosmostx.Factory[Params]
 }
 
-var _ loadtest.ClientFactory = (*AIW3DefiClientFactory)(nil)
+var (
+	_ loadtest.ClientFactory             = (*AIW3DefiClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*AIW3DefiClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*AIW3DefiClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*AIW3DefiClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*AIW3DefiClientFactory)(nil)
+)
 
-// NewAIW3DefiClientFactory creates a new factory for AIW3 DeFi clients
-func NewAIW3DefiClientFactory(txConfig client.TxConfig) *AIW3DefiClientFactory {
-	return &AIW3DefiClientFactory{
-		txConfig: txConfig,
-	}
+// NewAIW3DefiClientFactory creates a new factory for AIW3 DeFi clients that
+// sign with the given funded accounts, with the given default parameters
+func NewAIW3DefiClientFactory(txConfig client.TxConfig, accounts cosmostx.AccountConfig, params Params) *AIW3DefiClientFactory {
+	f := &AIW3DefiClientFactory{}
+	f.Factory = cosmostx.NewFactory[Params](f, txConfig, accounts, params)
+	return f
 }
 
 // AIW3DefiClient generates bank send transactions for load testing
 type AIW3DefiClient struct {
-	txConfig      client.TxConfig
-	chainID       string
-	denom         string
+	*cosmostx.Sender
+	params         Params
+	gasLimit       uint64
 	transferAmount sdk.Int
-	senderKey     cryptotypes.PrivKey
-	senderAddr    sdk.AccAddress
-	recipientAddr sdk.AccAddress
-	accountNumber uint64
-	sequence      uint64
-}
-
-var _ loadtest.Client = (*AIW3DefiClient)(nil)
-
-func (f *AIW3DefiClientFactory) ValidateConfig(cfg loadtest.Config) error {
-	// Validate that the configuration is compatible with AIW3 DeFi
-	if cfg.Connections <= 0 {
-		return fmt.Errorf("connections must be > 0")
-	}
-	if cfg.Rate <= 0 {
-		return fmt.Errorf("rate must be > 0")
-	}
-	return nil
+	recipientAddr  sdk.AccAddress
 }
 
-func (f *AIW3DefiClientFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
-	// Generate a random mnemonic for this client
-	entropy, err := bip39.NewEntropy(256)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate entropy: %w", err)
-	}
-	
-	mnemonic, err := bip39.NewMnemonic(entropy)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate mnemonic: %w", err)
-	}
+var (
+	_ loadtest.Client                 = (*AIW3DefiClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*AIW3DefiClient)(nil)
+)
 
-	// Derive sender private key directly from mnemonic
-	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, "", "m/44'/118'/0'/0/0")
+// NewClientWithParams creates a client with the factory's parameters
+// overridden by params
+func (f *AIW3DefiClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to derive sender private key: %w", err)
+		return nil, err
 	}
 
-	senderKey := hd.Secp256k1.Generate()(derivedPriv)
-	senderAddr := sdk.AccAddress(senderKey.PubKey().Address())
-
-	// Generate recipient address from different mnemonic
-	recipientEntropy, err := bip39.NewEntropy(256)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate recipient entropy: %w", err)
-	}
-	
-	recipientMnemonic, err := bip39.NewMnemonic(recipientEntropy)
+	// Clients share the accounts round-robin, and each sends to the next
+	// account so that the funds stay within the pool
+	sender, err := f.NewSender(cfg, p.TxParams)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate recipient mnemonic: %w", err)
+		return nil, err
 	}
 
-	// Derive recipient private key
-	recipientDerivedPriv, err := hd.Secp256k1.Derive()(recipientMnemonic, "", "m/44'/118'/0'/0/1")
-	if err != nil {
-		return nil, fmt.Errorf("failed to derive recipient private key: %w", err)
-	}
-
-	recipientKey := hd.Secp256k1.Generate()(recipientDerivedPriv)
-	recipientAddr := sdk.AccAddress(recipientKey.PubKey().Address())
-
-	// Random transfer amount between 1000 and 10000 uaiw (0.001 to 0.01 AIW)
-	randomAmount, err := rand.Int(rand.Reader, big.NewInt(9001))
+	// Random transfer amount between the minimum and the maximum
+	randomAmount, err := rand.Int(rand.Reader, big.NewInt(p.MaxAmount-p.MinAmount+1))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate random amount: %w", err)
 	}
-	transferAmount := sdk.NewInt(randomAmount.Int64() + 1000)
+	transferAmount := sdk.NewInt(randomAmount.Int64() + p.MinAmount)
 
-	return &AIW3DefiClient{
-		txConfig:       f.txConfig,
-		chainID:        "aiw3defi-devnet", // Default chain ID
-		denom:          "uaiw",            // AIW3 DeFi token
+	c := &AIW3DefiClient{
+		Sender:         sender,
+		params:         p,
+		gasLimit:       p.GasLimit,
 		transferAmount: transferAmount,
-		senderKey:      senderKey,
-		senderAddr:     senderAddr,
-		recipientAddr:  recipientAddr,
-		accountNumber:  0, // Will be set from actual account info
-		sequence:       0, // Will be incremented for each transaction
-	}, nil
+		recipientAddr:  sender.Pool.After(sender.Account).Address(),
+	}
+	if p.SimulateGas {
+		c.gasLimit, err = c.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+			return c.signSend(accountNumber, sequence, p.GasLimit)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
 }
 
 // GenerateTx creates a bank send transaction for load testing
 func (c *AIW3DefiClient) GenerateTx() ([]byte, error) {
+	// Reserve a sequence, shared with the other clients of the sender
+	accountNumber, sequence := c.Account.NextSequence()
+	return c.signSend(accountNumber, sequence, c.gasLimit)
+}
+
+// signSend signs a bank send transaction with the given sequence
+func (c *AIW3DefiClient) signSend(accountNumber, sequence, gasLimit uint64) ([]byte, error) {
 	// Create bank send message
 	msg := banktypes.NewMsgSend(
-		c.senderAddr,
+		c.Account.Address(),
 		c.recipientAddr,
-		sdk.NewCoins(sdk.NewCoin(c.denom, c.transferAmount)),
+		sdk.NewCoins(sdk.NewCoin(c.params.Denom, c.transferAmount)),
 	)
 
-	// Create transaction builder
-	txBuilder := c.txConfig.NewTxBuilder()
-	
-	// Set messages
-	if err := txBuilder.SetMsgs(msg); err != nil {
-		return nil, fmt.Errorf("failed to set messages: %w", err)
-	}
-
-	// Set gas limit and fee
-	gasLimit := uint64(200000) // Standard gas limit for bank send
-	gasPrice := sdk.NewDecWithPrec(1, 3) // 0.001 uaiw per gas
-	feeAmount := gasPrice.MulInt64(int64(gasLimit)).TruncateInt()
-	fee := sdk.NewCoins(sdk.NewCoin(c.denom, feeAmount))
-	
-	txBuilder.SetGasLimit(gasLimit)
-	txBuilder.SetFeeAmount(fee)
-
 	// Set memo for identification
-	txBuilder.SetMemo(fmt.Sprintf("LoadTest:%s", c.senderAddr.String()[:8]))
-
-	// Create signature data
-	sigV2 := signing.SignatureV2{
-		PubKey: c.senderKey.PubKey(),
-		Data: &signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: nil,
-		},
-		Sequence: c.sequence,
-	}
-
-	// Set the signature (empty for now)
-	if err := txBuilder.SetSignatures(sigV2); err != nil {
-		return nil, fmt.Errorf("failed to set signatures: %w", err)
-	}
-
-	// Create signing data
-	signMode := c.txConfig.SignModeHandler().DefaultMode()
-	signerData := authsigning.SignerData{
-		ChainID:       c.chainID,
-		AccountNumber: c.accountNumber,
-		Sequence:      c.sequence,
-	}
-
-	// Sign the transaction
-	signBytes, err := c.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get sign bytes: %w", err)
-	}
-
-	signature, err := c.senderKey.Sign(signBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
-	}
-
-	// Update signature with actual signature
-	sigV2.Data.(*signing.SingleSignatureData).Signature = signature
-	if err := txBuilder.SetSignatures(sigV2); err != nil {
-		return nil, fmt.Errorf("failed to set final signatures: %w", err)
-	}
+	memo := fmt.Sprintf("LoadTest:%s", c.Account.String()[:8])
 
-	// Increment sequence for next transaction
-	c.sequence++
-
-	// Encode transaction to bytes
-	txBytes, err := c.txConfig.TxEncoder()(txBuilder.GetTx())
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode transaction: %w", err)
-	}
-
-	return txBytes, nil
-}
\ No newline at end of file
+	return c.Sign(accountNumber, sequence, []sdk.Msg{msg}, gasLimit, memo)
+}
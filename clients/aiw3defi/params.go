@@ -0,0 +1,83 @@
+package aiw3defi
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// Params configure the transactions AIW3 DeFi clients generate. They can be
+// overridden for a load test by the client parameters of cosmostx.TxParams,
+// min_amount and max_amount.
+type Params struct {
+	cosmostx.TxParams
+	// Every client transfers a random amount in this range, inclusive, in
+	// the fee denom
+	MinAmount int64
+	MaxAmount int64
+}
+
+// DefaultParams returns the parameters of the AIW3 DeFi devnet.
+func DefaultParams() Params {
+	return Params{
+		TxParams: cosmostx.TxParams{
+			ChainID:       "aiw3defi-devnet",
+			Denom:         "uaiw",
+			GasLimit:      200000,
+			GasPrice:      sdk.NewDecWithPrec(1, 3),
+			GasAdjustment: 1.3,
+		},
+		MinAmount: 1000,
+		MaxAmount: 10000,
+	}
+}
+
+// RegisterFlags binds the parameters to flags prefixed with aiw3defi-, with
+// the current values as defaults.
+func (p *Params) RegisterFlags(fs *flag.FlagSet) {
+	p.TxParams.RegisterFlags(fs, "aiw3defi", "aiw3defi")
+	fs.Int64Var(&p.MinAmount, "aiw3defi-min-amount", p.MinAmount, "Minimum amount of aiw3defi transfers")
+	fs.Int64Var(&p.MaxAmount, "aiw3defi-max-amount", p.MaxAmount, "Maximum amount of aiw3defi transfers")
+}
+
+// Validate checks that the parameters make valid transactions.
+func (p Params) Validate() error {
+	if err := p.TxParams.Validate(); err != nil {
+		return err
+	}
+	if p.MinAmount <= 0 {
+		return fmt.Errorf("min_amount must be > 0")
+	}
+	if p.MaxAmount < p.MinAmount {
+		return fmt.Errorf("max_amount must be >= min_amount")
+	}
+	return nil
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	for name, value := range params {
+		var err error
+		switch name {
+		case "min_amount":
+			p.MinAmount, err = strconv.ParseInt(value, 10, 64)
+		case "max_amount":
+			p.MaxAmount, err = strconv.ParseInt(value, 10, 64)
+		default:
+			var ok bool
+			if ok, err = p.TxParams.Override(name, value); !ok {
+				return p, fmt.Errorf("unknown parameter %q", name)
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
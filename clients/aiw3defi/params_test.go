@@ -0,0 +1,40 @@
+package aiw3defi
+
+import (
+	"testing"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+func TestParamsWithOverrides(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        hybridloadtest.ClientParams
+		wantMinAmount int64
+		wantMaxAmount int64
+		wantDenom     string
+		wantErr       bool
+	}{
+		{"defaults", nil, 1000, 10000, "uaiw", false},
+		{"amounts", hybridloadtest.ClientParams{"min_amount": "5", "max_amount": "5"}, 5, 5, "uaiw", false},
+		{"transaction parameters", hybridloadtest.ClientParams{"denom": "stake"}, 1000, 10000, "stake", false},
+		{"zero min amount", hybridloadtest.ClientParams{"min_amount": "0"}, 0, 0, "", true},
+		{"max amount below min amount", hybridloadtest.ClientParams{"max_amount": "999"}, 0, 0, "", true},
+		{"invalid amount", hybridloadtest.ClientParams{"min_amount": "lots"}, 0, 0, "", true},
+		{"invalid transaction parameter", hybridloadtest.ClientParams{"gas_limit": "0"}, 0, 0, "", true},
+		{"unknown parameter", hybridloadtest.ClientParams{"amount": "1"}, 0, 0, "", true},
+	}
+	for _, tt := range tests {
+		got, err := DefaultParams().WithOverrides(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: WithOverrides() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got.MinAmount != tt.wantMinAmount || got.MaxAmount != tt.wantMaxAmount || got.Denom != tt.wantDenom {
+			t.Errorf("%s: WithOverrides() = %d-%d%s, want %d-%d%s", tt.name, got.MinAmount, got.MaxAmount, got.Denom, tt.wantMinAmount, tt.wantMaxAmount, tt.wantDenom)
+		}
+	}
+}
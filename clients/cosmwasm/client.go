@@ -0,0 +1,120 @@
+package cosmwasm
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// CosmWasmClientFactory creates clients that execute or instantiate
+// CosmWasm contracts
+type CosmWasmClientFactory struct {
+	cosmostx.Factory[Params]
+}
+
+var (
+	_ loadtest.ClientFactory             = (*CosmWasmClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*CosmWasmClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*CosmWasmClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*CosmWasmClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*CosmWasmClientFactory)(nil)
+)
+
+// NewCosmWasmClientFactory creates a factory for clients that sign with the
+// given funded accounts, with the given default parameters
+func NewCosmWasmClientFactory(txConfig client.TxConfig, accounts cosmostx.AccountConfig, params Params) *CosmWasmClientFactory {
+	f := &CosmWasmClientFactory{}
+	f.Factory = cosmostx.NewFactory[Params](f, txConfig, accounts, params)
+	return f
+}
+
+// CosmWasmClient generates contract transactions whose message is rendered
+// from the template for each transaction
+type CosmWasmClient struct {
+	*cosmostx.Sender
+	params    Params
+	gasLimit  uint64
+	tmpl      *template.Template
+	counter   uint64
+	recipient *cosmostx.Account
+}
+
+var (
+	_ loadtest.Client                 = (*CosmWasmClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*CosmWasmClient)(nil)
+)
+
+// NewClientWithParams creates a client with the factory's parameters
+// overridden by params
+func (f *CosmWasmClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := p.template()
+	if err != nil {
+		return nil, err
+	}
+	sender, err := f.NewSender(cfg, p.TxParams)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CosmWasmClient{
+		Sender:    sender,
+		params:    p,
+		gasLimit:  p.GasLimit,
+		tmpl:      tmpl,
+		recipient: sender.Pool.After(sender.Account),
+	}
+	if p.SimulateGas {
+		c.gasLimit, err = c.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+			return c.sign(accountNumber, sequence, p.GasLimit)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// GenerateTx creates a contract transaction with a freshly rendered message
+func (c *CosmWasmClient) GenerateTx() ([]byte, error) {
+	// Reserve a sequence, shared with the other clients of the sender
+	accountNumber, sequence := c.Account.NextSequence()
+	tx, err := c.sign(accountNumber, sequence, c.gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	c.counter++
+	return tx, nil
+}
+
+// sign renders the message with the given sequence and signs a transaction
+// with it
+func (c *CosmWasmClient) sign(accountNumber, sequence, gasLimit uint64) ([]byte, error) {
+	msgJSON, err := render(c.tmpl, cosmostx.TemplateVars{
+		Sender:    c.Account.String(),
+		Recipient: c.recipient.String(),
+		Sequence:  sequence,
+		Counter:   c.counter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if c.params.Action == actionInstantiate {
+		msg = instantiateContract(c.Account, c.params, msgJSON)
+	} else {
+		msg = executeContract(c.Account, c.params, msgJSON)
+	}
+	memo := fmt.Sprintf("LoadTest:%s", c.params.Action)
+	return c.Sign(accountNumber, sequence, []sdk.Msg{msg}, gasLimit, memo)
+}
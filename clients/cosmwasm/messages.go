@@ -0,0 +1,71 @@
+package cosmwasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// The type URLs of wasmd's messages, whose Go types aren't dependencies
+const (
+	msgExecuteContractTypeURL     = "/cosmwasm.wasm.v1.MsgExecuteContract"
+	msgInstantiateContractTypeURL = "/cosmwasm.wasm.v1.MsgInstantiateContract"
+)
+
+// executeContract encodes a MsgExecuteContract of the contract with the JSON
+// message.
+func executeContract(sender *cosmostx.Account, p Params, msgJSON []byte) sdk.Msg {
+	var value []byte
+	value = appendString(value, 1, sender.String())
+	value = appendString(value, 2, p.Contract)
+	value = appendBytes(value, 3, msgJSON)
+	value = appendCoins(value, 5, p.Funds)
+	return &cosmostx.RawMsg{
+		TypeURL: msgExecuteContractTypeURL,
+		Value:   value,
+		Signers: []sdk.AccAddress{sender.Address()},
+	}
+}
+
+// instantiateContract encodes a MsgInstantiateContract of the code with the
+// JSON message.
+func instantiateContract(sender *cosmostx.Account, p Params, msgJSON []byte) sdk.Msg {
+	var value []byte
+	value = appendString(value, 1, sender.String())
+	value = appendString(value, 2, p.Admin)
+	value = protowire.AppendTag(value, 3, protowire.VarintType)
+	value = protowire.AppendVarint(value, p.CodeID)
+	value = appendString(value, 4, p.Label)
+	value = appendBytes(value, 5, msgJSON)
+	value = appendCoins(value, 6, p.Funds)
+	return &cosmostx.RawMsg{
+		TypeURL: msgInstantiateContractTypeURL,
+		Value:   value,
+		Signers: []sdk.AccAddress{sender.Address()},
+	}
+}
+
+// appendString appends a string field, unless it is empty like proto3 does.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// appendCoins appends a repeated Coin field.
+func appendCoins(b []byte, num protowire.Number, coins sdk.Coins) []byte {
+	for _, coin := range coins {
+		// Marshaling a Coin can't fail
+		bz, _ := coin.Marshal()
+		b = appendBytes(b, num, bz)
+	}
+	return b
+}
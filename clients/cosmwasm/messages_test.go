@@ -0,0 +1,116 @@
+package cosmwasm
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// decodeFields decodes the fields of an encoded message, concatenating the
+// string values of repeated fields with commas.
+func decodeFields(t *testing.T, msg sdk.Msg) map[protowire.Number]any {
+	t.Helper()
+	value := msg.(*cosmostx.RawMsg).Value
+	fields := make(map[protowire.Number]any)
+	for len(value) > 0 {
+		num, typ, n := protowire.ConsumeTag(value)
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		value = value[n:]
+		switch typ {
+		case protowire.BytesType:
+			var b []byte
+			b, n = protowire.ConsumeBytes(value)
+			if prev, ok := fields[num]; ok {
+				fields[num] = prev.(string) + "," + string(b)
+			} else {
+				fields[num] = string(b)
+			}
+		case protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(value)
+			fields[num] = v
+		default:
+			t.Fatalf("unexpected wire type %d", typ)
+		}
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		value = value[n:]
+	}
+	return fields
+}
+
+func marshalCoin(t *testing.T, coin sdk.Coin) string {
+	t.Helper()
+	bz, err := coin.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bz)
+}
+
+func TestContractMessages(t *testing.T) {
+	pool, err := cosmostx.NewAccountPool(cosmostx.AccountConfig{Mnemonic: testMnemonic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := pool.Accounts()[0]
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ucosm", 2))
+	msgJSON := []byte(`{"increment": {}}`)
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		typeURL string
+		want    map[protowire.Number]any
+	}{
+		{
+			"execute",
+			executeContract(sender, Params{Contract: "wasm1contract", Funds: funds}, msgJSON),
+			msgExecuteContractTypeURL,
+			map[protowire.Number]any{
+				1: sender.String(),
+				2: "wasm1contract",
+				3: string(msgJSON),
+				5: marshalCoin(t, funds[0]) + "," + marshalCoin(t, funds[1]),
+			},
+		},
+		{
+			"instantiate",
+			instantiateContract(sender, Params{CodeID: 3, Label: "loadtest"}, msgJSON),
+			msgInstantiateContractTypeURL,
+			// No admin and no funds
+			map[protowire.Number]any{
+				1: sender.String(),
+				3: uint64(3),
+				4: "loadtest",
+				5: string(msgJSON),
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.msg.(*cosmostx.RawMsg).TypeURL; got != tt.typeURL {
+			t.Errorf("%s: type URL = %s, want %s", tt.name, got, tt.typeURL)
+		}
+		if signers := tt.msg.GetSigners(); len(signers) != 1 || !signers[0].Equals(sender.Address()) {
+			t.Errorf("%s: signers = %v, want [%s]", tt.name, signers, sender)
+		}
+		fields := decodeFields(t, tt.msg)
+		if len(fields) != len(tt.want) {
+			t.Errorf("%s: fields = %q, want %q", tt.name, fields, tt.want)
+		}
+		for num, want := range tt.want {
+			if fields[num] != want {
+				t.Errorf("%s: field %d = %q, want %q", tt.name, num, fields[num], want)
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package cosmwasm
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// The actions clients can take on contracts
+const (
+	actionExecute     = "execute"
+	actionInstantiate = "instantiate"
+)
+
+// Params configure the contract messages CosmWasm clients generate. Besides
+// the client parameters of cosmostx.TxParams, they can be overridden for a
+// load test by the client parameters action, contract, msg, msg_file, funds,
+// code_id, label and admin.
+type Params struct {
+	cosmostx.TxParams
+	// Whether to execute the contract, the default, or to instantiate code
+	Action string
+	// The address of the contract to execute
+	Contract string
+	// The template of the JSON message, or the file holding it. See
+	// cosmostx.TemplateVars for what templates can use.
+	Msg     string
+	MsgFile string
+	// The coins sent along with each message
+	Funds sdk.Coins
+	// The code to instantiate, the label of the instances and their admin
+	CodeID uint64
+	Label  string
+	Admin  string
+}
+
+// DefaultParams returns the parameters of a chain started with wasmd's
+// defaults.
+func DefaultParams() Params {
+	return Params{
+		TxParams: cosmostx.TxParams{
+			ChainID:       "testing",
+			Denom:         "stake",
+			GasLimit:      500000,
+			GasPrice:      sdk.ZeroDec(),
+			GasAdjustment: 1.3,
+		},
+		Action: actionExecute,
+		Label:  "loadtest",
+	}
+}
+
+// RegisterFlags binds the parameters to flags prefixed with wasm-, with the
+// current values as defaults.
+func (p *Params) RegisterFlags(fs *flag.FlagSet) {
+	p.TxParams.RegisterFlags(fs, "wasm", "cosmwasm")
+	fs.StringVar(&p.Contract, "wasm-contract", p.Contract, "Address of the contract cosmwasm transactions execute")
+	fs.StringVar(&p.Msg, "wasm-msg", p.Msg, "Template of the JSON message of cosmwasm transactions")
+	fs.StringVar(&p.MsgFile, "wasm-msg-file", p.MsgFile, "File holding the template of the JSON message of cosmwasm transactions, instead of --wasm-msg")
+	fs.Func("wasm-funds", "Coins sent along with each cosmwasm message, e.g. 10stake", func(s string) error {
+		funds, err := sdk.ParseCoinsNormalized(s)
+		if err != nil {
+			return err
+		}
+		p.Funds = funds
+		return nil
+	})
+}
+
+// Validate checks that the parameters make valid transactions, including
+// that the message template renders to JSON.
+func (p Params) Validate() error {
+	if err := p.TxParams.Validate(); err != nil {
+		return err
+	}
+	switch p.Action {
+	case actionExecute:
+		if p.Contract == "" {
+			return fmt.Errorf("contract is required to execute")
+		}
+	case actionInstantiate:
+		if p.CodeID == 0 {
+			return fmt.Errorf("code_id is required to instantiate")
+		}
+		if p.Label == "" {
+			return fmt.Errorf("label is required to instantiate")
+		}
+	default:
+		return fmt.Errorf("action must be %s or %s", actionExecute, actionInstantiate)
+	}
+	tmpl, err := p.template()
+	if err != nil {
+		return err
+	}
+	_, err = render(tmpl, cosmostx.TemplateVars{Sender: "sender", Recipient: "recipient"})
+	return err
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	_, hasMsg := params["msg"]
+	_, hasMsgFile := params["msg_file"]
+	if hasMsg && hasMsgFile {
+		return p, fmt.Errorf("set msg or msg_file, not both")
+	}
+	for name, value := range params {
+		var err error
+		switch name {
+		case "action":
+			p.Action = value
+		case "contract":
+			p.Contract = value
+		case "msg":
+			p.Msg, p.MsgFile = value, ""
+		case "msg_file":
+			p.Msg, p.MsgFile = "", value
+		case "funds":
+			p.Funds, err = sdk.ParseCoinsNormalized(value)
+		case "code_id":
+			p.CodeID, err = strconv.ParseUint(value, 10, 64)
+		case "label":
+			p.Label = value
+		case "admin":
+			p.Admin = value
+		default:
+			var ok bool
+			if ok, err = p.TxParams.Override(name, value); !ok {
+				return p, fmt.Errorf("unknown parameter %q", name)
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
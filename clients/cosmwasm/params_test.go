@@ -0,0 +1,59 @@
+package cosmwasm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+func TestParamsWithOverrides(t *testing.T) {
+	msgFile := filepath.Join(t.TempDir(), "msg.json")
+	if err := os.WriteFile(msgFile, []byte(`{"mint": {"owner": "{{.Sender}}"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		params  hybridloadtest.ClientParams
+		check   func(p Params) bool
+		wantErr bool
+	}{
+		{"execute", hybridloadtest.ClientParams{"contract": "wasm1contract"}, func(p Params) bool { return p.Action == actionExecute && p.Contract == "wasm1contract" }, false},
+		{
+			"execute with funds",
+			hybridloadtest.ClientParams{"contract": "wasm1contract", "msg": `{"deposit": {}}`, "funds": "10stake"},
+			func(p Params) bool { return p.Msg == `{"deposit": {}}` && p.Funds.String() == "10stake" },
+			false,
+		},
+		{"message file", hybridloadtest.ClientParams{"contract": "wasm1contract", "msg_file": msgFile}, func(p Params) bool { return p.MsgFile == msgFile && p.Msg == "" }, false},
+		{
+			"instantiate",
+			hybridloadtest.ClientParams{"action": "instantiate", "code_id": "3", "admin": "wasm1admin"},
+			func(p Params) bool { return p.CodeID == 3 && p.Label == "loadtest" && p.Admin == "wasm1admin" },
+			false,
+		},
+		{"transaction parameters", hybridloadtest.ClientParams{"contract": "wasm1contract", "gas_limit": "900000"}, func(p Params) bool { return p.GasLimit == 900000 }, false},
+		{"execute without contract", nil, nil, true},
+		{"instantiate without code", hybridloadtest.ClientParams{"action": "instantiate"}, nil, true},
+		{"instantiate without label", hybridloadtest.ClientParams{"action": "instantiate", "code_id": "3", "label": ""}, nil, true},
+		{"unknown action", hybridloadtest.ClientParams{"action": "migrate", "contract": "wasm1contract"}, nil, true},
+		{"msg and msg_file", hybridloadtest.ClientParams{"contract": "wasm1contract", "msg": "{}", "msg_file": msgFile}, nil, true},
+		{"missing message file", hybridloadtest.ClientParams{"contract": "wasm1contract", "msg_file": filepath.Join(t.TempDir(), "missing.json")}, nil, true},
+		{"template not JSON", hybridloadtest.ClientParams{"contract": "wasm1contract", "msg": "{{.Sender}}"}, nil, true},
+		{"unknown template variable", hybridloadtest.ClientParams{"contract": "wasm1contract", "msg": `{"owner": "{{.Owner}}"}`}, nil, true},
+		{"invalid funds", hybridloadtest.ClientParams{"contract": "wasm1contract", "funds": "ten"}, nil, true},
+		{"unknown parameter", hybridloadtest.ClientParams{"contract": "wasm1contract", "sender": "wasm1sender"}, nil, true},
+	}
+	for _, tt := range tests {
+		got, err := DefaultParams().WithOverrides(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: WithOverrides() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && !tt.check(got) {
+			t.Errorf("%s: WithOverrides() = %+v", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package cosmwasm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// template parses the message template, read from MsgFile if it is set. An
+// empty template renders the empty JSON object. Templates render with
+// cosmostx.TemplateVars and can call cosmostx.TemplateFuncs.
+func (p Params) template() (*template.Template, error) {
+	text := p.Msg
+	if p.MsgFile != "" {
+		blob, err := os.ReadFile(p.MsgFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message template: %w", err)
+		}
+		text = string(blob)
+	}
+	if text == "" {
+		text = "{}"
+	}
+	tmpl, err := template.New("msg").Funcs(cosmostx.TemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// render renders the message template, which must produce JSON.
+func render(tmpl *template.Template, vars cosmostx.TemplateVars) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return nil, fmt.Errorf("failed to render message template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("message template rendered invalid JSON: %s", buf.Bytes())
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+package cosmwasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+func TestRender(t *testing.T) {
+	vars := cosmostx.TemplateVars{Sender: "wasm1sender", Recipient: "wasm1recipient", Sequence: 4, Counter: 2}
+	tests := []struct {
+		msg     string
+		want    string
+		wantErr bool
+	}{
+		{"", `{}`, false},
+		{`{"transfer": {"recipient": "{{.Recipient}}"}}`, `{"transfer": {"recipient": "wasm1recipient"}}`, false},
+		{`{"mint": {"owner": "{{.Sender}}", "token_id": "{{.Sequence}}-{{.Counter}}"}}`, `{"mint": {"owner": "wasm1sender", "token_id": "4-2"}}`, false},
+		{`{"amount": "{{randInt 5 5}}"}`, `{"amount": "5"}`, false},
+		{`{"amount": "{{randInt 9 1}}"}`, `{"amount": "9"}`, false},
+		{`{{.Sender}}`, "", true},
+		{`{"owner": "{{.Owner}}"}`, "", true},
+	}
+	for _, tt := range tests {
+		tmpl, err := Params{Msg: tt.msg}.template()
+		if err != nil {
+			t.Fatalf("template() of %q error: %v", tt.msg, err)
+		}
+		got, err := render(tmpl, vars)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("render(%q) error = %v, wantErr %t", tt.msg, err, tt.wantErr)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("render(%q) = %s, want %s", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestRenderRandInt(t *testing.T) {
+	tmpl, err := Params{Msg: `{"amount": {{randInt 1 3}}}`}.template()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		got, err := render(tmpl, cosmostx.TemplateVars{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var msg struct{ Amount int64 }
+		if err := json.Unmarshal(got, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Amount < 1 || msg.Amount > 3 {
+			t.Fatalf("randInt 1 3 = %d, want it in [1, 3]", msg.Amount)
+		}
+	}
+}
+
+func TestTemplateInvalid(t *testing.T) {
+	if _, err := (Params{Msg: `{"amount": {{randInt 1}`}).template(); err == nil {
+		t.Errorf("template() of an unterminated action succeeded, want an error")
+	}
+}
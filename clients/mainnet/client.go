@@ -0,0 +1,353 @@
+package mainnet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// MainnetClientFactory creates clients that replay the transactions of a
+// production chain, pulled from its blocks or exported to a JSONL file
+type MainnetClientFactory struct {
+	cosmostx.Factory[Params]
+	cdc    *codec.ProtoCodec
+	decode sdk.TxDecoder
+
+	mtx sync.Mutex
+	// The transactions of each source, loaded once
+	sources map[string]*source
+	// The transactions the clients of the current load test share, and how
+	// many clients were created for it
+	feed        *feed
+	feedClients int
+}
+
+var (
+	_ loadtest.ClientFactory             = (*MainnetClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*MainnetClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*MainnetClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*MainnetClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*MainnetClientFactory)(nil)
+)
+
+// NewMainnetClientFactory creates a factory for clients that replay
+// transactions, re-signing them with the given funded accounts if enabled,
+// with the given default parameters
+func NewMainnetClientFactory(txConfig client.TxConfig, accounts cosmostx.AccountConfig, params Params) *MainnetClientFactory {
+	// Re-signing decodes the messages, which needs their types
+	cdc := codec.NewProtoCodec(cosmostx.NewInterfaceRegistry())
+	f := &MainnetClientFactory{
+		cdc:     cdc,
+		decode:  authtx.NewTxConfig(cdc, authtx.DefaultSignModes).TxDecoder(),
+		sources: make(map[string]*source),
+	}
+	f.Factory = cosmostx.NewFactory[Params](f, txConfig, accounts, params)
+	return f
+}
+
+// source holds the transactions of a source, and those of them that can be
+// re-signed once decoded
+type source struct {
+	txs        []RecordedTx
+	decodeOnce sync.Once
+	decoded    []decodedTx
+}
+
+// MainnetClient sends the next transaction of the feed it shares with the
+// other clients of the load test, once it is due
+type MainnetClient struct {
+	// Signs with the account the signer of the last re-signed transaction
+	// is mapped to
+	*cosmostx.Sender
+	params Params
+	feed   *feed
+}
+
+var (
+	_ loadtest.Client                 = (*MainnetClient)(nil)
+	_ hybridloadtest.PacedClient      = (*MainnetClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*MainnetClient)(nil)
+)
+
+// ValidateParams validates the configuration along with the factory's
+// parameters overridden by params, and loads the transactions of the source
+// they set
+func (f *MainnetClientFactory) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	if cfg.Connections <= 0 {
+		return fmt.Errorf("connections must be > 0")
+	}
+	if cfg.Rate <= 0 {
+		return fmt.Errorf("rate must be > 0")
+	}
+	p, err := f.Params(params)
+	if err != nil {
+		return err
+	}
+	src, err := f.source(p)
+	if err != nil {
+		return err
+	}
+	if !p.Resign {
+		return nil
+	}
+	if _, err := f.Signers().Pool(); err != nil {
+		return err
+	}
+	if len(f.decoded(src)) == 0 {
+		return fmt.Errorf("none of the %d transactions can be re-signed", len(src.txs))
+	}
+	return nil
+}
+
+// FundAccounts funds one sender account per connection from the first
+// configured account, which the factory's clients re-sign with until the
+// teardown
+func (f *MainnetClientFactory) FundAccounts(cfg loadtest.Config, params hybridloadtest.ClientParams, funding hybridloadtest.FundingConfig) (hybridloadtest.Teardown, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Resign {
+		return nil, fmt.Errorf("only re-signed transactions can be sent by funded accounts")
+	}
+	return f.Signers().Fund(cfg, p.TxParams, funding)
+}
+
+// ChainID returns the chain ID re-signed transactions are signed for, or an
+// empty one when transactions are replayed as they were signed
+func (f *MainnetClientFactory) ChainID(params hybridloadtest.ClientParams) (string, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return "", err
+	}
+	if !p.Resign {
+		return "", nil
+	}
+	return p.ChainID, nil
+}
+
+// PoolSize returns the number of accounts re-signed transactions are signed
+// with, or 0 when transactions are replayed as they were signed
+func (f *MainnetClientFactory) PoolSize(params hybridloadtest.ClientParams) (int, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return 0, err
+	}
+	if !p.Resign {
+		return 0, nil
+	}
+	return f.Factory.PoolSize(params)
+}
+
+// source returns the transactions of the source the parameters set, loading
+// them the first time
+func (f *MainnetClientFactory) source(p Params) (*source, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if src, ok := f.sources[p.source()]; ok {
+		return src, nil
+	}
+	txs, err := Load(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions from %s: %w", p.source(), err)
+	}
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("no transactions in %s", p.source())
+	}
+	src := &source{txs: txs}
+	f.sources[p.source()] = src
+	return src, nil
+}
+
+// decoded returns the transactions of the source that can be re-signed,
+// decoding them the first time
+func (f *MainnetClientFactory) decoded(src *source) []decodedTx {
+	src.decodeOnce.Do(func() {
+		var skipped int
+		src.decoded, skipped = decodeTxs(f.cdc, f.decode, src.txs)
+		if skipped > 0 {
+			logrus.Warnf("Skipping %d of %d transactions that can't be re-signed, with several signers or messages of unknown types", skipped, len(src.txs))
+		}
+	})
+	return src.decoded
+}
+
+// NewClientWithParams creates a client with the factory's parameters
+// overridden by params. The clients of a load test, one per connection,
+// share a feed of the source's transactions in their original order, so the
+// first client of each load test starts a new one.
+func (f *MainnetClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	src, err := f.source(p)
+	if err != nil {
+		return nil, err
+	}
+	var pool *cosmostx.AccountPool
+	if p.Resign {
+		if pool, err = f.Signers().ClientPool(); err != nil {
+			return nil, err
+		}
+	}
+	c := &MainnetClient{
+		Sender: cosmostx.NewSender(f.TxConfig(), p.TxParams, pool, nil, cfg.Endpoints),
+		params: p,
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.feed == nil || f.feedClients >= len(cfg.Endpoints)*cfg.Connections {
+		if p.Resign {
+			f.feed, err = f.resignedFeed(p, src, pool, cfg.Endpoints)
+		} else {
+			f.feed = newFeed(src.txs, p.Speed)
+		}
+		if err != nil {
+			f.feed = nil
+			return nil, err
+		}
+		f.feedClients = 0
+	}
+	f.feedClients++
+	c.feed = f.feed
+	return c, nil
+}
+
+// resignedFeed maps the original signers to the pool's accounts, refreshes
+// the mapped accounts and rewrites the messages of the transactions for them
+func (f *MainnetClientFactory) resignedFeed(p Params, src *source, pool *cosmostx.AccountPool, endpoints []string) (*feed, error) {
+	decoded := f.decoded(src)
+	keys := newKeyMap(decoded, pool.Accounts())
+	refreshed := make(map[*cosmostx.Account]bool)
+	for _, acc := range keys.accounts {
+		if refreshed[acc] {
+			continue
+		}
+		if err := pool.Refresh(endpoints, acc); err != nil {
+			return nil, err
+		}
+		refreshed[acc] = true
+	}
+
+	txs := make([]feedTx, 0, len(decoded))
+	for _, d := range decoded {
+		msgs, err := keys.msgs(f.cdc, d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewrite the messages of transaction %d: %w", d.index, err)
+		}
+		txs = append(txs, feedTx{
+			time:     src.txs[d.index].Time,
+			msgs:     msgs,
+			signer:   keys.account(d.signer),
+			gasLimit: d.gasLimit,
+			memo:     d.memo,
+		})
+	}
+	return &feed{txs: txs, speed: p.Speed}, nil
+}
+
+// GenerateTx returns the next transaction of the feed once it is due.
+func (c *MainnetClient) GenerateTx() ([]byte, error) {
+	tx, due, err := c.GenerateTxDue()
+	if err != nil {
+		return nil, err
+	}
+	time.Sleep(time.Until(due))
+	return tx, nil
+}
+
+// GenerateTxDue returns the next transaction of the feed and the time it is
+// due, as it was signed or re-signed with the account its signer is mapped
+// to. Re-signed transactions keep their messages, gas limit and memo, and pay
+// the fee of the test chain. Running out of transactions fails the load test.
+func (c *MainnetClient) GenerateTxDue() ([]byte, time.Time, error) {
+	tx, due, err := c.feed.next()
+	if err != nil {
+		return nil, due, err
+	}
+	if tx.raw != nil {
+		return tx.raw, due, nil
+	}
+
+	gasLimit := tx.gasLimit
+	if gasLimit == 0 {
+		gasLimit = c.params.GasLimit
+	}
+	c.Account = tx.signer
+	signed, err := c.Sign(tx.accountNumber, tx.sequence, tx.msgs, gasLimit, tx.memo)
+	return signed, due, err
+}
+
+// feedTx is a transaction of a feed, either as it was signed or ready to be
+// re-signed.
+type feedTx struct {
+	time     time.Time
+	raw      []byte
+	msgs     []sdk.Msg
+	signer   *cosmostx.Account
+	gasLimit uint64
+	memo     string
+	// The signer's account number and the sequence reserved for the
+	// transaction when the feed handed it out
+	accountNumber uint64
+	sequence      uint64
+}
+
+// feed hands out transactions in order, along with the time each is due: the
+// time between its block and the first transaction's block, divided by the
+// speed, after the first transaction was handed out. Re-signed transactions
+// get the next sequence of their signer as they are handed out, so that
+// sequences follow the order of the feed.
+type feed struct {
+	txs   []feedTx
+	speed float64
+
+	mtx   sync.Mutex
+	n     int
+	start time.Time
+}
+
+func newFeed(txs []RecordedTx, speed float64) *feed {
+	f := &feed{txs: make([]feedTx, len(txs)), speed: speed}
+	for i, tx := range txs {
+		f.txs[i] = feedTx{time: tx.Time, raw: tx.Tx}
+	}
+	return f
+}
+
+// next returns the next transaction and the time it is due, the zero time if
+// transactions are not paced.
+func (f *feed) next() (feedTx, time.Time, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.n == len(f.txs) {
+		return feedTx{}, time.Time{}, fmt.Errorf("all %d transactions were replayed", len(f.txs))
+	}
+	tx := f.txs[f.n]
+	f.n++
+	if f.start.IsZero() {
+		f.start = time.Now()
+	}
+	if tx.signer != nil {
+		tx.accountNumber, tx.sequence = tx.signer.NextSequence()
+	}
+
+	var due time.Time
+	first := f.txs[0].time
+	if f.speed > 0 && !first.IsZero() && !tx.time.IsZero() {
+		due = f.start.Add(time.Duration(float64(tx.time.Sub(first)) / f.speed))
+	}
+	return tx, due, nil
+}
@@ -0,0 +1,216 @@
+package mainnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// newTestNode starts a JSON-RPC server that answers account queries through
+// abci_query with the given account number.
+func newTestNode(t *testing.T, number uint64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "abci_query" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		account, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{AccountNumber: number})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		value, err := (&authtypes.QueryAccountResponse{Account: account}).Marshal()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"response": map[string]any{"code": 0, "value": value}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFeedPacing(t *testing.T) {
+	txs := []RecordedTx{
+		{Time: blockTime, Tx: []byte("0")},
+		{Time: blockTime.Add(time.Second), Tx: []byte("1")},
+		{Time: blockTime.Add(2 * time.Second), Tx: []byte("2")},
+	}
+	tests := []struct {
+		name  string
+		speed float64
+		// How long after the first transaction each is due, or -1 if it is
+		// due right away
+		wantDue []time.Duration
+	}{
+		{"as fast as possible", 0, []time.Duration{-1, -1, -1}},
+		{"original pace", 1, []time.Duration{0, time.Second, 2 * time.Second}},
+		{"ten times faster", 10, []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond}},
+	}
+	for _, tt := range tests {
+		f := newFeed(txs, tt.speed)
+		var first time.Time
+		for i := range txs {
+			tx, due, err := f.next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(tx.raw) != string(txs[i].Tx) {
+				t.Errorf("%s: transaction %d = %s, want %s", tt.name, i, tx.raw, txs[i].Tx)
+			}
+			if i == 0 {
+				first = due
+			}
+			if tt.wantDue[i] < 0 {
+				if !due.IsZero() {
+					t.Errorf("%s: transaction %d is due at %s, want right away", tt.name, i, due)
+				}
+			} else if got := due.Sub(first); got != tt.wantDue[i] {
+				t.Errorf("%s: transaction %d is due %s after the first, want %s", tt.name, i, got, tt.wantDue[i])
+			}
+		}
+		if _, _, err := f.next(); err == nil {
+			t.Errorf("%s: next() after the last transaction succeeded", tt.name)
+		}
+	}
+}
+
+func TestFeedSequences(t *testing.T) {
+	accounts := newOriginalAccounts(t, 2)
+	first, second := accounts[0], accounts[1]
+	f := &feed{txs: []feedTx{{signer: first}, {signer: second}, {signer: first}, {signer: first}}}
+	// Sequences are reserved as transactions are handed out, in feed order
+	want := []struct {
+		signer   *cosmostx.Account
+		sequence uint64
+	}{
+		{first, 0},
+		{second, 0},
+		{first, 1},
+		{first, 2},
+	}
+	for i, w := range want {
+		tx, _, err := f.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tx.signer != w.signer || tx.sequence != w.sequence {
+			t.Errorf("transaction %d is signed by %s with sequence %d, want %s with %d", i, tx.signer, tx.sequence, w.signer, w.sequence)
+		}
+	}
+}
+
+func TestMainnetClient(t *testing.T) {
+	originals := newOriginalAccounts(t, 3)
+	txs := originalTxs(t, originals)
+	file := filepath.Join(t.TempDir(), "txs.jsonl")
+	if err := WriteJSONL(file, txs); err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestNode(t, 4)
+	cfg := loadtest.Config{Endpoints: []string{srv.URL}, Connections: 2, Rate: 10}
+	txConfig := newTestTxConfig()
+	f := NewMainnetClientFactory(txConfig, cosmostx.AccountConfig{Mnemonic: testMnemonic, Accounts: 2}, DefaultParams(testTxParams))
+
+	t.Run("as signed", func(t *testing.T) {
+		params := hybridloadtest.ClientParams{"file": file, "speed": "0"}
+		if err := f.ValidateParams(cfg, params); err != nil {
+			t.Fatal(err)
+		}
+		if chainID, err := f.ChainID(params); err != nil || chainID != "" {
+			t.Errorf("ChainID() = %q, %v, want none", chainID, err)
+		}
+		// The clients of a load test share the feed
+		var clients []loadtest.Client
+		for i := 0; i < cfg.Connections; i++ {
+			client, err := f.NewClientWithParams(cfg, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			clients = append(clients, client)
+		}
+		for i, want := range txs {
+			tx, err := clients[i%2].GenerateTx()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(tx) != string(want.Tx) {
+				t.Errorf("transaction %d isn't replayed as it was signed", i)
+			}
+		}
+	})
+
+	t.Run("resigned", func(t *testing.T) {
+		params := hybridloadtest.ClientParams{"file": file, "speed": "0", "resign": "true"}
+		if err := f.ValidateParams(cfg, params); err != nil {
+			t.Fatal(err)
+		}
+		if chainID, err := f.ChainID(params); err != nil || chainID != "testing" {
+			t.Errorf("ChainID() = %q, %v, want testing", chainID, err)
+		}
+		client, err := f.NewClientWithParams(cfg, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := f.Signers().Pool()
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, second := pool.Accounts()[0], pool.Accounts()[1]
+
+		// The transaction that can't be decoded is skipped
+		for i, signer := range []*cosmostx.Account{first, second} {
+			txBytes, err := client.GenerateTx()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := txConfig.TxDecoder()(txBytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tx := decoded.(authsigning.Tx)
+			if signers := tx.GetSigners(); len(signers) != 1 || !signers[0].Equals(signer.Address()) {
+				t.Errorf("transaction %d is signed by %v, want %s", i, signers, signer)
+			}
+			if tx.GetGas() != 150000 || tx.GetMemo() != "original memo" || !tx.GetFee().IsZero() {
+				t.Errorf("transaction %d has gas %d, memo %q and fee %s, want the original gas and memo, and no fee", i, tx.GetGas(), tx.GetMemo(), tx.GetFee())
+			}
+			if client.(*MainnetClient).Account != signer {
+				t.Errorf("transaction %d: the sender's account isn't the last signer", i)
+			}
+			if i == 0 {
+				if send := tx.GetMsgs()[0].(*banktypes.MsgSend); send.ToAddress != first.String() {
+					t.Errorf("re-signed send = %v, want to %s", send, first)
+				}
+			}
+		}
+		if _, err := client.GenerateTx(); err == nil {
+			t.Errorf("GenerateTx() after the last transaction succeeded")
+		}
+	})
+
+	if _, err := f.FundAccounts(cfg, hybridloadtest.ClientParams{"file": file}, hybridloadtest.FundingConfig{Amount: "1stake"}); err == nil {
+		t.Errorf("FundAccounts() without re-signing succeeded")
+	}
+}
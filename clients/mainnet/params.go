@@ -0,0 +1,121 @@
+package mainnet
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// Params configure where replayed transactions come from and how they are
+// paced and signed. Besides the client parameters of cosmostx.TxParams, which
+// apply to re-signed transactions, they can be overridden for a load test by
+// the client parameters rpc, from_height, to_height, file, speed and resign.
+type Params struct {
+	cosmostx.TxParams
+	// The archive node whose blocks FromHeight to ToHeight, inclusive, hold
+	// the transactions, or else the JSONL file they were exported to
+	RPC        string
+	FromHeight int64
+	ToHeight   int64
+	File       string
+	// How many times faster than the original chain the transactions are
+	// sent, by block time. 0 sends them as fast as the rate allows.
+	Speed float64
+	// Whether to re-sign the transactions for the test chain, with the
+	// accounts the original signers are mapped to
+	Resign bool
+}
+
+// DefaultParams returns parameters that replay transactions as they were
+// signed at their original pace, re-signing them with the given transaction
+// parameters if enabled.
+func DefaultParams(txParams cosmostx.TxParams) Params {
+	return Params{
+		TxParams: txParams,
+		Speed:    1,
+	}
+}
+
+// RegisterFlags binds the parameters of the source, pacing and signing to
+// flags prefixed with mainnet-, with the current values as defaults.
+func (p *Params) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&p.RPC, "mainnet-rpc", p.RPC, "HTTP RPC endpoint of the archive node to pull replayed transactions from")
+	fs.Int64Var(&p.FromHeight, "mainnet-from-height", p.FromHeight, "First block to pull replayed transactions from")
+	fs.Int64Var(&p.ToHeight, "mainnet-to-height", p.ToHeight, "Last block to pull replayed transactions from")
+	fs.StringVar(&p.File, "mainnet-txs", p.File, "JSONL file of exported transactions to replay, instead of pulling them from blocks")
+	fs.Float64Var(&p.Speed, "mainnet-speed", p.Speed, "How many times faster than the original blocks to replay transactions (0 for as fast as the rate allows)")
+	fs.BoolVar(&p.Resign, "mainnet-resign", p.Resign, "Re-sign replayed transactions for the test chain with the test-cosmos-client-factory accounts")
+}
+
+// Validate checks that the parameters set one source of transactions.
+func (p Params) Validate() error {
+	if (p.RPC == "") == (p.File == "") {
+		return fmt.Errorf("one of rpc and file must be set")
+	}
+	if p.RPC != "" {
+		if !strings.HasPrefix(p.RPC, "http://") && !strings.HasPrefix(p.RPC, "https://") {
+			return fmt.Errorf("rpc must be an http:// or https:// URL")
+		}
+		if p.FromHeight <= 0 {
+			return fmt.Errorf("from_height must be > 0")
+		}
+		if p.ToHeight < p.FromHeight {
+			return fmt.Errorf("to_height must be >= from_height")
+		}
+	}
+	if p.Speed < 0 {
+		return fmt.Errorf("speed must be >= 0")
+	}
+	if p.Resign {
+		if err := p.TxParams.Validate(); err != nil {
+			return err
+		}
+		if p.SimulateGas {
+			return fmt.Errorf("simulate_gas is not supported, re-signed transactions keep their gas limit")
+		}
+	}
+	return nil
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	for name, value := range params {
+		var err error
+		switch name {
+		case "rpc":
+			p.RPC = value
+		case "from_height":
+			p.FromHeight, err = strconv.ParseInt(value, 10, 64)
+		case "to_height":
+			p.ToHeight, err = strconv.ParseInt(value, 10, 64)
+		case "file":
+			p.File = value
+		case "speed":
+			p.Speed, err = strconv.ParseFloat(value, 64)
+		case "resign":
+			p.Resign, err = strconv.ParseBool(value)
+		default:
+			var ok bool
+			if ok, err = p.TxParams.Override(name, value); !ok {
+				return p, fmt.Errorf("unknown parameter %q", name)
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
+
+// source identifies the transactions the parameters replay.
+func (p Params) source() string {
+	if p.File != "" {
+		return p.File
+	}
+	return fmt.Sprintf("%s@%d-%d", p.RPC, p.FromHeight, p.ToHeight)
+}
@@ -0,0 +1,63 @@
+package mainnet
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+var testTxParams = cosmostx.TxParams{
+	ChainID:  "testing",
+	Denom:    "stake",
+	GasLimit: 200000,
+	GasPrice: sdk.ZeroDec(),
+}
+
+func TestParamsWithOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  hybridloadtest.ClientParams
+		check   func(p Params) bool
+		wantErr bool
+	}{
+		{"file", hybridloadtest.ClientParams{"file": "txs.jsonl"}, func(p Params) bool { return p.File == "txs.jsonl" && p.Speed == 1 && p.source() == "txs.jsonl" }, false},
+		{
+			"blocks",
+			hybridloadtest.ClientParams{"rpc": "https://rpc.example.com", "from_height": "10", "to_height": "12", "speed": "0"},
+			func(p Params) bool {
+				return p.FromHeight == 10 && p.ToHeight == 12 && p.Speed == 0 && p.source() == "https://rpc.example.com@10-12"
+			},
+			false,
+		},
+		{"single block", hybridloadtest.ClientParams{"rpc": "http://localhost:26657", "from_height": "5", "to_height": "5"}, func(p Params) bool { return p.FromHeight == 5 }, false},
+		{
+			"resign",
+			hybridloadtest.ClientParams{"file": "txs.jsonl", "resign": "true", "chain_id": "local"},
+			func(p Params) bool { return p.Resign && p.ChainID == "local" },
+			false,
+		},
+		{"no source", nil, nil, true},
+		{"both sources", hybridloadtest.ClientParams{"file": "txs.jsonl", "rpc": "http://localhost:26657", "from_height": "1", "to_height": "1"}, nil, true},
+		{"websocket RPC", hybridloadtest.ClientParams{"rpc": "ws://localhost:26657", "from_height": "1", "to_height": "1"}, nil, true},
+		{"no from height", hybridloadtest.ClientParams{"rpc": "http://localhost:26657", "to_height": "1"}, nil, true},
+		{"to before from", hybridloadtest.ClientParams{"rpc": "http://localhost:26657", "from_height": "5", "to_height": "4"}, nil, true},
+		{"negative speed", hybridloadtest.ClientParams{"file": "txs.jsonl", "speed": "-1"}, nil, true},
+		{"invalid height", hybridloadtest.ClientParams{"rpc": "http://localhost:26657", "from_height": "one", "to_height": "1"}, nil, true},
+		{"resign with invalid transaction parameters", hybridloadtest.ClientParams{"file": "txs.jsonl", "resign": "true", "gas_limit": "0"}, nil, true},
+		{"resign with simulated gas", hybridloadtest.ClientParams{"file": "txs.jsonl", "resign": "true", "simulate_gas": "true"}, nil, true},
+		{"unknown parameter", hybridloadtest.ClientParams{"file": "txs.jsonl", "height": "1"}, nil, true},
+	}
+	for _, tt := range tests {
+		got, err := DefaultParams(testTxParams).WithOverrides(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: WithOverrides() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && !tt.check(got) {
+			t.Errorf("%s: WithOverrides() = %+v", tt.name, got)
+		}
+	}
+}
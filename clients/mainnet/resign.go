@@ -0,0 +1,197 @@
+package mainnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// decodedTx is a replayed transaction that can be re-signed: it has a single
+// signer, and messages of the SDK's modules.
+type decodedTx struct {
+	// The index of the transaction in its source
+	index int
+	// The messages as protobuf JSON with their type URL, and the address
+	// of the original signer
+	msgs     []json.RawMessage
+	signer   sdk.AccAddress
+	gasLimit uint64
+	memo     string
+}
+
+// decodeTxs decodes the transactions that can be re-signed. The others are
+// skipped, and their number returned.
+func decodeTxs(cdc *codec.ProtoCodec, decode sdk.TxDecoder, txs []RecordedTx) ([]decodedTx, int) {
+	var decoded []decodedTx
+	skipped := 0
+	for i, tx := range txs {
+		d, err := decodeTx(cdc, decode, tx.Tx)
+		if err != nil {
+			skipped++
+			continue
+		}
+		d.index = i
+		decoded = append(decoded, d)
+	}
+	return decoded, skipped
+}
+
+func decodeTx(cdc *codec.ProtoCodec, decode sdk.TxDecoder, txBytes []byte) (decodedTx, error) {
+	var d decodedTx
+	tx, err := decode(txBytes)
+	if err != nil {
+		return d, err
+	}
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return d, fmt.Errorf("transaction can't be signed")
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return d, err
+	}
+	if len(pubKeys) != 1 || pubKeys[0] == nil {
+		return d, fmt.Errorf("transaction has %d signers, expected 1", len(pubKeys))
+	}
+
+	for _, msg := range sigTx.GetMsgs() {
+		bz, err := cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return d, err
+		}
+		d.msgs = append(d.msgs, bz)
+	}
+	d.signer = sdk.AccAddress(pubKeys[0].Address())
+	d.gasLimit = sigTx.GetGas()
+	d.memo = sigTx.GetMemo()
+	return d, nil
+}
+
+// keyMap maps the addresses of the accounts of the original chain to the
+// accounts that re-sign their transactions and stand in for them in messages.
+// Signers are mapped in the order they first signed, then the other addresses
+// in messages with the same bech32 prefix as a signer's, e.g. recipients, in
+// the order they appear, taking the accounts in turn.
+type keyMap struct {
+	accounts map[string]*cosmostx.Account
+	// The prefixes of the signers' addresses, to tell account addresses
+	// from others such as validator operator addresses
+	prefixes map[string]bool
+}
+
+func newKeyMap(txs []decodedTx, accounts []*cosmostx.Account) keyMap {
+	keys := keyMap{
+		accounts: make(map[string]*cosmostx.Account),
+		prefixes: make(map[string]bool),
+	}
+	add := func(address []byte) {
+		if _, ok := keys.accounts[string(address)]; !ok {
+			keys.accounts[string(address)] = accounts[len(keys.accounts)%len(accounts)]
+		}
+	}
+	for _, tx := range txs {
+		add(tx.signer)
+	}
+	forEachAddress(txs, func(prefix string, address []byte) {
+		if _, ok := keys.accounts[string(address)]; ok {
+			keys.prefixes[prefix] = true
+		}
+	})
+	forEachAddress(txs, func(prefix string, address []byte) {
+		if keys.prefixes[prefix] {
+			add(address)
+		}
+	})
+	return keys
+}
+
+// account returns the account the address is mapped to.
+func (k keyMap) account(address sdk.AccAddress) *cosmostx.Account {
+	return k.accounts[string(address)]
+}
+
+// forEachAddress calls fn with each bech32 address in the messages of the
+// transactions, in order.
+func forEachAddress(txs []decodedTx, fn func(prefix string, address []byte)) {
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			// Sorted, so that addresses are mapped the same way every time
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				walk(v[key])
+			}
+		case []interface{}:
+			for _, value := range v {
+				walk(value)
+			}
+		case string:
+			if prefix, address, err := bech32.DecodeAndConvert(v); err == nil {
+				fn(prefix, address)
+			}
+		}
+	}
+	for _, tx := range txs {
+		for _, bz := range tx.msgs {
+			var fields interface{}
+			if err := json.Unmarshal(bz, &fields); err == nil {
+				walk(fields)
+			}
+		}
+	}
+}
+
+// msgs returns the transaction's messages, with the mapped addresses in them
+// replaced by those of the accounts they are mapped to.
+func (k keyMap) msgs(cdc *codec.ProtoCodec, tx decodedTx) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(tx.msgs))
+	for _, bz := range tx.msgs {
+		var fields interface{}
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(k.rewrite(fields))
+		if err != nil {
+			return nil, err
+		}
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// rewrite replaces the mapped bech32 addresses in JSON values.
+func (k keyMap) rewrite(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = k.rewrite(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = k.rewrite(value)
+		}
+	case string:
+		if prefix, address, err := bech32.DecodeAndConvert(v); err == nil && k.prefixes[prefix] {
+			if acc, ok := k.accounts[string(address)]; ok {
+				return acc.String()
+			}
+		}
+	}
+	return v
+}
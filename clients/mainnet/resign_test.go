@@ -0,0 +1,126 @@
+package mainnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+const testValidator = "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+
+func newTestTxConfig() client.TxConfig {
+	return authtx.NewTxConfig(codec.NewProtoCodec(cosmostx.NewInterfaceRegistry()), authtx.DefaultSignModes)
+}
+
+// newOriginalAccounts derives n accounts that stand for the signers of the
+// original chain, apart from the test accounts.
+func newOriginalAccounts(t *testing.T, n int) []*cosmostx.Account {
+	t.Helper()
+	pool := cosmostx.NewEmptyPool(cosmostx.AccountConfig{})
+	if err := pool.Derive(testMnemonic, 1, n); err != nil {
+		t.Fatal(err)
+	}
+	return pool.Accounts()
+}
+
+// signOriginal signs a transaction of the original chain.
+func signOriginal(t *testing.T, signer *cosmostx.Account, msgs ...sdk.Msg) []byte {
+	t.Helper()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000))
+	tx, err := cosmostx.SignTx(newTestTxConfig(), "cosmoshub-4", signer, 12, 7, msgs, 150000, fee, "original memo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// originalTxs returns a send from the first to the second original account,
+// a delegation by the third, and a transaction that can't be decoded.
+func originalTxs(t *testing.T, originals []*cosmostx.Account) []RecordedTx {
+	t.Helper()
+	send := banktypes.NewMsgSend(originals[0].Address(), originals[1].Address(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)))
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: originals[2].String(), ValidatorAddress: testValidator, Amount: sdk.NewInt64Coin("uatom", 3)}
+	return []RecordedTx{
+		{Height: 1, Time: blockTime, Tx: signOriginal(t, originals[0], send)},
+		{Height: 1, Time: blockTime, Tx: []byte("not a transaction")},
+		{Height: 2, Time: blockTime.Add(time.Second), Tx: signOriginal(t, originals[2], delegate)},
+	}
+}
+
+func TestDecodeTxs(t *testing.T) {
+	originals := newOriginalAccounts(t, 3)
+	cdc := codec.NewProtoCodec(cosmostx.NewInterfaceRegistry())
+	decoded, skipped := decodeTxs(cdc, newTestTxConfig().TxDecoder(), originalTxs(t, originals))
+	if len(decoded) != 2 || skipped != 1 {
+		t.Fatalf("decodeTxs() = %d transactions and %d skipped, want 2 and 1", len(decoded), skipped)
+	}
+	tests := []struct {
+		index  int
+		signer *cosmostx.Account
+	}{
+		{0, originals[0]},
+		{2, originals[2]},
+	}
+	for i, tt := range tests {
+		d := decoded[i]
+		if d.index != tt.index || !d.signer.Equals(tt.signer.Address()) || d.gasLimit != 150000 || d.memo != "original memo" || len(d.msgs) != 1 {
+			t.Errorf("decoded transaction %d = %+v, want transaction %d signed by %s", i, d, tt.index, tt.signer)
+		}
+	}
+}
+
+func TestKeyMap(t *testing.T) {
+	originals := newOriginalAccounts(t, 3)
+	accounts, err := cosmostx.NewAccountPool(cosmostx.AccountConfig{Mnemonic: testMnemonic, Accounts: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cdc := codec.NewProtoCodec(cosmostx.NewInterfaceRegistry())
+	decoded, _ := decodeTxs(cdc, newTestTxConfig().TxDecoder(), originalTxs(t, originals))
+	keys := newKeyMap(decoded, accounts.Accounts())
+
+	// The signers are mapped first, in turn, then the recipient
+	first, second := accounts.Accounts()[0], accounts.Accounts()[1]
+	tests := []struct {
+		original *cosmostx.Account
+		want     *cosmostx.Account
+	}{
+		{originals[0], first},
+		{originals[2], second},
+		{originals[1], first},
+	}
+	for i, tt := range tests {
+		if got := keys.account(tt.original.Address()); got != tt.want {
+			t.Errorf("account(original %d) = %s, want %s", i, got, tt.want)
+		}
+	}
+
+	msgs, err := keys.msgs(cdc, decoded[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := msgs[0].(*banktypes.MsgSend)
+	if send.FromAddress != first.String() || send.ToAddress != first.String() || send.Amount.String() != "10uatom" {
+		t.Errorf("rewritten send = %v, want 10uatom from and to %s", send, first)
+	}
+	msgs, err = keys.msgs(cdc, decoded[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Validator operator addresses aren't accounts
+	delegate := msgs[0].(*stakingtypes.MsgDelegate)
+	if delegate.DelegatorAddress != second.String() || delegate.ValidatorAddress != testValidator {
+		t.Errorf("rewritten delegation = %v, want from %s to %s", delegate, second, testValidator)
+	}
+}
@@ -0,0 +1,116 @@
+package mainnet
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+// How often to log the progress of pulling blocks
+const fetchLogInterval = 100
+
+// RecordedTx is a transaction as it was included in a block. It is exported
+// to and read from JSONL files, one per line, with the transaction encoded in
+// base64.
+type RecordedTx struct {
+	Height int64 `json:"height"`
+	// The time of the block, zero if unknown, in which case the
+	// transaction isn't paced
+	Time time.Time `json:"time"`
+	Tx   []byte    `json:"tx"`
+}
+
+// FetchBlocks pulls the transactions of the blocks from to to, inclusive,
+// from the node at the HTTP RPC endpoint with /block, in the order they were
+// included.
+func FetchBlocks(endpoint string, from, to int64) ([]RecordedTx, error) {
+	client, err := httprpc.NewHTTPRPCClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	var txs []RecordedTx
+	for height := from; height <= to; height++ {
+		res, err := client.Block(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %d: %w", height, err)
+		}
+		if res.Block.Header.Height != strconv.FormatInt(height, 10) {
+			return nil, fmt.Errorf("node returned block %s instead of %d", res.Block.Header.Height, height)
+		}
+		for _, tx := range res.Block.Data.Txs {
+			txs = append(txs, RecordedTx{Height: height, Time: res.Block.Header.Time, Tx: tx})
+		}
+		if (height-from+1)%fetchLogInterval == 0 {
+			logrus.Infof("Pulled %d of %d blocks, %d transactions", height-from+1, to-from+1, len(txs))
+		}
+	}
+	return txs, nil
+}
+
+// ReadJSONL reads the transactions of a JSONL file, in order.
+func ReadJSONL(path string) ([]RecordedTx, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var txs []RecordedTx
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var tx RecordedTx
+		if err := json.Unmarshal(scanner.Bytes(), &tx); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if len(tx.Tx) == 0 {
+			return nil, fmt.Errorf("%s:%d: tx is required", path, line)
+		}
+		txs = append(txs, tx)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// WriteJSONL exports transactions to a JSONL file that ReadJSONL reads.
+func WriteJSONL(path string, txs []RecordedTx) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, tx := range txs {
+		if err := enc.Encode(tx); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Load reads the transactions of the source the parameters set.
+func Load(p Params) ([]RecordedTx, error) {
+	if p.File != "" {
+		return ReadJSONL(p.File)
+	}
+	return FetchBlocks(p.RPC, p.FromHeight, p.ToHeight)
+}
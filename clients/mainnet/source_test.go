@@ -0,0 +1,134 @@
+package mainnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var blockTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestJSONLRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "txs.jsonl")
+	txs := []RecordedTx{
+		{Height: 1, Time: blockTime, Tx: []byte("first")},
+		{Height: 1, Time: blockTime, Tx: []byte{0, 1, 2}},
+		{Height: 3, Tx: []byte("untimed")},
+	}
+	if err := WriteJSONL(path, txs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadJSONL(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(txs) {
+		t.Fatalf("ReadJSONL() = %d transactions, want %d", len(got), len(txs))
+	}
+	for i := range txs {
+		if got[i].Height != txs[i].Height || !got[i].Time.Equal(txs[i].Time) || string(got[i].Tx) != string(txs[i].Tx) {
+			t.Errorf("transaction %d = %+v, want %+v", i, got[i], txs[i])
+		}
+	}
+}
+
+func TestReadJSONLErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+		wantErr bool
+	}{
+		{"blank lines", "\n" + `{"height": 1, "tx": "AAE="}` + "\n\n", 1, false},
+		{"invalid JSON", `{"height": 1, "tx": "AAE="}` + "\n{\n", 0, true},
+		{"no transaction", `{"height": 1}` + "\n", 0, true},
+		{"invalid base64", `{"height": 1, "tx": "not base64!"}` + "\n", 0, true},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "txs.jsonl")
+		if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		txs, err := ReadJSONL(path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ReadJSONL() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(txs) != tt.want {
+			t.Errorf("%s: ReadJSONL() = %d transactions, want %d", tt.name, len(txs), tt.want)
+		}
+	}
+	if _, err := ReadJSONL(filepath.Join(t.TempDir(), "missing.jsonl")); err == nil {
+		t.Errorf("ReadJSONL() of a missing file succeeded")
+	}
+}
+
+// newTestArchive starts a JSON-RPC server that serves blocks whose height
+// is their number of transactions, one second apart. The block at
+// wrongHeight reports another height.
+func newTestArchive(t *testing.T, wrongHeight int64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Height string `json:"height"`
+			} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "block" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		height, err := strconv.ParseInt(req.Params.Height, 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		txs := make([][]byte, height)
+		for i := range txs {
+			txs[i] = []byte(req.Params.Height + "-" + strconv.Itoa(i))
+		}
+		reported := height
+		if height == wrongHeight {
+			reported++
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result": map[string]any{"block": map[string]any{
+				"header": map[string]any{"height": strconv.FormatInt(reported, 10), "time": blockTime.Add(time.Duration(height) * time.Second)},
+				"data":   map[string]any{"txs": txs},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetchBlocks(t *testing.T) {
+	srv := newTestArchive(t, 0)
+	txs, err := FetchBlocks(srv.URL, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"1-0", "2-0", "2-1", "3-0", "3-1", "3-2"}
+	if len(txs) != len(want) {
+		t.Fatalf("FetchBlocks() = %d transactions, want %d", len(txs), len(want))
+	}
+	for i, tx := range txs {
+		height, _ := strconv.ParseInt(want[i][:1], 10, 64)
+		if string(tx.Tx) != want[i] || tx.Height != height || !tx.Time.Equal(blockTime.Add(time.Duration(height)*time.Second)) {
+			t.Errorf("transaction %d = %+v, want %s of block %d", i, tx, want[i], height)
+		}
+	}
+
+	if _, err := FetchBlocks(newTestArchive(t, 2).URL, 1, 3); err == nil {
+		t.Errorf("FetchBlocks() succeeded when the node returned the wrong block")
+	}
+}
@@ -0,0 +1,122 @@
+package msgmix
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// MsgMixClientFactory creates clients that generate a weighted mix of common
+// SDK messages
+type MsgMixClientFactory struct {
+	cosmostx.Factory[Params]
+}
+
+var (
+	_ loadtest.ClientFactory             = (*MsgMixClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*MsgMixClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*MsgMixClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*MsgMixClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*MsgMixClientFactory)(nil)
+)
+
+// NewMsgMixClientFactory creates a factory for clients that sign with the
+// given funded accounts, with the given default parameters
+func NewMsgMixClientFactory(txConfig client.TxConfig, accounts cosmostx.AccountConfig, params Params) *MsgMixClientFactory {
+	f := &MsgMixClientFactory{}
+	f.Factory = cosmostx.NewFactory[Params](f, txConfig, accounts, params)
+	return f
+}
+
+// MsgMixClient generates transactions of one message each, picked at random
+// by weight
+type MsgMixClient struct {
+	*cosmostx.Sender
+	params Params
+	// The gas limit of each message, by name
+	gasLimits map[string]uint64
+	// The weighted messages and their cumulative weights, to pick from
+	names      []string
+	cumWeights []int
+	rand       *rand.Rand
+}
+
+var (
+	_ loadtest.Client                 = (*MsgMixClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*MsgMixClient)(nil)
+)
+
+// NewClientWithParams creates a client with the factory's parameters
+// overridden by params
+func (f *MsgMixClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := f.NewSender(cfg, p.TxParams)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &MsgMixClient{
+		Sender:    sender,
+		params:    p,
+		gasLimits: make(map[string]uint64),
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	total := 0
+	for _, name := range msgNames {
+		if weight := p.Weights[name]; weight > 0 {
+			total += weight
+			c.names = append(c.names, name)
+			c.cumWeights = append(c.cumWeights, total)
+			c.gasLimits[name] = p.GasLimit
+		}
+	}
+	if p.SimulateGas {
+		// Each message gets the gas a simulated transaction with it uses
+		for _, name := range c.names {
+			gasLimit, err := c.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+				return c.sign(name, accountNumber, sequence)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			c.gasLimits[name] = gasLimit
+		}
+	}
+	return c, nil
+}
+
+// GenerateTx creates a transaction with a message picked by weight
+func (c *MsgMixClient) GenerateTx() ([]byte, error) {
+	n := c.rand.Intn(c.cumWeights[len(c.cumWeights)-1])
+	name := c.names[len(c.names)-1]
+	for i, cumWeight := range c.cumWeights {
+		if n < cumWeight {
+			name = c.names[i]
+			break
+		}
+	}
+
+	// Reserve a sequence, shared with the other clients of the sender
+	accountNumber, sequence := c.Account.NextSequence()
+	return c.sign(name, accountNumber, sequence)
+}
+
+// sign signs a transaction with the named message
+func (c *MsgMixClient) sign(name string, accountNumber, sequence uint64) ([]byte, error) {
+	msg, err := c.msg(name)
+	if err != nil {
+		return nil, err
+	}
+	memo := fmt.Sprintf("LoadTest:%s", name)
+	return c.Sign(accountNumber, sequence, []sdk.Msg{msg}, c.gasLimits[name], memo)
+}
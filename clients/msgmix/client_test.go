@@ -0,0 +1,211 @@
+package msgmix
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+const testValidator = "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+
+// newTestTxConfig returns a transaction configuration that decodes bank and
+// staking messages.
+func newTestTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+// newTestClient creates a client that signs locally with the first of three
+// accounts, without looking it up on chain, and picks messages by the given
+// weights.
+func newTestClient(t *testing.T, weights map[string]int) *MsgMixClient {
+	t.Helper()
+	pool, err := cosmostx.NewAccountPool(cosmostx.AccountConfig{Mnemonic: testMnemonic, Accounts: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := DefaultParams(testTxParams)
+	p.Weights = weights
+	p.Validator = testValidator
+	p.ProposalID = 1
+	p.IBCChannel = "channel-0"
+	p.IBCReceiver = "osmo1receiver"
+
+	c := &MsgMixClient{
+		Sender:    cosmostx.NewSender(newTestTxConfig(), p.TxParams, pool, pool.Accounts()[0], nil),
+		params:    p,
+		gasLimits: make(map[string]uint64),
+		rand:      rand.New(rand.NewSource(1)),
+	}
+	total := 0
+	for _, name := range msgNames {
+		if weight := weights[name]; weight > 0 {
+			total += weight
+			c.names = append(c.names, name)
+			c.cumWeights = append(c.cumWeights, total)
+			c.gasLimits[name] = p.GasLimit
+		}
+	}
+	return c
+}
+
+func TestGenerateTxWeights(t *testing.T) {
+	c := newTestClient(t, map[string]int{msgSend: 3, msgDelegate: 1})
+	counts := make(map[string]int)
+	for i := 0; i < 400; i++ {
+		txBytes, err := c.GenerateTx()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := newTestTxConfig().TxDecoder()(txBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[sdk.MsgTypeURL(tx.GetMsgs()[0])]++
+	}
+
+	sends, delegations := counts[sdk.MsgTypeURL(&banktypes.MsgSend{})], counts[sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})]
+	if sends+delegations != 400 {
+		t.Fatalf("generated %v, want only sends and delegations", counts)
+	}
+	// Sends have three quarters of the weight
+	if sends < 250 || sends > 350 {
+		t.Errorf("generated %d sends of 400 transactions, want about 300", sends)
+	}
+}
+
+func TestMsg(t *testing.T) {
+	c := newTestClient(t, map[string]int{msgSend: 1})
+	accounts := c.Pool.Accounts()
+	sender, next, previous := accounts[0].String(), accounts[1].String(), accounts[2].String()
+
+	tests := []struct {
+		name  string
+		check func(msg sdk.Msg) bool
+	}{
+		{msgSend, func(msg sdk.Msg) bool {
+			send, ok := msg.(*banktypes.MsgSend)
+			return ok && send.FromAddress == sender && send.ToAddress == next
+		}},
+		{msgMultiSend, func(msg sdk.Msg) bool {
+			multiSend, ok := msg.(*banktypes.MsgMultiSend)
+			return ok && len(multiSend.Outputs) == 3 && multiSend.Inputs[0].Coins.String() == "3stake" &&
+				multiSend.Outputs[0].Address == next && multiSend.Outputs[1].Address == previous && multiSend.Outputs[2].Address == sender
+		}},
+		{msgDelegate, func(msg sdk.Msg) bool {
+			delegate, ok := msg.(*stakingtypes.MsgDelegate)
+			return ok && delegate.DelegatorAddress == sender && delegate.ValidatorAddress == testValidator
+		}},
+		{msgUndelegate, func(msg sdk.Msg) bool {
+			undelegate, ok := msg.(*stakingtypes.MsgUndelegate)
+			return ok && undelegate.DelegatorAddress == sender && undelegate.ValidatorAddress == testValidator
+		}},
+		{msgVote, func(msg sdk.Msg) bool {
+			vote, ok := msg.(*govv1beta1.MsgVote)
+			return ok && vote.Voter == sender && vote.ProposalId == 1 && vote.Option == govv1beta1.OptionYes
+		}},
+		{msgGrant, func(msg sdk.Msg) bool {
+			grant, ok := msg.(*authz.MsgGrant)
+			return ok && grant.Granter == sender && grant.Grantee == next
+		}},
+		{msgExec, func(msg sdk.Msg) bool {
+			exec, ok := msg.(*authz.MsgExec)
+			return ok && exec.Grantee == sender && len(exec.Msgs) == 1
+		}},
+		{msgIBCTransfer, func(msg sdk.Msg) bool {
+			raw, ok := msg.(*cosmostx.RawMsg)
+			return ok && raw.TypeURL == msgTransferTypeURL && raw.GetSigners()[0].String() == sender
+		}},
+	}
+	for _, tt := range tests {
+		msg, err := c.msg(tt.name)
+		if err != nil {
+			t.Errorf("msg(%q) error: %v", tt.name, err)
+			continue
+		}
+		if !tt.check(msg) {
+			t.Errorf("msg(%q) = %v", tt.name, msg)
+		}
+	}
+	if _, err := c.msg("swap"); err == nil {
+		t.Errorf("msg(%q) succeeded, want an error", "swap")
+	}
+}
+
+func TestIBCTransferEncoding(t *testing.T) {
+	c := newTestClient(t, map[string]int{msgIBCTransfer: 1})
+	msg, err := c.ibcTransfer(sdk.NewInt64Coin("stake", 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Decode the fields of the MsgTransfer
+	fields := make(map[protowire.Number][]byte)
+	var timeout uint64
+	value := msg.(*cosmostx.RawMsg).Value
+	for len(value) > 0 {
+		num, typ, n := protowire.ConsumeTag(value)
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		value = value[n:]
+		switch typ {
+		case protowire.BytesType:
+			var b []byte
+			b, n = protowire.ConsumeBytes(value)
+			fields[num] = b
+		case protowire.VarintType:
+			timeout, n = protowire.ConsumeVarint(value)
+		}
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		value = value[n:]
+	}
+
+	var token sdk.Coin
+	if err := token.Unmarshal(fields[3]); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		field protowire.Number
+		got   string
+		want  string
+	}{
+		{1, string(fields[1]), "transfer"},
+		{2, string(fields[2]), "channel-0"},
+		{3, token.String(), "7stake"},
+		{4, string(fields[4]), c.Account.String()},
+		{5, string(fields[5]), "osmo1receiver"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("field %d = %q, want %q", tt.field, tt.got, tt.want)
+		}
+	}
+	if _, ok := fields[6]; ok {
+		t.Errorf("field 6, the timeout height, is set")
+	}
+	if timeout == 0 {
+		t.Errorf("field 7, the timeout timestamp, is unset")
+	}
+}
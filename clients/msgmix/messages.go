@@ -0,0 +1,109 @@
+package msgmix
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// The type URL of ICS-20 transfers, whose Go type is in ibc-go
+const msgTransferTypeURL = "/ibc.applications.transfer.v1.MsgTransfer"
+
+// msg creates the named message, signed by the client's sender. Funds move
+// from each account of the pool to the next, so that they stay within the
+// pool, and authz grants go the same way.
+func (c *MsgMixClient) msg(name string) (sdk.Msg, error) {
+	sender := c.Account.String()
+	recipient := c.Pool.After(c.Account)
+	amount := sdk.NewCoin(c.params.Denom, sdk.NewInt(c.params.amount(name)))
+
+	switch name {
+	case msgSend:
+		return &banktypes.MsgSend{FromAddress: sender, ToAddress: recipient.String(), Amount: sdk.NewCoins(amount)}, nil
+
+	case msgMultiSend:
+		outputs := c.params.MultiSendOutputs
+		total := sdk.NewCoin(amount.Denom, amount.Amount.MulRaw(int64(outputs)))
+		msg := &banktypes.MsgMultiSend{
+			Inputs: []banktypes.Input{{Address: sender, Coins: sdk.NewCoins(total)}},
+		}
+		for i := 0; i < outputs; i++ {
+			msg.Outputs = append(msg.Outputs, banktypes.Output{Address: recipient.String(), Coins: sdk.NewCoins(amount)})
+			recipient = c.Pool.After(recipient)
+		}
+		return msg, nil
+
+	case msgDelegate:
+		return &stakingtypes.MsgDelegate{DelegatorAddress: sender, ValidatorAddress: c.params.Validator, Amount: amount}, nil
+
+	case msgUndelegate:
+		return &stakingtypes.MsgUndelegate{DelegatorAddress: sender, ValidatorAddress: c.params.Validator, Amount: amount}, nil
+
+	case msgVote:
+		return &govv1beta1.MsgVote{ProposalId: c.params.ProposalID, Voter: sender, Option: c.params.VoteOption}, nil
+
+	case msgGrant:
+		// Let the next account send on the sender's behalf
+		now := time.Now()
+		expiration := now.Add(c.params.GrantExpiration)
+		grant, err := authz.NewGrant(now, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), &expiration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create grant: %w", err)
+		}
+		return &authz.MsgGrant{Granter: sender, Grantee: recipient.String(), Grant: grant}, nil
+
+	case msgExec:
+		// Send from the previous account, which grants the sender
+		granter := c.Pool.Before(c.Account)
+		send := &banktypes.MsgSend{FromAddress: granter.String(), ToAddress: sender, Amount: sdk.NewCoins(amount)}
+		msgs, err := sdktx.SetMsgs([]sdk.Msg{send})
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack executed message: %w", err)
+		}
+		return &authz.MsgExec{Grantee: sender, Msgs: msgs}, nil
+
+	case msgIBCTransfer:
+		return c.ibcTransfer(amount)
+	}
+	return nil, fmt.Errorf("unknown message %q", name)
+}
+
+// ibcTransfer encodes an ICS-20 transfer of the amount to the IBC receiver,
+// which times out by timestamp only.
+func (c *MsgMixClient) ibcTransfer(amount sdk.Coin) (sdk.Msg, error) {
+	token, err := amount.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode token: %w", err)
+	}
+	timeout := time.Now().Add(c.params.IBCTimeout)
+
+	var value []byte
+	value = protowire.AppendTag(value, 1, protowire.BytesType)
+	value = protowire.AppendString(value, c.params.IBCPort)
+	value = protowire.AppendTag(value, 2, protowire.BytesType)
+	value = protowire.AppendString(value, c.params.IBCChannel)
+	value = protowire.AppendTag(value, 3, protowire.BytesType)
+	value = protowire.AppendBytes(value, token)
+	value = protowire.AppendTag(value, 4, protowire.BytesType)
+	value = protowire.AppendString(value, c.Account.String())
+	value = protowire.AppendTag(value, 5, protowire.BytesType)
+	value = protowire.AppendString(value, c.params.IBCReceiver)
+	// Field 6, the timeout height, is left empty
+	value = protowire.AppendTag(value, 7, protowire.VarintType)
+	value = protowire.AppendVarint(value, uint64(timeout.UnixNano()))
+
+	return &cosmostx.RawMsg{
+		TypeURL: msgTransferTypeURL,
+		Value:   value,
+		Signers: []sdk.AccAddress{c.Account.Address()},
+	}, nil
+}
@@ -0,0 +1,195 @@
+package msgmix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// The names of the messages clients can generate
+const (
+	msgSend        = "send"
+	msgMultiSend   = "multisend"
+	msgDelegate    = "delegate"
+	msgUndelegate  = "undelegate"
+	msgVote        = "vote"
+	msgGrant       = "grant"
+	msgExec        = "exec"
+	msgIBCTransfer = "ibc_transfer"
+)
+
+var msgNames = []string{msgSend, msgMultiSend, msgDelegate, msgUndelegate, msgVote, msgGrant, msgExec, msgIBCTransfer}
+
+// Params configure the mix of messages clients generate. Besides the client
+// parameters of cosmostx.TxParams, they can be overridden for a load test by
+// the client parameters:
+//
+//   - <message>.weight and <message>.amount, for each message name
+//   - multisend.outputs
+//   - validator, for delegate and undelegate
+//   - vote.proposal_id and vote.option
+//   - grant.expiration
+//   - ibc_transfer.port, ibc_transfer.channel, ibc_transfer.receiver and
+//     ibc_transfer.timeout
+type Params struct {
+	cosmostx.TxParams
+	// The relative frequency of each message, by name. Messages without a
+	// positive weight aren't generated.
+	Weights map[string]int
+	// The amount each message moves, by name, in the denom. Defaults to 1.
+	Amounts map[string]int64
+	// The number of outputs of multi-sends
+	MultiSendOutputs int
+	// The validator delegations go to and undelegations come from
+	Validator string
+	// The proposal votes are cast on, and how
+	ProposalID uint64
+	VoteOption govv1beta1.VoteOption
+	// How long authz grants last
+	GrantExpiration time.Duration
+	// The port and channel IBC transfers go through, the address on the
+	// counterparty chain that receives them, and how long they have to be
+	// relayed
+	IBCPort     string
+	IBCChannel  string
+	IBCReceiver string
+	IBCTimeout  time.Duration
+}
+
+// DefaultParams returns parameters that only generate sends, with the given
+// transaction parameters.
+func DefaultParams(txParams cosmostx.TxParams) Params {
+	return Params{
+		TxParams:         txParams,
+		Weights:          map[string]int{msgSend: 1},
+		Amounts:          map[string]int64{},
+		MultiSendOutputs: 3,
+		VoteOption:       govv1beta1.OptionYes,
+		GrantExpiration:  time.Hour,
+		IBCPort:          "transfer",
+		IBCTimeout:       10 * time.Minute,
+	}
+}
+
+// amount returns the amount the named message moves.
+func (p Params) amount(name string) int64 {
+	if amount, ok := p.Amounts[name]; ok {
+		return amount
+	}
+	return 1
+}
+
+// Validate checks that the parameters make valid transactions.
+func (p Params) Validate() error {
+	if err := p.TxParams.Validate(); err != nil {
+		return err
+	}
+	total := 0
+	for name, weight := range p.Weights {
+		if weight < 0 {
+			return fmt.Errorf("%s.weight must be >= 0", name)
+		}
+		total += weight
+	}
+	if total == 0 {
+		return fmt.Errorf("at least one message must have a positive weight")
+	}
+	for name, amount := range p.Amounts {
+		if amount <= 0 {
+			return fmt.Errorf("%s.amount must be > 0", name)
+		}
+	}
+
+	if p.Weights[msgMultiSend] > 0 && p.MultiSendOutputs <= 0 {
+		return fmt.Errorf("multisend.outputs must be > 0")
+	}
+	if (p.Weights[msgDelegate] > 0 || p.Weights[msgUndelegate] > 0) && p.Validator == "" {
+		return fmt.Errorf("validator is required for delegate and undelegate")
+	}
+	if p.Weights[msgVote] > 0 && p.ProposalID == 0 {
+		return fmt.Errorf("vote.proposal_id is required for vote")
+	}
+	if p.Weights[msgGrant] > 0 && p.GrantExpiration <= 0 {
+		return fmt.Errorf("grant.expiration must be > 0")
+	}
+	if p.Weights[msgIBCTransfer] > 0 {
+		if p.IBCPort == "" || p.IBCChannel == "" || p.IBCReceiver == "" {
+			return fmt.Errorf("ibc_transfer.port, ibc_transfer.channel and ibc_transfer.receiver are required for ibc_transfer")
+		}
+		if p.IBCTimeout <= 0 {
+			return fmt.Errorf("ibc_transfer.timeout must be > 0")
+		}
+	}
+	return nil
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	// Copy the maps, which are shared with the factory's parameters
+	weights := make(map[string]int, len(p.Weights))
+	for name, weight := range p.Weights {
+		weights[name] = weight
+	}
+	amounts := make(map[string]int64, len(p.Amounts))
+	for name, amount := range p.Amounts {
+		amounts[name] = amount
+	}
+	p.Weights, p.Amounts = weights, amounts
+
+	for name, value := range params {
+		var err error
+		switch name {
+		case "multisend.outputs":
+			p.MultiSendOutputs, err = strconv.Atoi(value)
+		case "validator":
+			p.Validator = value
+		case "vote.proposal_id":
+			p.ProposalID, err = strconv.ParseUint(value, 10, 64)
+		case "vote.option":
+			p.VoteOption, err = govv1beta1.VoteOptionFromString("VOTE_OPTION_" + strings.ToUpper(value))
+		case "grant.expiration":
+			p.GrantExpiration, err = time.ParseDuration(value)
+		case "ibc_transfer.port":
+			p.IBCPort = value
+		case "ibc_transfer.channel":
+			p.IBCChannel = value
+		case "ibc_transfer.receiver":
+			p.IBCReceiver = value
+		case "ibc_transfer.timeout":
+			p.IBCTimeout, err = time.ParseDuration(value)
+		default:
+			msg, field, _ := strings.Cut(name, ".")
+			switch {
+			case field == "weight" && isMsgName(msg):
+				p.Weights[msg], err = strconv.Atoi(value)
+			case field == "amount" && isMsgName(msg):
+				p.Amounts[msg], err = strconv.ParseInt(value, 10, 64)
+			default:
+				var ok bool
+				if ok, err = p.TxParams.Override(name, value); !ok {
+					return p, fmt.Errorf("unknown parameter %q", name)
+				}
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
+
+func isMsgName(name string) bool {
+	for _, msgName := range msgNames {
+		if name == msgName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package msgmix
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+var testTxParams = cosmostx.TxParams{
+	ChainID:  "testing",
+	Denom:    "stake",
+	GasLimit: 200000,
+	GasPrice: sdk.ZeroDec(),
+}
+
+func TestParamsWithOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  hybridloadtest.ClientParams
+		check   func(p Params) bool
+		wantErr bool
+	}{
+		{"defaults", nil, func(p Params) bool { return p.Weights[msgSend] == 1 && p.amount(msgSend) == 1 }, false},
+		{
+			"weights and amounts",
+			hybridloadtest.ClientParams{"send.weight": "3", "multisend.weight": "1", "multisend.amount": "50", "multisend.outputs": "5"},
+			func(p Params) bool {
+				return p.Weights[msgSend] == 3 && p.Weights[msgMultiSend] == 1 && p.amount(msgMultiSend) == 50 && p.MultiSendOutputs == 5
+			},
+			false,
+		},
+		{
+			"vote",
+			hybridloadtest.ClientParams{"send.weight": "0", "vote.weight": "1", "vote.proposal_id": "4", "vote.option": "no_with_veto"},
+			func(p Params) bool { return p.ProposalID == 4 && p.VoteOption == govv1beta1.OptionNoWithVeto },
+			false,
+		},
+		{
+			"ibc transfer",
+			hybridloadtest.ClientParams{"ibc_transfer.weight": "1", "ibc_transfer.channel": "channel-0", "ibc_transfer.receiver": "osmo1xyz", "ibc_transfer.timeout": "1m"},
+			func(p Params) bool {
+				return p.IBCPort == "transfer" && p.IBCChannel == "channel-0" && p.IBCTimeout == time.Minute
+			},
+			false,
+		},
+		{"transaction parameters", hybridloadtest.ClientParams{"denom": "uatom"}, func(p Params) bool { return p.Denom == "uatom" }, false},
+		{"no positive weight", hybridloadtest.ClientParams{"send.weight": "0"}, nil, true},
+		{"negative weight", hybridloadtest.ClientParams{"delegate.weight": "-1"}, nil, true},
+		{"zero amount", hybridloadtest.ClientParams{"send.amount": "0"}, nil, true},
+		{"delegate without validator", hybridloadtest.ClientParams{"delegate.weight": "1"}, nil, true},
+		{"vote without proposal", hybridloadtest.ClientParams{"vote.weight": "1"}, nil, true},
+		{"invalid vote option", hybridloadtest.ClientParams{"vote.option": "maybe"}, nil, true},
+		{"ibc transfer without channel", hybridloadtest.ClientParams{"ibc_transfer.weight": "1", "ibc_transfer.receiver": "osmo1xyz"}, nil, true},
+		{"unknown message", hybridloadtest.ClientParams{"swap.weight": "1"}, nil, true},
+		{"unknown parameter", hybridloadtest.ClientParams{"min_amount": "1"}, nil, true},
+	}
+	defaults := DefaultParams(testTxParams)
+	for _, tt := range tests {
+		got, err := defaults.WithOverrides(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: WithOverrides() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && !tt.check(got) {
+			t.Errorf("%s: WithOverrides() = %+v", tt.name, got)
+		}
+	}
+	// Overrides don't leak into the factory's parameters
+	if len(defaults.Weights) != 1 || len(defaults.Amounts) != 0 {
+		t.Errorf("WithOverrides() changed the defaults to %v, %v", defaults.Weights, defaults.Amounts)
+	}
+}
@@ -1,22 +1,47 @@
 package myabciapp
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
 )
 
+// The memo every transaction starts with, padded to the load test's size
+const memoPrefix = "LoadTest:"
+
+// How many times to re-sign a transaction whose padding missed the size, as
+// the varint lengths of the memo, body and sequence change
+const maxPaddingRetries = 3
+
 // CosmosClientFactory creates instances of CosmosClient
 type CosmosClientFactory struct {
-	txConfig client.TxConfig
+	cosmostx.Factory[Params]
 }
 
-// CosmosClientFactory implements loadtest.ClientFactory
-var _ loadtest.ClientFactory = (*CosmosClientFactory)(nil)
+// CosmosClientFactory implements loadtest.ClientFactory, takes client
+// parameters, funds accounts and reports its chain ID
+var (
+	_ loadtest.ClientFactory             = (*CosmosClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*CosmosClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*CosmosClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*CosmosClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*CosmosClientFactory)(nil)
+)
 
-func NewCosmosClientFactory(txConfig client.TxConfig) *CosmosClientFactory {
-	return &CosmosClientFactory{
-		txConfig: txConfig,
-	}
+// NewCosmosClientFactory creates a factory for clients that sign bank sends
+// with the given funded accounts, with the given default parameters.
+func NewCosmosClientFactory(txConfig client.TxConfig, accounts cosmostx.AccountConfig, params Params) *CosmosClientFactory {
+	f := &CosmosClientFactory{}
+	f.Factory = cosmostx.NewFactory[Params](f, txConfig, accounts, params)
+	return f
 }
 
 // CosmosClient is responsible for generating transactions. Only one client
@@ -24,30 +49,150 @@ func NewCosmosClientFactory(txConfig client.TxConfig) *CosmosClientFactory {
 // each client will be responsible for maintaining its own state in a
 // thread-safe manner.
 type CosmosClient struct {
-	txConfig client.TxConfig
+	*cosmostx.Sender
+	params    Params
+	gasLimit  uint64
+	size      int
+	recipient sdk.AccAddress
+	// The memo length that pads transactions to size, adjusted when a
+	// transaction misses it
+	padding int
 }
 
-// CosmosClient implements loadtest.Client
-var _ loadtest.Client = (*CosmosClient)(nil)
+// CosmosClient implements loadtest.Client, and handles rejections
+var (
+	_ loadtest.Client                 = (*CosmosClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*CosmosClient)(nil)
+)
 
-func (f *CosmosClientFactory) ValidateConfig(cfg loadtest.Config) error {
-	// Do any checks here that you need to ensure that the load test
-	// configuration is compatible with your client.
+// ValidateParams validates the configuration along with the factory's
+// parameters overridden by params. The size can't be more than that of a bank
+// send with the longest memo; smaller sizes than that of a bank send without a
+// memo can't be honored and only warn.
+func (f *CosmosClientFactory) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	p, err := f.Params(params)
+	if err != nil {
+		return err
+	}
+	pool, err := f.Signers().Pool()
+	if err != nil {
+		return err
+	}
+
+	// The sizes of unpadded and fully padded transactions, signed locally
+	// with the first account
+	first := pool.Accounts()[0]
+	c := &CosmosClient{
+		Sender:    cosmostx.NewSender(f.TxConfig(), p.TxParams, pool, first, cfg.Endpoints),
+		params:    p,
+		recipient: first.Address(),
+	}
+	minTx, err := c.signSend(0, 0, p.GasLimit, 0)
+	if err != nil {
+		return err
+	}
+	maxTx, err := c.signSend(0, 0, p.GasLimit, p.MaxMemo)
+	if err != nil {
+		return err
+	}
+	if cfg.Size > len(maxTx) {
+		return fmt.Errorf("size must be at most %d bytes, the size of a bank send with a memo of max_memo=%d characters", len(maxTx), p.MaxMemo)
+	}
+	if cfg.Size < len(minTx) {
+		logrus.Warnf("Transactions will be %d bytes instead of %d, the size of a bank send without a memo", len(minTx), cfg.Size)
+	}
 	return nil
 }
 
-func (f *CosmosClientFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
-	return &CosmosClient{
-		txConfig: f.txConfig,
-	}, nil
+// NewClientWithParams creates a client with the factory's parameters
+// overridden by params.
+func (f *CosmosClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clients share the accounts round-robin, and each sends to the next
+	// account so that the funds stay within the pool
+	sender, err := f.NewSender(cfg, p.TxParams)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CosmosClient{
+		Sender:    sender,
+		params:    p,
+		gasLimit:  p.GasLimit,
+		size:      cfg.Size,
+		recipient: sender.Pool.After(sender.Account).Address(),
+	}
+	if p.SimulateGas {
+		// Simulate a padded transaction
+		c.gasLimit, err = c.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+			return c.signPadded(accountNumber, sequence, p.GasLimit)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
 }
 
 // GenerateTx must return the raw bytes that make up the transaction for your
 // ABCI app. The conversion to base64 will automatically be handled by the
 // loadtest package, so don't worry about that. Only return an error here if you
 // want to completely fail the entire load test operation.
+//
+// Transactions are signed bank sends of 1 unit of the denom, whose memo pads
+// them to the load test's size.
 func (c *CosmosClient) GenerateTx() ([]byte, error) {
-	txBuilder := c.txConfig.NewTxBuilder()
-	// TODO: add messages to this transaction.
-	return c.txConfig.TxEncoder()(txBuilder.GetTx())
+	// Reserve a sequence, shared with the other clients of the sender
+	accountNumber, sequence := c.Account.NextSequence()
+	return c.signPadded(accountNumber, sequence, c.gasLimit)
+}
+
+// signPadded signs a bank send whose memo pads it to the client's size. The
+// padding is corrected and the transaction re-signed when it misses the size,
+// which it can only do by a few bytes. When the size can't be hit exactly, the
+// transaction can be a byte or two off.
+func (c *CosmosClient) signPadded(accountNumber, sequence, gasLimit uint64) ([]byte, error) {
+	var tx []byte
+	for i := 0; i < maxPaddingRetries; i++ {
+		var err error
+		tx, err = c.signSend(accountNumber, sequence, gasLimit, c.padding)
+		if err != nil {
+			return nil, err
+		}
+		padding := c.padding + c.size - len(tx)
+		if padding < 0 {
+			padding = 0
+		}
+		if padding > c.params.MaxMemo {
+			padding = c.params.MaxMemo
+		}
+		if padding == c.padding {
+			break
+		}
+		c.padding = padding
+	}
+	return tx, nil
+}
+
+// signSend signs a bank send with a memo of the given length.
+func (c *CosmosClient) signSend(accountNumber, sequence, gasLimit uint64, memoLength int) ([]byte, error) {
+	msg := banktypes.NewMsgSend(
+		c.Account.Address(),
+		c.recipient,
+		sdk.NewCoins(sdk.NewCoin(c.params.Denom, sdk.OneInt())),
+	)
+	return c.Sign(accountNumber, sequence, []sdk.Msg{msg}, gasLimit, memo(memoLength))
+}
+
+// memo returns a memo of the given length, starting with as much of
+// memoPrefix as fits.
+func memo(length int) string {
+	if length <= len(memoPrefix) {
+		return memoPrefix[:length]
+	}
+	return memoPrefix + strings.Repeat("x", length-len(memoPrefix))
 }
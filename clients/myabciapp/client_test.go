@@ -0,0 +1,113 @@
+package myabciapp
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func newTestFactory() *CosmosClientFactory {
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes)
+	return NewCosmosClientFactory(txConfig, cosmostx.AccountConfig{Mnemonic: testMnemonic}, DefaultParams())
+}
+
+// newTestClient creates a client that signs locally with the first account,
+// without looking it up on chain.
+func newTestClient(t *testing.T, f *CosmosClientFactory, size int) *CosmosClient {
+	t.Helper()
+	pool, err := f.Signers().Pool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := pool.Accounts()[0]
+	p := DefaultParams()
+	return &CosmosClient{
+		Sender:    cosmostx.NewSender(f.TxConfig(), p.TxParams, pool, first, nil),
+		params:    p,
+		gasLimit:  p.GasLimit,
+		size:      size,
+		recipient: first.Address(),
+	}
+}
+
+func TestMemo(t *testing.T) {
+	tests := []struct {
+		length int
+		want   string
+	}{
+		{0, ""},
+		{4, "Load"},
+		{len(memoPrefix), memoPrefix},
+		{len(memoPrefix) + 3, memoPrefix + "xxx"},
+	}
+	for _, tt := range tests {
+		if got := memo(tt.length); got != tt.want {
+			t.Errorf("memo(%d) = %q, want %q", tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateTxSize(t *testing.T) {
+	f := newTestFactory()
+	unpadded, err := newTestClient(t, f, 0).signSend(0, 0, DefaultParams().GasLimit, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	min := len(unpadded)
+
+	tests := []struct {
+		name string
+		size int
+		// The transaction can be up to 2 bytes off when the size can't be
+		// hit exactly, and unpadded ones grow with the sequence
+		wantMin, wantMax int
+	}{
+		{"no padding", min, min, min + 2},
+		{"below the unpadded size", min - 50, min, min + 2},
+		{"padded", min + 100, min + 100, min + 102},
+		{"padded across a varint length", min + 200, min + 200, min + 202},
+	}
+	for _, tt := range tests {
+		c := newTestClient(t, f, tt.size)
+		for i := 0; i < 3; i++ {
+			tx, err := c.GenerateTx()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tx) < tt.wantMin || len(tx) > tt.wantMax {
+				t.Errorf("%s: transaction %d is %d bytes, want %d to %d", tt.name, i, len(tx), tt.wantMin, tt.wantMax)
+			}
+		}
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		params  hybridloadtest.ClientParams
+		wantErr bool
+	}{
+		{"default size", 250, nil, false},
+		{"below the unpadded size", 10, nil, false},
+		{"within the longest memo", 500, nil, false},
+		{"beyond the longest memo", 2000, nil, true},
+		{"within a longer memo", 2000, hybridloadtest.ClientParams{"max_memo": "2048"}, false},
+		{"invalid max memo", 250, hybridloadtest.ClientParams{"max_memo": "-1"}, true},
+	}
+	for _, tt := range tests {
+		cfg := loadtest.Config{Connections: 1, Rate: 10, Size: tt.size}
+		if err := newTestFactory().ValidateParams(cfg, tt.params); (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateParams() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
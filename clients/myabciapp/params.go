@@ -0,0 +1,77 @@
+package myabciapp
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// Params configure the transactions CosmosClients generate. They can be
+// overridden for a load test by the client parameters of cosmostx.TxParams and
+// max_memo.
+type Params struct {
+	cosmostx.TxParams
+	// The longest memo the chain accepts, the MaxMemoCharacters parameter of
+	// its auth module. Transactions are padded to the load test's size with
+	// their memo, so this bounds the size.
+	MaxMemo int
+}
+
+// DefaultParams returns the parameters of a chain started with simd's
+// defaults.
+func DefaultParams() Params {
+	return Params{
+		TxParams: cosmostx.TxParams{
+			ChainID:       "testing",
+			Denom:         "stake",
+			GasLimit:      200000,
+			GasPrice:      sdk.ZeroDec(),
+			GasAdjustment: 1.3,
+		},
+		MaxMemo: 256,
+	}
+}
+
+// RegisterFlags binds the parameters to flags prefixed with cosmos-, with the
+// current values as defaults.
+func (p *Params) RegisterFlags(fs *flag.FlagSet) {
+	p.TxParams.RegisterFlags(fs, "cosmos", "test-cosmos-client-factory")
+	fs.IntVar(&p.MaxMemo, "cosmos-max-memo", p.MaxMemo, "Longest memo the chain accepts, which bounds the size of test-cosmos-client-factory transactions")
+}
+
+// Validate checks that the parameters make valid transactions.
+func (p Params) Validate() error {
+	if err := p.TxParams.Validate(); err != nil {
+		return err
+	}
+	if p.MaxMemo < 0 {
+		return fmt.Errorf("max_memo must be >= 0")
+	}
+	return nil
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	for name, value := range params {
+		var err error
+		switch name {
+		case "max_memo":
+			p.MaxMemo, err = strconv.Atoi(value)
+		default:
+			var ok bool
+			if ok, err = p.TxParams.Override(name, value); !ok {
+				return p, fmt.Errorf("unknown parameter %q", name)
+			}
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
@@ -0,0 +1,147 @@
+// Package clients registers the client factories the server and the CLI
+// share: the built-in ones, those of transaction templates and those of
+// plugins.
+package clients
+
+import (
+	"flag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/clients/aiw3defi"
+	"github.com/orijtech/cosmosloadtester/clients/cosmwasm"
+	"github.com/orijtech/cosmosloadtester/clients/mainnet"
+	"github.com/orijtech/cosmosloadtester/clients/msgmix"
+	"github.com/orijtech/cosmosloadtester/clients/myabciapp"
+	"github.com/orijtech/cosmosloadtester/clients/replay"
+	"github.com/orijtech/cosmosloadtester/clients/txtemplate"
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	"github.com/orijtech/cosmosloadtester/pkg/plugin"
+)
+
+// Config configures the client factories RegisterAll registers.
+type Config struct {
+	// The funded accounts the test-cosmos-client-factory, aiw3defi-bank-send
+	// and cosmwasm-execute factories sign with, and the default parameters
+	// of their transactions. The cosmos-msg-mix factory shares those of
+	// test-cosmos-client-factory.
+	CosmosAccounts   cosmostx.AccountConfig
+	CosmosParams     myabciapp.Params
+	AIW3DefiAccounts cosmostx.AccountConfig
+	AIW3DefiParams   aiw3defi.Params
+	WasmAccounts     cosmostx.AccountConfig
+	WasmParams       cosmwasm.Params
+	// The default corpus the replay factory sends, and the transactions the
+	// mainnet-replay factory replays, which it re-signs with the
+	// test-cosmos-client-factory accounts and chain parameters
+	ReplayParams  replay.Params
+	MainnetParams mainnet.Params
+	// Transaction template files, or directories of them, to register a
+	// factory for each template of
+	TxTemplates []string
+	// A directory of plugin binaries whose factories to register, which run
+	// out of process until the program exits
+	PluginsDir string
+}
+
+// DefaultConfig returns the default parameters of each factory, without
+// accounts, templates or plugins.
+func DefaultConfig() Config {
+	return Config{
+		CosmosParams:   myabciapp.DefaultParams(),
+		AIW3DefiParams: aiw3defi.DefaultParams(),
+		WasmParams:     cosmwasm.DefaultParams(),
+		MainnetParams:  mainnet.DefaultParams(cosmostx.TxParams{}),
+	}
+}
+
+// RegisterFlags binds the accounts and parameters of the factories to flags,
+// with the current values as defaults.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	c.CosmosAccounts.RegisterFlags(fs, "cosmos", "test-cosmos-client-factory")
+	c.CosmosParams.RegisterFlags(fs)
+	c.AIW3DefiAccounts.RegisterFlags(fs, "aiw3defi", "aiw3defi")
+	c.AIW3DefiParams.RegisterFlags(fs)
+	c.WasmAccounts.RegisterFlags(fs, "wasm", "cosmwasm")
+	c.WasmParams.RegisterFlags(fs)
+	c.ReplayParams.RegisterFlags(fs)
+	c.MainnetParams.RegisterFlags(fs)
+}
+
+// RegisterAll registers the built-in client factories, which encode
+// transactions with txConfig, along with a factory for each transaction
+// template and the factories of each plugin.
+func RegisterAll(txConfig client.TxConfig, cfg Config) error {
+	mainnetParams := cfg.MainnetParams
+	mainnetParams.TxParams = cfg.CosmosParams.TxParams
+	builtin := []struct {
+		name    string
+		factory loadtest.ClientFactory
+	}{
+		{"test-cosmos-client-factory", myabciapp.NewCosmosClientFactory(txConfig, cfg.CosmosAccounts, cfg.CosmosParams)},
+		{"aiw3defi-bank-send", aiw3defi.NewAIW3DefiClientFactory(txConfig, cfg.AIW3DefiAccounts, cfg.AIW3DefiParams)},
+		{"cosmos-msg-mix", msgmix.NewMsgMixClientFactory(txConfig, cfg.CosmosAccounts, msgmix.DefaultParams(cfg.CosmosParams.TxParams))},
+		{"cosmwasm-execute", cosmwasm.NewCosmWasmClientFactory(txConfig, cfg.WasmAccounts, cfg.WasmParams)},
+		{"replay", replay.NewReplayClientFactory(cfg.ReplayParams)},
+		{"mainnet-replay", mainnet.NewMainnetClientFactory(txConfig, cfg.CosmosAccounts, mainnetParams)},
+	}
+	for _, b := range builtin {
+		if err := register(b.name, b.factory); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.TxTemplates) > 0 {
+		specs, err := txtemplate.LoadSpecs(cfg.TxTemplates)
+		if err != nil {
+			return errors.NewConfigError(errors.ErrCodeInvalidConfig,
+				"failed to load transaction templates").
+				WithDetails(err.Error())
+		}
+		for _, spec := range specs {
+			templateClientFactory, err := txtemplate.Compile(spec, txConfig)
+			if err != nil {
+				return errors.NewConfigError(errors.ErrCodeInvalidConfig,
+					"failed to compile transaction template").
+					WithContext("factory_name", spec.Name).
+					WithDetails(err.Error())
+			}
+			if err := register(templateClientFactory.Name(), templateClientFactory); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfg.PluginsDir != "" {
+		plugins, err := plugin.Load(cfg.PluginsDir)
+		if err != nil {
+			return errors.NewConfigError(errors.ErrCodeInvalidConfig,
+				"failed to load plugins").
+				WithContext("plugins_dir", cfg.PluginsDir).
+				WithDetails(err.Error())
+		}
+		for _, p := range plugins {
+			for _, name := range p.Names() {
+				if err := register(name, p.ClientFactory(name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func register(name string, factory loadtest.ClientFactory) error {
+	logrus.Debugf("Registering %s", name)
+	if err := hybridloadtest.RegisterClientFactory(name, factory); err != nil {
+		return errors.NewClientFactoryError(errors.ErrCodeClientFactoryNotFound,
+			"failed to register "+name).
+			WithContext("factory_name", name).
+			WithDetails(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/corpus"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// ReplayClientFactory creates clients that send the pre-signed transactions
+// of a corpus, which the generate mode of the CLI writes
+type ReplayClientFactory struct {
+	params Params
+
+	mtx sync.Mutex
+	// The senders handed out and readers opened for the current load test
+	run *replayRun
+}
+
+// replayRun is what the clients of a load test share: the next sender of each
+// corpus file to hand out, and the readers opened for them, which are closed
+// once the load test is over.
+type replayRun struct {
+	next    map[string]int
+	readers []*corpus.Reader
+}
+
+func newReplayRun() *replayRun {
+	return &replayRun{next: make(map[string]int)}
+}
+
+var (
+	_ loadtest.ClientFactory             = (*ReplayClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*ReplayClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*ReplayClientFactory)(nil)
+	_ hybridloadtest.Preparer            = (*ReplayClientFactory)(nil)
+)
+
+// NewReplayClientFactory creates a factory for clients that replay a corpus,
+// with the given default parameters
+func NewReplayClientFactory(params Params) *ReplayClientFactory {
+	return &ReplayClientFactory{
+		params: params,
+		run:    newReplayRun(),
+	}
+}
+
+// ReplayClient sends the transactions of one sender of a corpus in the order
+// they were signed, so that their sequences follow each other
+type ReplayClient struct {
+	reader *corpus.Reader
+	sender int
+}
+
+var (
+	_ loadtest.Client                = (*ReplayClient)(nil)
+	_ hybridloadtest.SequencedClient = (*ReplayClient)(nil)
+)
+
+func (f *ReplayClientFactory) ValidateConfig(cfg loadtest.Config) error {
+	return f.ValidateParams(cfg, nil)
+}
+
+// ValidateParams validates the configuration along with the factory's
+// parameters overridden by params. The corpus needs a sender for every
+// connection, as senders' transactions can't be sent twice.
+func (f *ReplayClientFactory) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	if cfg.Connections <= 0 {
+		return fmt.Errorf("connections must be > 0")
+	}
+	if cfg.Rate <= 0 {
+		return fmt.Errorf("rate must be > 0")
+	}
+	p, err := f.params.WithOverrides(params)
+	if err != nil {
+		return fmt.Errorf("invalid client parameters: %w", err)
+	}
+	header, err := f.header(p)
+	if err != nil {
+		return err
+	}
+	if clients := len(cfg.Endpoints) * cfg.Connections; clients > header.Senders {
+		return fmt.Errorf("corpus %s has %d senders, fewer than the %d connections of the load test", p.Corpus, header.Senders, clients)
+	}
+	if cfg.Count > 0 && cfg.Count > header.Txs() {
+		logrus.Warnf("Corpus %s holds %d transactions, fewer than the count of %d", p.Corpus, header.Txs(), cfg.Count)
+	}
+	return nil
+}
+
+// ChainID returns the chain ID the corpus is signed for
+func (f *ReplayClientFactory) ChainID(params hybridloadtest.ClientParams) (string, error) {
+	p, err := f.params.WithOverrides(params)
+	if err != nil {
+		return "", fmt.Errorf("invalid client parameters: %w", err)
+	}
+	header, err := f.header(p)
+	if err != nil {
+		return "", err
+	}
+	return header.ChainID, nil
+}
+
+// header reads the header of the corpus, and checks its chain ID if the
+// parameters set one
+func (f *ReplayClientFactory) header(p Params) (corpus.Header, error) {
+	header, err := corpus.ReadHeader(p.Corpus)
+	if err != nil {
+		return header, fmt.Errorf("failed to read corpus %s: %w", p.Corpus, err)
+	}
+	if p.ChainID != "" && header.ChainID != p.ChainID {
+		return header, fmt.Errorf("corpus %s is signed for chain %q, not %q", p.Corpus, header.ChainID, p.ChainID)
+	}
+	return header, nil
+}
+
+func (f *ReplayClientFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
+	return f.NewClientWithParams(cfg, nil)
+}
+
+// Prepare starts handing out the senders of each corpus from the first one
+// again. The returned teardown closes the corpus files of the clients created
+// for the load test, which stop sending once it is over.
+func (f *ReplayClientFactory) Prepare(cfg loadtest.Config, params hybridloadtest.ClientParams) (hybridloadtest.Teardown, error) {
+	run := newReplayRun()
+	f.mtx.Lock()
+	f.run = run
+	f.mtx.Unlock()
+
+	return func() error {
+		f.mtx.Lock()
+		readers := run.readers
+		run.readers = nil
+		f.mtx.Unlock()
+
+		var errs []error
+		for _, reader := range readers {
+			if err := reader.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// NewClientWithParams creates a client that replays the next sender of the
+// corpus set by the factory's parameters overridden by params. Senders are
+// handed out in turn, from the first one for each load test, starting over
+// once all of them were.
+func (f *ReplayClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.params.WithOverrides(params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client parameters: %w", err)
+	}
+	header, err := f.header(p)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	run := f.run
+	sender := run.next[p.Corpus] % header.Senders
+	run.next[p.Corpus] = sender + 1
+
+	reader, err := corpus.OpenSender(p.Corpus, sender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open corpus %s: %w", p.Corpus, err)
+	}
+	run.readers = append(run.readers, reader)
+	return &ReplayClient{reader: reader, sender: sender}, nil
+}
+
+// Sequenced reports that the corpus's transactions carry sequences, which
+// follow each other for each sender.
+func (c *ReplayClient) Sequenced() bool {
+	return true
+}
+
+// GenerateTx returns the sender's next pre-signed transaction. Running out of
+// them fails the load test, so the corpus needs at least as many transactions
+// per sender as a connection sends.
+func (c *ReplayClient) GenerateTx() ([]byte, error) {
+	tx, err := c.reader.Next()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("sender %d of the corpus has no transactions left", c.sender)
+	}
+	return tx, err
+}
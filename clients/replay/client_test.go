@@ -0,0 +1,132 @@
+package replay
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/corpus"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// writeCorpus writes a corpus for test-chain of the given senders, whose
+// transactions are "s<sender>-t<index>", and returns its path.
+func writeCorpus(t *testing.T, senders, txsPerSender int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	w, err := corpus.Create(path, corpus.Header{
+		ChainID:      "test-chain",
+		Factory:      "test-factory",
+		Senders:      senders,
+		TxsPerSender: txsPerSender,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for sender := 0; sender < senders; sender++ {
+		for i := 0; i < txsPerSender; i++ {
+			if err := w.Write([]byte(fmt.Sprintf("s%d-t%d", sender, i))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestValidateParams(t *testing.T) {
+	path := writeCorpus(t, 2, 1)
+	tests := []struct {
+		name    string
+		cfg     loadtest.Config
+		params  hybridloadtest.ClientParams
+		wantErr bool
+	}{
+		{"valid", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 2, Rate: 1}, nil, false},
+		{"matching chain ID", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1, Rate: 1}, hybridloadtest.ClientParams{"chain_id": "test-chain"}, false},
+		{"other chain ID", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1, Rate: 1}, hybridloadtest.ClientParams{"chain_id": "mainnet"}, true},
+		{"fewer senders than connections", loadtest.Config{Endpoints: []string{"ws://a", "ws://b"}, Connections: 2, Rate: 1}, nil, true},
+		{"no connections", loadtest.Config{Endpoints: []string{"ws://a"}, Rate: 1}, nil, true},
+		{"no rate", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1}, nil, true},
+		{"missing corpus", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1, Rate: 1}, hybridloadtest.ClientParams{"corpus": filepath.Join(t.TempDir(), "missing.bin")}, true},
+		{"no corpus", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1, Rate: 1}, hybridloadtest.ClientParams{"corpus": ""}, true},
+		{"unknown parameter", loadtest.Config{Endpoints: []string{"ws://a"}, Connections: 1, Rate: 1}, hybridloadtest.ClientParams{"denom": "stake"}, true},
+	}
+	for _, tt := range tests {
+		f := NewReplayClientFactory(Params{Corpus: path})
+		if err := f.ValidateParams(tt.cfg, tt.params); (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateParams() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestChainID(t *testing.T) {
+	f := NewReplayClientFactory(Params{Corpus: writeCorpus(t, 1, 1)})
+	if chainID, err := f.ChainID(nil); err != nil || chainID != "test-chain" {
+		t.Errorf("ChainID() = %q, %v, want test-chain", chainID, err)
+	}
+}
+
+func TestReplayClient(t *testing.T) {
+	f := NewReplayClientFactory(Params{Corpus: writeCorpus(t, 2, 2)})
+	// Senders are handed out in turn, starting over after the last one
+	tests := []struct {
+		want    []string
+		wantErr bool
+	}{
+		{[]string{"s0-t0", "s0-t1"}, true},
+		{[]string{"s1-t0", "s1-t1"}, true},
+		{[]string{"s0-t0"}, false},
+	}
+	for i, tt := range tests {
+		client, err := f.NewClient(loadtest.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			tx, err := client.GenerateTx()
+			if err != nil {
+				t.Fatalf("client %d: %v", i, err)
+			}
+			if string(tx) != want {
+				t.Errorf("client %d: GenerateTx() = %s, want %s", i, tx, want)
+			}
+		}
+		if tt.wantErr {
+			if _, err := client.GenerateTx(); err == nil {
+				t.Errorf("client %d: GenerateTx() past the sender's transactions succeeded", i)
+			}
+		}
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	f := NewReplayClientFactory(Params{Corpus: writeCorpus(t, 2, 2)})
+	if _, err := f.NewClient(loadtest.Config{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new load test starts over from the first sender
+	teardown, err := f.Prepare(loadtest.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := f.NewClient(loadtest.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx, err := client.GenerateTx(); err != nil || string(tx) != "s0-t0" {
+		t.Errorf("GenerateTx() = %s, %v, want s0-t0", tx, err)
+	}
+
+	// Once it is over, the corpus files of its clients are closed
+	if err := teardown(); err != nil {
+		t.Errorf("teardown() = %v", err)
+	}
+}
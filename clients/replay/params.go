@@ -0,0 +1,48 @@
+package replay
+
+import (
+	"flag"
+	"fmt"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// Params configure which corpus replay clients send. They can be overridden
+// for a load test by the client parameters corpus and chain_id.
+type Params struct {
+	// The corpus file of pre-signed transactions
+	Corpus string
+	// The chain the corpus must be signed for, not checked if empty
+	ChainID string
+}
+
+// RegisterFlags binds the parameters to flags prefixed with replay-, with the
+// current values as defaults.
+func (p *Params) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&p.Corpus, "replay-corpus", p.Corpus, "Corpus file of pre-signed transactions the replay factory sends")
+	fs.StringVar(&p.ChainID, "replay-chain-id", p.ChainID, "Chain ID the replayed corpus must be signed for (not checked if empty)")
+}
+
+// Validate checks that a corpus is set.
+func (p Params) Validate() error {
+	if p.Corpus == "" {
+		return fmt.Errorf("corpus must be set")
+	}
+	return nil
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p Params) WithOverrides(params hybridloadtest.ClientParams) (Params, error) {
+	for name, value := range params {
+		switch name {
+		case "corpus":
+			p.Corpus = value
+		case "chain_id":
+			p.ChainID = value
+		default:
+			return p, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+	return p, p.Validate()
+}
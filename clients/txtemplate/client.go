@@ -0,0 +1,206 @@
+package txtemplate
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// TemplateClientFactory creates clients that generate the messages of a Spec
+type TemplateClientFactory struct {
+	cosmostx.Factory[cosmostx.TxParams]
+	name     string
+	cdc      *codec.ProtoCodec
+	registry codectypes.InterfaceRegistry
+	strategy string
+	memo     *template.Template
+	messages []message
+
+	// The pool whose accounts were all refreshed, for the rotate strategy
+	refreshMtx sync.Mutex
+	refreshed  *cosmostx.AccountPool
+}
+
+var (
+	_ loadtest.ClientFactory             = (*TemplateClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*TemplateClientFactory)(nil)
+	_ hybridloadtest.AccountFunder       = (*TemplateClientFactory)(nil)
+	_ hybridloadtest.ChainIDFactory      = (*TemplateClientFactory)(nil)
+	_ hybridloadtest.AccountPoolFactory  = (*TemplateClientFactory)(nil)
+)
+
+// Name returns the name the factory is registered under
+func (f *TemplateClientFactory) Name() string {
+	return f.name
+}
+
+// TemplateClient generates transactions of one message each, picked at random
+// by weight and rendered from its template
+type TemplateClient struct {
+	// Signs with the last of the client's accounts it signed with
+	*cosmostx.Sender
+	factory *TemplateClientFactory
+	// The gas limit of each message
+	gasLimits []uint64
+	// The cumulative weights of the messages, to pick from
+	cumWeights []int
+	rand       *rand.Rand
+	counter    uint64
+	// The accounts the client signs with in turn
+	signers []*cosmostx.Account
+	next    int
+}
+
+var (
+	_ loadtest.Client                 = (*TemplateClient)(nil)
+	_ hybridloadtest.RejectionHandler = (*TemplateClient)(nil)
+)
+
+// NewClientWithParams creates a client with the spec's fee parameters
+// overridden by params
+func (f *TemplateClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := f.Signers().ClientPool()
+	if err != nil {
+		return nil, err
+	}
+	signers, err := f.clientSigners(pool, cfg.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &TemplateClient{
+		Sender:  cosmostx.NewSender(f.TxConfig(), p, pool, signers[0], cfg.Endpoints),
+		factory: f,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		signers: signers,
+	}
+	total := 0
+	for _, msg := range f.messages {
+		total += msg.weight
+		c.cumWeights = append(c.cumWeights, total)
+		c.gasLimits = append(c.gasLimits, p.GasLimit)
+	}
+	if p.SimulateGas {
+		// Each message gets the gas a simulated transaction with it, signed
+		// by the first account, uses
+		for i := range f.messages {
+			gasLimit, err := c.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+				return c.sign(i, accountNumber, sequence)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", f.messages[i].typeURL, err)
+			}
+			c.gasLimits[i] = gasLimit
+		}
+	}
+	return c, nil
+}
+
+// PoolSize returns the number of accounts new clients sign with, or 1 when
+// all clients sign with the same accounts as the single and rotate signer
+// strategies have it
+func (f *TemplateClientFactory) PoolSize(params hybridloadtest.ClientParams) (int, error) {
+	if f.strategy != strategyRoundRobin {
+		return 1, nil
+	}
+	return f.Factory.PoolSize(params)
+}
+
+// clientSigners returns the accounts a new client signs with, refreshed from
+// the chain, as the signer strategy has it.
+func (f *TemplateClientFactory) clientSigners(pool *cosmostx.AccountPool, endpoints []string) ([]*cosmostx.Account, error) {
+	switch f.strategy {
+	case strategySingle:
+		first := pool.Accounts()[0]
+		if err := f.refreshAll(pool, endpoints, []*cosmostx.Account{first}); err != nil {
+			return nil, err
+		}
+		return []*cosmostx.Account{first}, nil
+	case strategyRotate:
+		if err := f.refreshAll(pool, endpoints, pool.Accounts()); err != nil {
+			return nil, err
+		}
+		return pool.Accounts(), nil
+	default:
+		sender, err := pool.Acquire(endpoints)
+		if err != nil {
+			return nil, err
+		}
+		return []*cosmostx.Account{sender}, nil
+	}
+}
+
+// refreshAll refreshes the accounts once per pool, so that clients sharing
+// them don't reset each other's sequences.
+func (f *TemplateClientFactory) refreshAll(pool *cosmostx.AccountPool, endpoints []string, accounts []*cosmostx.Account) error {
+	f.refreshMtx.Lock()
+	defer f.refreshMtx.Unlock()
+	if f.refreshed == pool {
+		return nil
+	}
+	for _, acc := range accounts {
+		if err := pool.Refresh(endpoints, acc); err != nil {
+			return err
+		}
+	}
+	f.refreshed = pool
+	return nil
+}
+
+// GenerateTx creates a transaction with a message picked by weight, signed by
+// the next of the client's accounts
+func (c *TemplateClient) GenerateTx() ([]byte, error) {
+	n := c.rand.Intn(c.cumWeights[len(c.cumWeights)-1])
+	i := len(c.cumWeights) - 1
+	for j, cumWeight := range c.cumWeights {
+		if n < cumWeight {
+			i = j
+			break
+		}
+	}
+
+	c.Account = c.signers[c.next%len(c.signers)]
+	c.next++
+	// Reserve a sequence, shared with the other clients of the signer
+	accountNumber, sequence := c.Account.NextSequence()
+	tx, err := c.sign(i, accountNumber, sequence)
+	if err != nil {
+		return nil, err
+	}
+	c.counter++
+	return tx, nil
+}
+
+// sign renders the i-th message and signs a transaction with it as the
+// client's current account
+func (c *TemplateClient) sign(i int, accountNumber, sequence uint64) ([]byte, error) {
+	vars := cosmostx.TemplateVars{
+		Sender:    c.Account.String(),
+		Recipient: c.Pool.After(c.Account).String(),
+		Sequence:  sequence,
+		Counter:   c.counter,
+	}
+	msg, err := c.factory.render(c.factory.messages[i], vars)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := execute(c.factory.memo, vars)
+	if err != nil {
+		return nil, err
+	}
+	return c.Sign(accountNumber, sequence, []sdk.Msg{msg}, c.gasLimits[i], memo)
+}
@@ -0,0 +1,111 @@
+package txtemplate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// newTestNode starts a JSON-RPC server that answers account queries through
+// abci_query.
+func newTestNode(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "abci_query" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		account, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{AccountNumber: 1})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		value, err := (&authtypes.QueryAccountResponse{Account: account}).Marshal()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"response": map[string]any{"code": 0, "value": value}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGenerateTxStrategies(t *testing.T) {
+	t.Setenv("TXTEMPLATE_TEST_MNEMONIC", testMnemonic)
+	tests := []struct {
+		strategy string
+		// The senders of two clients' first three transactions, by index
+		// in the pool
+		want [2][3]int
+	}{
+		{strategyRoundRobin, [2][3]int{{0, 0, 0}, {1, 1, 1}}},
+		{strategySingle, [2][3]int{{0, 0, 0}, {0, 0, 0}}},
+		{strategyRotate, [2][3]int{{0, 1, 2}, {0, 1, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			srv := newTestNode(t)
+			spec := Spec{
+				Name:     "send",
+				ChainID:  "testing",
+				Fee:      FeeSpec{Denom: "stake"},
+				Signers:  SignerSpec{Strategy: tt.strategy, MnemonicEnv: "TXTEMPLATE_TEST_MNEMONIC", Accounts: 3},
+				Memo:     "LoadTest:{{.Counter}}",
+				Messages: []MessageSpec{sendSpec("1")},
+			}
+			txConfig := newTestTxConfig()
+			f, err := Compile(spec, txConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pool, err := f.Signers().Pool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			accounts := pool.Accounts()
+
+			for i, want := range tt.want {
+				client, err := f.NewClient(loadtest.Config{Endpoints: []string{srv.URL}})
+				if err != nil {
+					t.Fatal(err)
+				}
+				for j, index := range want {
+					txBytes, err := client.GenerateTx()
+					if err != nil {
+						t.Fatal(err)
+					}
+					tx, err := txConfig.TxDecoder()(txBytes)
+					if err != nil {
+						t.Fatal(err)
+					}
+					send := tx.GetMsgs()[0].(*banktypes.MsgSend)
+					sender, recipient := accounts[index], pool.After(accounts[index])
+					if send.FromAddress != sender.String() || send.ToAddress != recipient.String() {
+						t.Errorf("client %d transaction %d sends from %s to %s, want from account %d to the next", i, j, send.FromAddress, send.ToAddress, index)
+					}
+					if client.(*TemplateClient).Account != sender {
+						t.Errorf("client %d transaction %d: the sender's account isn't the last signer", i, j)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package txtemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+// Signer strategies
+const (
+	strategyRoundRobin = "round_robin"
+	strategySingle     = "single"
+	strategyRotate     = "rotate"
+)
+
+// Defaults of the fee spec
+const (
+	defaultGasLimit      = 200000
+	defaultGasAdjustment = 1.3
+)
+
+// message is a compiled MessageSpec.
+type message struct {
+	typeURL string
+	weight  int
+	// The fields, with string values that are templates parsed
+	fields map[string]interface{}
+}
+
+// Compile checks the spec and creates its client factory. Message type URLs
+// are resolved against the SDK's modules and their templates are rendered
+// once with placeholder variables, so that mistakes show up at startup
+// rather than during a load test.
+func Compile(spec Spec, txConfig client.TxConfig) (*TemplateClientFactory, error) {
+	if spec.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	params := cosmostx.TxParams{
+		ChainID:       spec.ChainID,
+		Denom:         spec.Fee.Denom,
+		GasLimit:      spec.Fee.GasLimit,
+		GasPrice:      sdk.ZeroDec(),
+		SimulateGas:   spec.Fee.SimulateGas,
+		GasAdjustment: spec.Fee.GasAdjustment,
+	}
+	if params.GasLimit == 0 {
+		params.GasLimit = defaultGasLimit
+	}
+	if params.GasAdjustment == 0 {
+		params.GasAdjustment = defaultGasAdjustment
+	}
+	if spec.Fee.GasPrice != "" {
+		gasPrice, err := sdk.NewDecFromStr(spec.Fee.GasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid gas_price %q: %w", spec.Name, spec.Fee.GasPrice, err)
+		}
+		params.GasPrice = gasPrice
+	}
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", spec.Name, err)
+	}
+
+	strategy := spec.Signers.Strategy
+	switch strategy {
+	case "":
+		strategy = strategyRoundRobin
+	case strategyRoundRobin, strategySingle, strategyRotate:
+	default:
+		return nil, fmt.Errorf("%s: unknown signer strategy %q, expected %s, %s or %s", spec.Name, strategy, strategyRoundRobin, strategySingle, strategyRotate)
+	}
+
+	memo, err := parseTemplate("memo", spec.Memo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", spec.Name, err)
+	}
+
+	registry := cosmostx.NewInterfaceRegistry()
+	f := &TemplateClientFactory{
+		name:     spec.Name,
+		cdc:      codec.NewProtoCodec(registry),
+		registry: registry,
+		strategy: strategy,
+		memo:     memo,
+	}
+
+	f.Factory = cosmostx.NewFactory[cosmostx.TxParams](f, txConfig, spec.accountConfig(), params)
+
+	if len(spec.Messages) == 0 {
+		return nil, fmt.Errorf("%s: at least one message is required", spec.Name)
+	}
+	placeholder := cosmostx.TemplateVars{}
+	placeholder.Sender = placeholder.RandomAddress()
+	placeholder.Recipient = placeholder.RandomAddress()
+	total := 0
+	for i, msgSpec := range spec.Messages {
+		msg := message{typeURL: msgSpec.TypeURL, weight: 1}
+		if msgSpec.Weight != nil {
+			msg.weight = *msgSpec.Weight
+		}
+		if msg.weight < 0 {
+			return nil, fmt.Errorf("%s: message %d: weight must be >= 0", spec.Name, i)
+		}
+		total += msg.weight
+
+		fields, err := parseFields(msgSpec.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s: message %d: %w", spec.Name, i, err)
+		}
+		msg.fields = fields.(map[string]interface{})
+		if _, err := f.render(msg, placeholder); err != nil {
+			return nil, fmt.Errorf("%s: message %d: %w", spec.Name, i, err)
+		}
+		f.messages = append(f.messages, msg)
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("%s: at least one message must have a positive weight", spec.Name)
+	}
+	return f, nil
+}
+
+// accountConfig returns the account configuration of the signers.
+func (s Spec) accountConfig() cosmostx.AccountConfig {
+	config := cosmostx.AccountConfig{
+		MnemonicFile:   s.Signers.MnemonicFile,
+		Accounts:       s.Signers.Accounts,
+		KeyringDir:     s.Signers.KeyringDir,
+		KeyringBackend: s.Signers.KeyringBackend,
+		KeyNames:       s.Signers.KeyNames,
+		AddressPrefix:  s.Signers.AddressPrefix,
+		GRPCAddress:    s.Signers.GRPCAddress,
+	}
+	if s.Signers.MnemonicEnv != "" {
+		config.Mnemonic = os.Getenv(s.Signers.MnemonicEnv)
+	}
+	if config.MnemonicFile != "" && !filepath.IsAbs(config.MnemonicFile) {
+		config.MnemonicFile = filepath.Join(s.dir, config.MnemonicFile)
+	}
+	if config.KeyringDir != "" && !filepath.IsAbs(config.KeyringDir) {
+		config.KeyringDir = filepath.Join(s.dir, config.KeyringDir)
+	}
+	if config.KeyringBackend == "" {
+		config.KeyringBackend = "test"
+	}
+	return config
+}
+
+// parseFields replaces the string values of fields that hold templates with
+// the parsed templates.
+func parseFields(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case string:
+		if !strings.Contains(value, "{{") {
+			return value, nil
+		}
+		return parseTemplate("field", value)
+	case map[string]interface{}:
+		parsed := make(map[string]interface{}, len(value))
+		for key, v := range value {
+			p, err := parseFields(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			parsed[key] = p
+		}
+		return parsed, nil
+	case []interface{}:
+		parsed := make([]interface{}, len(value))
+		for i, v := range value {
+			p, err := parseFields(v)
+			if err != nil {
+				return nil, fmt.Errorf("%d: %w", i, err)
+			}
+			parsed[i] = p
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(cosmostx.TemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func execute(tmpl *template.Template, vars cosmostx.TemplateVars) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// renderFields renders the templates of parsed fields.
+func renderFields(value interface{}, vars cosmostx.TemplateVars) (interface{}, error) {
+	switch value := value.(type) {
+	case *template.Template:
+		return execute(value, vars)
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(value))
+		for key, v := range value {
+			r, err := renderFields(v, vars)
+			if err != nil {
+				return nil, err
+			}
+			rendered[key] = r
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, len(value))
+		for i, v := range value {
+			r, err := renderFields(v, vars)
+			if err != nil {
+				return nil, err
+			}
+			rendered[i] = r
+		}
+		return rendered, nil
+	default:
+		return value, nil
+	}
+}
+
+// render renders the fields of the message and decodes them as its protobuf
+// JSON.
+func (f *TemplateClientFactory) render(msg message, vars cosmostx.TemplateVars) (sdk.Msg, error) {
+	resolved, err := f.registry.Resolve(msg.typeURL)
+	if err != nil {
+		return nil, err
+	}
+	sdkMsg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", msg.typeURL)
+	}
+
+	fields, err := renderFields(msg.fields, vars)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fields: %w", err)
+	}
+	if err := f.cdc.UnmarshalJSON(bz, sdkMsg); err != nil {
+		return nil, fmt.Errorf("fields don't match %s: %w", msg.typeURL, err)
+	}
+	return sdkMsg, nil
+}
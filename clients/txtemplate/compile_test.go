@@ -0,0 +1,97 @@
+package txtemplate
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/orijtech/cosmosloadtester/pkg/cosmostx"
+)
+
+func newTestTxConfig() client.TxConfig {
+	return authtx.NewTxConfig(codec.NewProtoCodec(cosmostx.NewInterfaceRegistry()), authtx.DefaultSignModes)
+}
+
+// sendSpec returns a spec of bank sends of the given amount template to the
+// next account.
+func sendSpec(amount string) MessageSpec {
+	return MessageSpec{
+		TypeURL: "/cosmos.bank.v1beta1.MsgSend",
+		Fields: map[string]interface{}{
+			"from_address": "{{.Sender}}",
+			"to_address":   "{{.Recipient}}",
+			"amount":       []interface{}{map[string]interface{}{"denom": "stake", "amount": amount}},
+		},
+	}
+}
+
+func TestCompile(t *testing.T) {
+	zero, negative := 0, -1
+	valid := Spec{Name: "send", ChainID: "testing", Fee: FeeSpec{Denom: "stake"}, Messages: []MessageSpec{sendSpec("{{randInt 1 100}}")}}
+	tests := []struct {
+		name    string
+		change  func(s *Spec)
+		wantErr bool
+	}{
+		{"valid", func(s *Spec) {}, false},
+		{"gas price and strategy", func(s *Spec) { s.Fee.GasPrice = "0.025"; s.Signers.Strategy = strategyRotate }, false},
+		{"no name", func(s *Spec) { s.Name = "" }, true},
+		{"no chain ID", func(s *Spec) { s.ChainID = "" }, true},
+		{"no denom", func(s *Spec) { s.Fee.Denom = "" }, true},
+		{"invalid gas price", func(s *Spec) { s.Fee.GasPrice = "cheap" }, true},
+		{"unknown strategy", func(s *Spec) { s.Signers.Strategy = "random" }, true},
+		{"invalid memo", func(s *Spec) { s.Memo = "{{.Counter" }, true},
+		{"no messages", func(s *Spec) { s.Messages = nil }, true},
+		{"unknown type URL", func(s *Spec) { s.Messages = []MessageSpec{{TypeURL: "/cosmos.bank.v1beta1.MsgBurn"}} }, true},
+		{"unknown field", func(s *Spec) { s.Messages[0].Fields = map[string]interface{}{"sender": "{{.Sender}}"} }, true},
+		{"invalid field template", func(s *Spec) { s.Messages = []MessageSpec{sendSpec("{{randInt 1}")} }, true},
+		{"unknown field variable", func(s *Spec) { s.Messages = []MessageSpec{sendSpec("{{.Amount}}")} }, true},
+		{"negative weight", func(s *Spec) { s.Messages[0].Weight = &negative }, true},
+		{"no positive weight", func(s *Spec) { s.Messages[0].Weight = &zero }, true},
+	}
+	for _, tt := range tests {
+		spec := valid
+		spec.Messages = []MessageSpec{sendSpec("{{randInt 1 100}}")}
+		tt.change(&spec)
+		_, err := Compile(spec, newTestTxConfig())
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Compile() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCompileExample(t *testing.T) {
+	specs, err := LoadSpecs([]string{"../../examples/templates"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, spec := range specs {
+		if _, err := Compile(spec, newTestTxConfig()); err != nil {
+			t.Errorf("Compile(%s) error: %v", spec.Name, err)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	spec := Spec{Name: "send", ChainID: "testing", Fee: FeeSpec{Denom: "stake"}, Messages: []MessageSpec{sendSpec("{{.Counter}}7")}}
+	f, err := Compile(spec, newTestTxConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars := cosmostx.TemplateVars{Counter: 4}
+	vars.Sender, vars.Recipient = vars.RandomAddress(), vars.RandomAddress()
+	msg, err := f.render(f.messages[0], vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		t.Fatalf("render() = %T, want a MsgSend", msg)
+	}
+	if send.FromAddress != vars.Sender || send.ToAddress != vars.Recipient || send.Amount.String() != "47stake" {
+		t.Errorf("render() = %v, want 47stake from %s to %s", send, vars.Sender, vars.Recipient)
+	}
+}
@@ -0,0 +1,157 @@
+package txtemplate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a template client factory. It is read from a YAML or JSON
+// file such as:
+//
+//	name: bank-send-template
+//	chain_id: testing
+//	fee:
+//	  denom: stake
+//	  gas_limit: 200000
+//	  gas_price: "0.025"
+//	signers:
+//	  strategy: round_robin
+//	  mnemonic_env: LOADTEST_MNEMONIC
+//	  accounts: 10
+//	messages:
+//	  - type_url: /cosmos.bank.v1beta1.MsgSend
+//	    weight: 3
+//	    fields:
+//	      from_address: "{{.Sender}}"
+//	      to_address: "{{.RandomAddress}}"
+//	      amount:
+//	        - denom: stake
+//	          amount: "{{randInt 1 100}}"
+//
+// String fields are templates rendered with cosmostx.TemplateVars and
+// cosmostx.TemplateFuncs for every transaction. The rendered fields are the
+// protobuf JSON of the message.
+type Spec struct {
+	// The name the factory is registered under
+	Name     string        `yaml:"name"`
+	ChainID  string        `yaml:"chain_id"`
+	Fee      FeeSpec       `yaml:"fee"`
+	Signers  SignerSpec    `yaml:"signers"`
+	Memo     string        `yaml:"memo"`
+	Messages []MessageSpec `yaml:"messages"`
+
+	// The directory of the file the spec was read from, which relative
+	// paths in it are relative to
+	dir string
+}
+
+// FeeSpec configures the gas and fees of transactions.
+type FeeSpec struct {
+	Denom string `yaml:"denom"`
+	// Defaults to 200000
+	GasLimit uint64 `yaml:"gas_limit"`
+	// The fee per unit of gas, in Denom. Defaults to 0.
+	GasPrice string `yaml:"gas_price"`
+	// Whether to estimate the gas limit of each message by simulating it,
+	// and the factor the estimate is multiplied by, which defaults to 1.3
+	SimulateGas   bool    `yaml:"simulate_gas"`
+	GasAdjustment float64 `yaml:"gas_adjustment"`
+}
+
+// SignerSpec configures the funded accounts transactions are signed with, and
+// how clients take turns with them.
+type SignerSpec struct {
+	// One of round_robin, the default, where each client signs with the
+	// next account of the pool, single, where every client signs with the
+	// first account, and rotate, where each transaction is signed with the
+	// next account of the pool
+	Strategy string `yaml:"strategy"`
+	// The environment variable holding the mnemonic, or the file holding it,
+	// and the number of accounts to derive from it
+	MnemonicEnv  string `yaml:"mnemonic_env"`
+	MnemonicFile string `yaml:"mnemonic_file"`
+	Accounts     int    `yaml:"accounts"`
+	// A keyring holding the keys, instead of a mnemonic
+	KeyringDir     string   `yaml:"keyring_dir"`
+	KeyringBackend string   `yaml:"keyring_backend"`
+	KeyNames       []string `yaml:"key_names"`
+	AddressPrefix  string   `yaml:"address_prefix"`
+	// The gRPC address to query accounts through, instead of /abci_query
+	GRPCAddress string `yaml:"grpc"`
+}
+
+// MessageSpec describes a message, which transactions hold one of.
+type MessageSpec struct {
+	// The type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+	TypeURL string `yaml:"type_url"`
+	// The relative frequency of the message. Defaults to 1.
+	Weight *int `yaml:"weight"`
+	// The fields of the message, as in its protobuf JSON, with templates
+	// as string values
+	Fields map[string]interface{} `yaml:"fields"`
+}
+
+// LoadSpecs reads the specs in the given files, and in the .yaml, .yml and
+// .json files of the given directories.
+func LoadSpecs(paths []string) ([]Spec, error) {
+	var files []string
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		var dirFiles []string
+		for _, entry := range entries {
+			switch filepath.Ext(entry.Name()) {
+			case ".yaml", ".yml", ".json":
+				dirFiles = append(dirFiles, filepath.Join(path, entry.Name()))
+			}
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+
+	specs := make([]Spec, 0, len(files))
+	for _, file := range files {
+		spec, err := loadSpec(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// loadSpec reads a spec, rejecting unknown keys. JSON is read as YAML.
+func loadSpec(file string) (Spec, error) {
+	var spec Spec
+	blob, err := os.ReadFile(file)
+	if err != nil {
+		return spec, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(blob))
+	dec.KnownFields(true)
+	if err := dec.Decode(&spec); err != nil {
+		return spec, fmt.Errorf("failed to parse template: %w", err)
+	}
+	spec.dir = filepath.Dir(file)
+	return spec, nil
+}
@@ -0,0 +1,77 @@
+package txtemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadSpecs(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "b.yaml"), "name: b\nchain_id: testing\n")
+	writeFile(t, filepath.Join(dir, "a.json"), `{"name": "a", "chain_id": "testing", "fee": {"denom": "stake"}}`)
+	writeFile(t, filepath.Join(dir, "notes.txt"), "not a spec")
+	single := filepath.Join(t.TempDir(), "single.yml")
+	writeFile(t, single, "name: single\n")
+	unknown := filepath.Join(t.TempDir(), "unknown.yaml")
+	writeFile(t, unknown, "name: unknown\nchain: testing\n")
+
+	tests := []struct {
+		name      string
+		paths     []string
+		wantNames []string
+		wantErr   bool
+	}{
+		{"directory", []string{dir}, []string{"a", "b"}, false},
+		{"file", []string{single}, []string{"single"}, false},
+		{"directory and file", []string{single, " ", dir}, []string{"single", "a", "b"}, false},
+		{"none", nil, nil, false},
+		{"unknown key", []string{unknown}, nil, true},
+		{"missing", []string{filepath.Join(dir, "missing.yaml")}, nil, true},
+	}
+	for _, tt := range tests {
+		specs, err := LoadSpecs(tt.paths)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: LoadSpecs() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(specs) != len(tt.wantNames) {
+			t.Errorf("%s: LoadSpecs() = %d specs, want %d", tt.name, len(specs), len(tt.wantNames))
+			continue
+		}
+		for i, spec := range specs {
+			if spec.Name != tt.wantNames[i] {
+				t.Errorf("%s: spec %d = %q, want %q", tt.name, i, spec.Name, tt.wantNames[i])
+			}
+		}
+	}
+}
+
+func TestSpecAccountConfig(t *testing.T) {
+	t.Setenv("TXTEMPLATE_TEST_MNEMONIC", "some words")
+	tests := []struct {
+		name             string
+		signers          SignerSpec
+		wantMnemonic     string
+		wantMnemonicFile string
+		wantKeyringDir   string
+		wantBackend      string
+	}{
+		{"mnemonic env", SignerSpec{MnemonicEnv: "TXTEMPLATE_TEST_MNEMONIC"}, "some words", "", "", "test"},
+		{"relative paths", SignerSpec{MnemonicFile: "mnemonic.txt", KeyringDir: "keys", KeyringBackend: "file"}, "", "/specs/mnemonic.txt", "/specs/keys", "file"},
+		{"absolute paths", SignerSpec{MnemonicFile: "/etc/mnemonic.txt", KeyringDir: "/keys"}, "", "/etc/mnemonic.txt", "/keys", "test"},
+	}
+	for _, tt := range tests {
+		config := Spec{Signers: tt.signers, dir: "/specs"}.accountConfig()
+		if config.Mnemonic != tt.wantMnemonic || config.MnemonicFile != tt.wantMnemonicFile || config.KeyringDir != tt.wantKeyringDir || config.KeyringBackend != tt.wantBackend {
+			t.Errorf("%s: accountConfig() = %+v", tt.name, config)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// The exit codes of a load test that ran but failed an assertion, by the
+// kind of assertion. They start above the 1 of errors and the 2 of invalid
+// flags, and exitRegression of --compare follows them.
+const (
+	exitAssertionTPS           = 10
+	exitAssertionLatency       = 11
+	exitAssertionErrorRate     = 12
+	exitAssertionInclusionRate = 13
+)
+
+// Assertions are thresholds the results of a load test must meet, for CI
+// pipelines to fail a build on performance regressions. Nil fields are not
+// checked, while zero ones are, so that e.g. a max_error_rate of 0 fails on
+// any failed transaction.
+type Assertions struct {
+	MinTPS        *float64       `yaml:"min_tps,omitempty" json:"min_tps,omitempty"`
+	MaxP95Latency *time.Duration `yaml:"max_p95_latency,omitempty" json:"max_p95_latency,omitempty"`
+	MaxP99Latency *time.Duration `yaml:"max_p99_latency,omitempty" json:"max_p99_latency,omitempty"`
+	// Percentages, like the success and inclusion rates of Stats
+	MaxErrorRate     *float64 `yaml:"max_error_rate,omitempty" json:"max_error_rate,omitempty"`
+	MinInclusionRate *float64 `yaml:"min_inclusion_rate,omitempty" json:"min_inclusion_rate,omitempty"`
+}
+
+// Validate checks the thresholds.
+func (a *Assertions) Validate() error {
+	if a.MinTPS != nil && *a.MinTPS < 0 {
+		return fmt.Errorf("min TPS must not be negative")
+	}
+	if (a.MaxP95Latency != nil && *a.MaxP95Latency < 0) || (a.MaxP99Latency != nil && *a.MaxP99Latency < 0) {
+		return fmt.Errorf("max latencies must not be negative")
+	}
+	if a.MaxErrorRate != nil && (*a.MaxErrorRate < 0 || *a.MaxErrorRate > 100) {
+		return fmt.Errorf("max error rate must be between 0 and 100")
+	}
+	if a.MinInclusionRate != nil && (*a.MinInclusionRate < 0 || *a.MinInclusionRate > 100) {
+		return fmt.Errorf("min inclusion rate must be between 0 and 100")
+	}
+	return nil
+}
+
+// Empty reports whether no threshold is set.
+func (a *Assertions) Empty() bool {
+	return a == nil || *a == Assertions{}
+}
+
+// String lists the thresholds set, e.g. "min_tps >= 100.00, max_p99_latency <= 2s".
+func (a *Assertions) String() string {
+	var thresholds []string
+	if a.MinTPS != nil {
+		thresholds = append(thresholds, fmt.Sprintf("min_tps >= %.2f", *a.MinTPS))
+	}
+	if a.MaxP95Latency != nil {
+		thresholds = append(thresholds, fmt.Sprintf("max_p95_latency <= %s", *a.MaxP95Latency))
+	}
+	if a.MaxP99Latency != nil {
+		thresholds = append(thresholds, fmt.Sprintf("max_p99_latency <= %s", *a.MaxP99Latency))
+	}
+	if a.MaxErrorRate != nil {
+		thresholds = append(thresholds, fmt.Sprintf("max_error_rate <= %.2f%%", *a.MaxErrorRate))
+	}
+	if a.MinInclusionRate != nil {
+		thresholds = append(thresholds, fmt.Sprintf("min_inclusion_rate >= %.2f%%", *a.MinInclusionRate))
+	}
+	return strings.Join(thresholds, ", ")
+}
+
+// AssertionResult is the outcome of one assertion.
+type AssertionResult struct {
+	Name      string `json:"name"`
+	Threshold string `json:"threshold"`
+	Actual    string `json:"actual"`
+	Passed    bool   `json:"passed"`
+	// The process exit code if it failed
+	ExitCode int `json:"exit_code"`
+}
+
+// Evaluate checks every threshold set against the results.
+func (a *Assertions) Evaluate(stats *Stats) []AssertionResult {
+	if a.Empty() {
+		return nil
+	}
+	var results []AssertionResult
+	if a.MinTPS != nil {
+		results = append(results, AssertionResult{
+			Name:      "min_tps",
+			Threshold: fmt.Sprintf(">= %.2f", *a.MinTPS),
+			Actual:    fmt.Sprintf("%.2f", stats.AvgTxsPerSecond),
+			Passed:    stats.AvgTxsPerSecond >= *a.MinTPS,
+			ExitCode:  exitAssertionTPS,
+		})
+	}
+	if a.MaxP95Latency != nil {
+		results = append(results, latencyAssertion("max_p95_latency", *a.MaxP95Latency, stats.LatencyP95))
+	}
+	if a.MaxP99Latency != nil {
+		results = append(results, latencyAssertion("max_p99_latency", *a.MaxP99Latency, stats.LatencyP99))
+	}
+	if a.MaxErrorRate != nil {
+		errorRate := 100 - stats.SuccessRate
+		results = append(results, AssertionResult{
+			Name:      "max_error_rate",
+			Threshold: fmt.Sprintf("<= %.2f%%", *a.MaxErrorRate),
+			Actual:    fmt.Sprintf("%.2f%%", errorRate),
+			Passed:    stats.TotalTxs > 0 && errorRate <= *a.MaxErrorRate,
+			ExitCode:  exitAssertionErrorRate,
+		})
+	}
+	if a.MinInclusionRate != nil {
+		result := AssertionResult{
+			Name:      "min_inclusion_rate",
+			Threshold: fmt.Sprintf(">= %.2f%%", *a.MinInclusionRate),
+			Actual:    fmt.Sprintf("%.2f%%", stats.InclusionRate),
+			Passed:    stats.InclusionRate >= *a.MinInclusionRate,
+			ExitCode:  exitAssertionInclusionRate,
+		}
+		// Inclusion is only tracked with --confirm
+		if stats.IncludedTxs+stats.DroppedTxs == 0 {
+			result.Actual = "not tracked"
+			result.Passed = false
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// latencyAssertion checks a latency percentile, which is 0 and fails if no
+// transaction was sent.
+func latencyAssertion(name string, max, actual time.Duration) AssertionResult {
+	result := AssertionResult{
+		Name:      name,
+		Threshold: fmt.Sprintf("<= %s", max),
+		Actual:    actual.Round(time.Microsecond).String(),
+		Passed:    actual > 0 && actual <= max,
+		ExitCode:  exitAssertionLatency,
+	}
+	if actual == 0 {
+		result.Actual = "no transactions"
+	}
+	return result
+}
+
+// AssertionError is returned by a load test that ran but failed assertions.
+type AssertionError struct {
+	Failed []AssertionResult
+}
+
+func (e *AssertionError) Error() string {
+	names := make([]string, 0, len(e.Failed))
+	for _, result := range e.Failed {
+		names = append(names, fmt.Sprintf("%s (%s, want %s)", result.Name, result.Actual, result.Threshold))
+	}
+	return "assertions failed: " + strings.Join(names, ", ")
+}
+
+// ExitCode returns the exit code of the first failed assertion.
+func (e *AssertionError) ExitCode() int {
+	return e.Failed[0].ExitCode
+}
+
+// checkAssertions returns an AssertionError if any assertion failed.
+func checkAssertions(results []AssertionResult) error {
+	var failed []AssertionResult
+	for _, result := range results {
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &AssertionError{Failed: failed}
+}
+
+// exitCodeOf returns the exit code of err if it is, or wraps, an
+// AssertionError or a RegressionError.
+func exitCodeOf(err error) (int, bool) {
+	var coder interface{ ExitCode() int }
+	if stderrors.As(err, &coder) {
+		return coder.ExitCode(), true
+	}
+	return 0, false
+}
+
+// displayAssertions shows the assertions as a pass/fail table.
+func displayAssertions(results []AssertionResult) {
+	color.Green("\n=== Assertions ===")
+	color.White("%-20s %-16s %-16s %s", "Assertion", "Threshold", "Actual", "Result")
+	for _, result := range results {
+		line := fmt.Sprintf("%-20s %-16s %-16s", result.Name, result.Threshold, result.Actual)
+		if result.Passed {
+			color.Green("%s PASS", line)
+		} else {
+			color.Red("%s FAIL (exit code %d)", line, result.ExitCode)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestAssertionsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		assertions Assertions
+		wantErr    bool
+	}{
+		{"empty", Assertions{}, false},
+		{"every threshold", Assertions{MinTPS: ptr(100.0), MaxP95Latency: ptr(time.Second), MaxP99Latency: ptr(2 * time.Second), MaxErrorRate: ptr(1.0), MinInclusionRate: ptr(99.0)}, false},
+		{"negative TPS", Assertions{MinTPS: ptr(-1.0)}, true},
+		{"negative latency", Assertions{MaxP99Latency: ptr(-time.Second)}, true},
+		{"error rate above 100", Assertions{MaxErrorRate: ptr(101.0)}, true},
+		{"negative inclusion rate", Assertions{MinInclusionRate: ptr(-1.0)}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.assertions.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAssertionsEvaluate(t *testing.T) {
+	stats := &Stats{
+		TotalTxs:        1000,
+		AvgTxsPerSecond: 150,
+		SuccessRate:     98,
+		IncludedTxs:     950,
+		DroppedTxs:      50,
+		InclusionRate:   95,
+		LatencyP95:      800 * time.Millisecond,
+		LatencyP99:      1500 * time.Millisecond,
+	}
+	tests := []struct {
+		name       string
+		assertions *Assertions
+		stats      *Stats
+		want       map[string]bool
+	}{
+		{
+			name:       "no assertions",
+			assertions: nil,
+			stats:      stats,
+			want:       map[string]bool{},
+		},
+		{
+			name:       "all passing",
+			assertions: &Assertions{MinTPS: ptr(100.0), MaxP95Latency: ptr(time.Second), MaxP99Latency: ptr(2 * time.Second), MaxErrorRate: ptr(2.0), MinInclusionRate: ptr(95.0)},
+			stats:      stats,
+			want:       map[string]bool{"min_tps": true, "max_p95_latency": true, "max_p99_latency": true, "max_error_rate": true, "min_inclusion_rate": true},
+		},
+		{
+			name:       "all failing",
+			assertions: &Assertions{MinTPS: ptr(200.0), MaxP95Latency: ptr(500 * time.Millisecond), MaxP99Latency: ptr(time.Second), MaxErrorRate: ptr(1.0), MinInclusionRate: ptr(99.0)},
+			stats:      stats,
+			want:       map[string]bool{"min_tps": false, "max_p95_latency": false, "max_p99_latency": false, "max_error_rate": false, "min_inclusion_rate": false},
+		},
+		{
+			name:       "nothing sent",
+			assertions: &Assertions{MaxP99Latency: ptr(time.Second), MaxErrorRate: ptr(100.0)},
+			stats:      &Stats{},
+			want:       map[string]bool{"max_p99_latency": false, "max_error_rate": false},
+		},
+		{
+			// Thresholds of 0 are checked too
+			name:       "zero thresholds",
+			assertions: &Assertions{MinTPS: ptr(0.0), MaxErrorRate: ptr(0.0)},
+			stats:      stats,
+			want:       map[string]bool{"min_tps": true, "max_error_rate": false},
+		},
+		{
+			name:       "inclusion not tracked",
+			assertions: &Assertions{MinInclusionRate: ptr(1.0)},
+			stats:      &Stats{TotalTxs: 10, SuccessRate: 100},
+			want:       map[string]bool{"min_inclusion_rate": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := tt.assertions.Evaluate(tt.stats)
+			if len(results) != len(tt.want) {
+				t.Fatalf("Evaluate() = %d results, want %d", len(results), len(tt.want))
+			}
+			for _, result := range results {
+				if want, ok := tt.want[result.Name]; !ok || result.Passed != want {
+					t.Errorf("%s passed: %t, want %t", result.Name, result.Passed, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAssertionExitCode(t *testing.T) {
+	stats := &Stats{TotalTxs: 100, AvgTxsPerSecond: 10, SuccessRate: 50, LatencyP99: time.Second}
+	tests := []struct {
+		name       string
+		assertions Assertions
+		wantCode   int
+		wantOK     bool
+	}{
+		{"passing", Assertions{MinTPS: ptr(5.0)}, 0, false},
+		{"TPS", Assertions{MinTPS: ptr(20.0)}, exitAssertionTPS, true},
+		{"latency", Assertions{MaxP99Latency: ptr(time.Millisecond)}, exitAssertionLatency, true},
+		{"error rate", Assertions{MaxErrorRate: ptr(10.0)}, exitAssertionErrorRate, true},
+		{"inclusion rate", Assertions{MinInclusionRate: ptr(10.0)}, exitAssertionInclusionRate, true},
+		// The code is that of the first failed assertion, in evaluation order
+		{"TPS and error rate", Assertions{MinTPS: ptr(20.0), MaxErrorRate: ptr(10.0)}, exitAssertionTPS, true},
+	}
+	for _, tt := range tests {
+		err := checkAssertions(tt.assertions.Evaluate(stats))
+		if (err != nil) != tt.wantOK {
+			t.Errorf("%s: checkAssertions() = %v, want an error: %t", tt.name, err, tt.wantOK)
+		}
+		// Errors returned by the load test wrap it
+		code, ok := exitCodeOf(fmt.Errorf("load test: %w", err))
+		if code != tt.wantCode || ok != tt.wantOK {
+			t.Errorf("%s: exitCodeOf() = %d, %t, want %d, %t", tt.name, code, ok, tt.wantCode, tt.wantOK)
+		}
+	}
+}
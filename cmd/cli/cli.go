@@ -4,13 +4,16 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/orijtech/cosmosloadtester/clients/mainnet"
 	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
 	"github.com/orijtech/cosmosloadtester/pkg/logger"
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
 )
@@ -96,6 +99,18 @@ func (cli *CLI) Run() error {
 		return cli.handleDryRun()
 	}
 
+	if *generateCorpus != "" {
+		return cli.handleGenerateCorpus(*generateCorpus)
+	}
+
+	if *compare != "" {
+		return cli.handleCompare(*compare)
+	}
+
+	if *exportTxs != "" {
+		return cli.handleExportTxs(*exportTxs)
+	}
+
 	// Handle profile loading
 	if *profile != "" {
 		return cli.handleLoadProfile(*profile)
@@ -165,6 +180,21 @@ func (cli *CLI) handleShowProfile(name string) error {
 		color.White("  • %s", endpoint)
 	}
 	color.White("Endpoint Selection: %s", profile.EndpointSelectMethod)
+	if profile.Funding != nil {
+		color.White("Account Funding: %s per sender (sweep: %t)", profile.Funding.Amount, profile.Funding.Sweep)
+	}
+	if len(profile.ClientParams) > 0 {
+		color.White("Client Parameters: %s", hybridloadtest.ClientParams(profile.ClientParams))
+	}
+	if profile.Arrival != nil {
+		color.White("Arrival: %s (max in flight: %d)", profile.Arrival.Model, profile.Arrival.MaxInFlight)
+	}
+	if len(profile.Stages) > 0 {
+		displayStages(profile.Stages)
+	}
+	if !profile.Assertions.Empty() {
+		color.White("Assertions: %s", profile.Assertions)
+	}
 	if len(profile.Tags) > 0 {
 		color.White("Tags: %s", strings.Join(profile.Tags, ", "))
 	}
@@ -355,12 +385,16 @@ func (cli *CLI) handleBenchmark(benchmarkType string) error {
 				EndpointSelectMethod: "supplied",
 			},
 		}
+	case "find-max":
+		return cli.handleFindMax()
 	default:
-		return fmt.Errorf("unknown benchmark type: %s (available: quick, standard, stress)", benchmarkType)
+		return fmt.Errorf("unknown benchmark type: %s (available: quick, standard, stress, find-max)", benchmarkType)
 	}
 
 	color.Green("Running %s benchmark suite...", benchmarkType)
 	
+	// The suite runs to the end, and fails with the first failed assertions
+	var assertionErr error
 	for i, profile := range profiles {
 		color.White("\n=== Running benchmark %d/%d: %s ===", i+1, len(profiles), profile.Name)
 		
@@ -368,14 +402,17 @@ func (cli *CLI) handleBenchmark(benchmarkType string) error {
 		config := profileToConfig(profile)
 		
 		// Run the benchmark
-		if err := runLoadTest(config); err != nil {
+		if err := runLoadTest(config, runOptionsFor(profile)); err != nil {
 			color.Red("Benchmark %s failed: %v", profile.Name, err)
+			if _, ok := exitCodeOf(err); ok && assertionErr == nil {
+				assertionErr = err
+			}
 			continue
 		}
 	}
 
 	color.Green("\n%s benchmark suite completed!", benchmarkType)
-	return nil
+	return assertionErr
 }
 
 func (cli *CLI) runInteractiveMode() error {
@@ -489,7 +526,7 @@ func (cli *CLI) interactiveLoadTest() error {
 	config.BroadcastTxMethod = "sync"
 	config.EndpointSelectMethod = "supplied"
 
-	return runLoadTest(config)
+	return runLoadTest(config, runOptionsFor(nil))
 }
 
 func (cli *CLI) interactiveCreateProfile() error {
@@ -527,7 +564,7 @@ func (cli *CLI) interactiveLoadProfile() error {
 
 	selectedProfile := profiles[selection-1]
 	config := profileToConfig(selectedProfile)
-	return runLoadTest(config)
+	return runLoadTest(config, runOptionsFor(selectedProfile))
 }
 
 func (cli *CLI) interactiveGenerateTemplate() error {
@@ -573,6 +610,73 @@ func (cli *CLI) handleDryRun() error {
 	return nil
 }
 
+// handleGenerateCorpus pre-signs transactions with the client factory of the
+// flags, or of the profile if one is set, to a corpus the replay factory sends
+func (cli *CLI) handleGenerateCorpus(path string) error {
+	if *corpusTxs <= 0 {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"corpus transactions must be positive").
+			WithContext("corpus_txs", *corpusTxs)
+	}
+
+	var config loadtest.Config
+	var opts runOptions
+	if *profile != "" {
+		configProfile, err := cli.configManager.LoadProfile(*profile)
+		if err != nil {
+			return fmt.Errorf("failed to load profile: %w", err)
+		}
+		config = profileToConfig(configProfile)
+		opts = runOptionsFor(configProfile)
+	} else {
+		var err error
+		if config, err = buildConfig(); err != nil {
+			return err
+		}
+		opts = runOptionsFor(nil)
+	}
+
+	color.Green("Generating corpus %s with %s...", path, config.ClientFactory)
+	header, err := hybridloadtest.GenerateCorpus(config, opts.clientParams, opts.funding, path, *corpusTxs)
+	if err != nil {
+		return errors.WrapError(err, errors.ErrorTypeLoadTest,
+			errors.ErrCodeLoadTestFailed, "failed to generate corpus").
+			WithContext("corpus", path)
+	}
+
+	color.Green("Corpus generated ✓")
+	color.White("Chain ID: %s", header.ChainID)
+	color.White("Senders: %d", header.Senders)
+	color.White("Transactions: %d per sender, %d in total", header.TxsPerSender, header.Txs())
+	color.White("Replay with: --client-factory replay --replay-corpus %s", path)
+	return nil
+}
+
+// handleExportTxs pulls the transactions of the block range set by the
+// mainnet-replay flags and exports them to a JSONL file it can replay
+func (cli *CLI) handleExportTxs(path string) error {
+	if factoryConfig.MainnetParams.RPC == "" || factoryConfig.MainnetParams.FromHeight <= 0 || factoryConfig.MainnetParams.ToHeight < factoryConfig.MainnetParams.FromHeight {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"a block range to export is required").
+			WithDetails("Use --mainnet-rpc, --mainnet-from-height and --mainnet-to-height")
+	}
+
+	color.Green("Pulling blocks %d to %d from %s...", factoryConfig.MainnetParams.FromHeight, factoryConfig.MainnetParams.ToHeight, factoryConfig.MainnetParams.RPC)
+	txs, err := mainnet.FetchBlocks(factoryConfig.MainnetParams.RPC, factoryConfig.MainnetParams.FromHeight, factoryConfig.MainnetParams.ToHeight)
+	if err != nil {
+		return errors.WrapError(err, errors.ErrorTypeEndpoint,
+			errors.ErrCodeConnectionFailed, "failed to pull blocks").
+			WithContext("rpc", factoryConfig.MainnetParams.RPC)
+	}
+	if err := mainnet.WriteJSONL(path, txs); err != nil {
+		return fmt.Errorf("failed to export transactions: %w", err)
+	}
+
+	color.Green("Exported %d transactions to %s ✓", len(txs), path)
+	color.White("Replay with: --client-factory mainnet-replay --mainnet-txs %s", path)
+	return nil
+}
+
 func (cli *CLI) handleLoadProfile(profileName string) error {
 	profile, err := cli.configManager.LoadProfile(profileName)
 	if err != nil {
@@ -580,7 +684,7 @@ func (cli *CLI) handleLoadProfile(profileName string) error {
 	}
 
 	config := profileToConfig(profile)
-	return runLoadTest(config)
+	return runLoadTest(config, runOptionsFor(profile))
 }
 
 func (cli *CLI) handleSaveProfile(profileName string) error {
@@ -590,6 +694,19 @@ func (cli *CLI) handleSaveProfile(profileName string) error {
 	}
 
 	profile := configToProfile(config, profileName)
+	opts := runOptionsFor(nil)
+	if opts.funding.Enabled() {
+		profile.Funding = &FundingProfile{
+			Amount:  opts.funding.Amount,
+			Sweep:   opts.funding.Sweep,
+			Timeout: opts.funding.Timeout,
+		}
+	}
+	if len(opts.clientParams) > 0 {
+		profile.ClientParams = opts.clientParams
+	}
+	profile.Arrival = flagArrival()
+	profile.Assertions = flagAssertions()
 	if err := cli.configManager.SaveProfile(profile); err != nil {
 		return fmt.Errorf("failed to save profile: %w", err)
 	}
@@ -611,6 +728,21 @@ func (cli *CLI) displayProfileConfig(profile *ConfigProfile) error {
 	color.White("Transaction Size: %d bytes", profile.TransactionSize)
 	color.White("Broadcast Method: %s", profile.BroadcastMethod)
 	color.White("Endpoints: %s", strings.Join(profile.Endpoints, ", "))
+	if profile.Funding != nil {
+		color.White("Account Funding: %s per sender (sweep: %t)", profile.Funding.Amount, profile.Funding.Sweep)
+	}
+	if len(profile.ClientParams) > 0 {
+		color.White("Client Parameters: %s", hybridloadtest.ClientParams(profile.ClientParams))
+	}
+	if profile.Arrival != nil {
+		color.White("Arrival: %s (max in flight: %d)", profile.Arrival.Model, profile.Arrival.MaxInFlight)
+	}
+	if len(profile.Stages) > 0 {
+		displayStages(profile.Stages)
+	}
+	if !profile.Assertions.Empty() {
+		color.White("Assertions: %s", profile.Assertions)
+	}
 	return nil
 }
 
@@ -625,9 +757,30 @@ func (cli *CLI) displayLoadTestConfig(config loadtest.Config) {
 	color.White("Endpoints: %s", strings.Join(config.Endpoints, ", "))
 }
 
+// displayStages shows the stages of a profile, one per line
+func displayStages(stages []StageProfile) {
+	color.White("Stages:")
+	for i, stage := range stages {
+		name := stage.Name
+		if name == "" {
+			name = fmt.Sprintf("%d:%s", i+1, stage.Shape)
+		}
+		var rate string
+		switch hybridloadtest.StageShape(stage.Shape) {
+		case hybridloadtest.StageRamp:
+			rate = fmt.Sprintf("%d → %d TPS", stage.From, stage.To)
+		case hybridloadtest.StageSine:
+			rate = fmt.Sprintf("%d ± %d TPS every %s", stage.Rate, stage.Amplitude, stage.Period)
+		default:
+			rate = fmt.Sprintf("%d TPS", stage.Rate)
+		}
+		color.White("  • %s: %s for %s", name, rate, stage.Duration)
+	}
+}
+
 // Utility functions for converting between profile and config formats
 func profileToConfig(profile *ConfigProfile) loadtest.Config {
-	return loadtest.Config{
+	config := loadtest.Config{
 		ClientFactory:        profile.ClientFactory,
 		Connections:          profile.Connections,
 		Time:                 int(profile.Duration.Seconds()),
@@ -645,6 +798,13 @@ func profileToConfig(profile *ConfigProfile) loadtest.Config {
 		StatsOutputFile:      profile.StatsOutputFile,
 		NoTrapInterrupts:     false,
 	}
+	// Stages replace the duration and the rate, which client factories
+	// validate at its peak. They were validated when the profile was loaded.
+	if stages, err := stagesConfig(profile.Stages); err == nil && len(stages) > 0 {
+		config.Time = int(math.Ceil(stages.Duration().Seconds()))
+		config.Rate = stages.PeakRate()
+	}
+	return config
 }
 
 func configToProfile(config loadtest.Config, name string) *ConfigProfile {
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	"github.com/orijtech/cosmosloadtester/pkg/logger"
+	"github.com/orijtech/cosmosloadtester/pkg/results"
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// exitRegression is the exit code of a comparison that found regressions.
+const exitRegression = 14
+
+// handleCompare compares two load test results, each a JSON file or the ID
+// of a load test stored by the server, and reports regressions of the
+// candidate beyond the tolerances.
+func (cli *CLI) handleCompare(spec string) error {
+	log := logger.WithComponent("compare")
+
+	parts := strings.Split(spec, ",")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"compare expects BASELINE,CANDIDATE").
+			WithContext("compare", spec)
+	}
+	tolerances := results.Tolerances{
+		TPS:          *toleranceTPS,
+		Bytes:        *toleranceBytes,
+		Latency:      *toleranceLatency,
+		Significance: *significance,
+	}
+	if err := tolerances.Validate(); err != nil {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"invalid comparison tolerances").
+			WithDetails(err.Error())
+	}
+	switch *reportFormat {
+	case "text", "markdown", "html", "json":
+	default:
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"unsupported report format (supported: text, markdown, html, json)").
+			WithContext("report_format", *reportFormat)
+	}
+
+	var runs [2]*loadtestpb.RunLoadtestResponse
+	for i, source := range parts {
+		source = strings.TrimSpace(source)
+		res, err := loadResult(source)
+		if err != nil {
+			return errors.WrapError(err, errors.ErrorTypeFileSystem,
+				errors.ErrCodeFileReadFailed, "failed to load result to compare").
+				WithContext("source", source)
+		}
+		runs[i] = res
+		log.WithFields(logger.Fields{
+			"source":  source,
+			"seconds": len(res.PerSec),
+		}).Debug("Loaded result")
+	}
+
+	report := results.NewReport(strings.TrimSpace(parts[0]), runs[0], strings.TrimSpace(parts[1]), runs[1], tolerances)
+	if err := writeReport(report); err != nil {
+		return err
+	}
+	if regressions := report.Regressions(); len(regressions) > 0 {
+		return &RegressionError{Regressions: regressions}
+	}
+	return nil
+}
+
+// RegressionError is returned by a comparison that found regressions.
+type RegressionError struct {
+	Regressions []*results.SeriesComparison
+}
+
+func (e *RegressionError) Error() string {
+	names := make([]string, 0, len(e.Regressions))
+	for _, series := range e.Regressions {
+		names = append(names, fmt.Sprintf("%s (%+.1f%%)", series.Name, series.DeltaPercent))
+	}
+	return "regressions found: " + strings.Join(names, ", ")
+}
+
+// ExitCode returns exitRegression.
+func (e *RegressionError) ExitCode() int {
+	return exitRegression
+}
+
+// loadResult reads a result from a JSON file, or fetches it from the server
+// by load test ID if no such file exists.
+func loadResult(source string) (*loadtestpb.RunLoadtestResponse, error) {
+	data, err := os.ReadFile(source)
+	if os.IsNotExist(err) {
+		return fetchResult(source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseResult(data)
+}
+
+// parseResult parses the JSON output of the CLI, a result exported by the
+// server, or a load test as returned by the server's HTTP API.
+func parseResult(data []byte) (*loadtestpb.RunLoadtestResponse, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("not a JSON result: %w", err)
+	}
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	if _, ok := fields["per_second_stats"]; ok {
+		var stats Stats
+		if err := json.Unmarshal(data, &stats); err != nil {
+			return nil, fmt.Errorf("invalid CLI result: %w", err)
+		}
+		return statsToResponse(&stats), nil
+	}
+	if _, ok := fields["result"]; ok {
+		lt := new(loadtestpb.Loadtest)
+		if err := unmarshal.Unmarshal(data, lt); err != nil {
+			return nil, fmt.Errorf("invalid load test: %w", err)
+		}
+		return loadtestResult(lt)
+	}
+	res := new(loadtestpb.RunLoadtestResponse)
+	if err := unmarshal.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("invalid server result: %w", err)
+	}
+	return res, nil
+}
+
+// fetchResult fetches the result of a stored load test from the server at
+// --compare-server.
+func fetchResult(id string) (*loadtestpb.RunLoadtestResponse, error) {
+	endpoint := strings.TrimSuffix(*compareServer, "/") + "/v1/loadtests/" + url.PathEscape(id)
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("no file %q, and fetching it as a load test ID failed: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("no file %q, and %s returned %s: %s", id, endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+	lt := new(loadtestpb.Loadtest)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, lt); err != nil {
+		return nil, fmt.Errorf("invalid load test from %s: %w", endpoint, err)
+	}
+	return loadtestResult(lt)
+}
+
+// loadtestResult returns the result of a finished load test.
+func loadtestResult(lt *loadtestpb.Loadtest) (*loadtestpb.RunLoadtestResponse, error) {
+	if lt.State == loadtestpb.Loadtest_STATE_RUNNING {
+		return nil, fmt.Errorf("load test %q is still running", lt.Id)
+	}
+	if lt.Result == nil {
+		return nil, fmt.Errorf("load test %q has no result", lt.Id)
+	}
+	return lt.Result, nil
+}
+
+// statsToResponse converts the JSON output of the CLI to the result type of
+// the server, with latency rankings for the seconds in which transactions
+// were sent. Seconds keep their number, so that the report can align them
+// and count those without latencies as missing.
+func statsToResponse(stats *Stats) *loadtestpb.RunLoadtestResponse {
+	res := &loadtestpb.RunLoadtestResponse{
+		TotalTxs:          stats.TotalTxs,
+		TotalTime:         durationpb.New(stats.TotalTime),
+		TotalBytes:        stats.TotalBytes,
+		AvgTxsPerSecond:   stats.AvgTxsPerSecond,
+		AvgBytesPerSecond: stats.AvgBytesPerSecond,
+		AcceptedTxs:       stats.AcceptedTxs,
+		FailedTxs:         stats.FailedTxs,
+		IncludedTxs:       stats.IncludedTxs,
+		DroppedTxs:        stats.DroppedTxs,
+		InclusionRate:     stats.InclusionRate,
+	}
+	if stats.Chain != nil {
+		res.ChainMetrics = &loadtestpb.ChainMetrics{
+			Blocks:                int64(stats.Chain.Blocks),
+			CommittedTxs:          stats.Chain.CommittedTxs,
+			CommittedTxsPerSecond: stats.Chain.CommittedTxsPerSecond,
+			AvgBlockTime:          durationpb.New(stats.Chain.AvgBlockTime),
+			AvgBlockBytes:         stats.Chain.AvgBlockBytes,
+			MaxMempoolTxs:         stats.Chain.MaxMempoolTxs,
+		}
+	}
+	for _, ps := range stats.PerSecondStats {
+		sec := &loadtestpb.PerSecond{
+			Sec:         ps.Second,
+			Qps:         ps.TxsPerSecond,
+			BytesSent:   ps.BytesPerSecond,
+			FailedTxs:   ps.ErrorCount,
+			IncludedTxs: ps.IncludedTxs,
+			DroppedTxs:  ps.DroppedTxs,
+			Stage:       ps.Stage,
+		}
+		if ps.LatencyP99 > 0 {
+			percentile := func(latency time.Duration) *loadtestpb.Percentile {
+				return &loadtestpb.Percentile{Latency: durationpb.New(latency)}
+			}
+			sec.LatencyRankings = &loadtestpb.Ranking{
+				P50: percentile(ps.LatencyP50),
+				P75: percentile(ps.LatencyP75),
+				P90: percentile(ps.LatencyP90),
+				P95: percentile(ps.LatencyP95),
+				P99: percentile(ps.LatencyP99),
+			}
+		}
+		res.PerSec = append(res.PerSec, sec)
+	}
+	return res
+}
+
+// writeReport writes the report in --report-format to --report-output, or
+// to stdout.
+func writeReport(report *results.Report) error {
+	if *reportFormat == "text" && *reportOutput == "" {
+		displayReport(report)
+		return nil
+	}
+
+	w := io.Writer(os.Stdout)
+	if *reportOutput != "" {
+		f, err := os.Create(*reportOutput)
+		if err != nil {
+			return errors.WrapError(err, errors.ErrorTypeFileSystem,
+				errors.ErrCodeFileWriteFailed, "failed to create report file").
+				WithContext("report_output", *reportOutput)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	var err error
+	switch *reportFormat {
+	case "markdown":
+		err = report.WriteMarkdown(w)
+	case "html":
+		err = report.WriteHTML(w)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	default:
+		// Without colors, as the file is not a terminal
+		color.NoColor = true
+		color.Output = w
+		displayReport(report)
+		color.Output = os.Stdout
+	}
+	if err != nil {
+		return errors.WrapError(err, errors.ErrorTypeFileSystem,
+			errors.ErrCodeFileWriteFailed, "failed to write report")
+	}
+	if *reportOutput != "" && !*quiet {
+		color.Green("Report written to %s", *reportOutput)
+	}
+	return nil
+}
+
+// displayReport shows the report as tables, with regressions in red and
+// improvements in green.
+func displayReport(report *results.Report) {
+	color.Green("=== Load Test Comparison ===")
+	color.White("Baseline:   %s", report.Baseline)
+	color.White("Candidate:  %s", report.Candidate)
+	color.White("Tolerances: TPS -%g%%, bytes -%g%%, latency +%g%%, significance p < %g",
+		report.Tolerances.TPS, report.Tolerances.Bytes, report.Tolerances.Latency, report.Tolerances.Significance)
+
+	color.Green("\n=== Per-Second Series (%d aligned seconds) ===", len(report.PerSecond))
+	color.White("%-18s %16s %16s %12s %9s %8s %8s  %s", "Series", "Baseline", "Candidate", "Delta", "Delta %", "p", "Missing", "Result")
+	for _, s := range report.Series {
+		line := fmt.Sprintf("%-18s %11.2f %-4s %11.2f %-4s %12.2f %8.1f%% %8s %8s", s.Name,
+			s.Baseline, s.Unit, s.Candidate, s.Unit, s.Delta, s.DeltaPercent, results.FormatPValue(s.PValue), results.FormatMissing(s))
+		switch {
+		case s.Regression:
+			color.Red("%s  ✗ regression", line)
+		case s.Improvement:
+			color.Green("%s  ✓ improvement", line)
+		case s.Significant:
+			color.White("%s  within tolerance", line)
+		default:
+			color.White("%s  no significant change", line)
+		}
+	}
+
+	fmt.Fprintln(color.Output)
+	if len(report.Regressions()) > 0 {
+		color.Red(report.Verdict())
+	} else {
+		color.Green(report.Verdict())
+	}
+}
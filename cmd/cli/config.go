@@ -10,6 +10,7 @@ import (
 
 	"gopkg.in/yaml.v3"
 	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
 	"github.com/orijtech/cosmosloadtester/pkg/logger"
 	"github.com/orijtech/cosmosloadtester/pkg/recovery"
 )
@@ -33,11 +34,84 @@ type ConfigProfile struct {
 	MinConnectivity      int           `yaml:"min_connectivity" json:"min_connectivity"`
 	PeerConnectTimeout   time.Duration `yaml:"peer_connect_timeout" json:"peer_connect_timeout"`
 	StatsOutputFile      string        `yaml:"stats_output_file,omitempty" json:"stats_output_file,omitempty"`
+	Funding              *FundingProfile `yaml:"funding,omitempty" json:"funding,omitempty"`
+	ClientParams         map[string]string `yaml:"client_params,omitempty" json:"client_params,omitempty"`
+	Arrival              *ArrivalProfile `yaml:"arrival,omitempty" json:"arrival,omitempty"`
+	Stages               []StageProfile `yaml:"stages,omitempty" json:"stages,omitempty"`
+	Assertions           *Assertions    `yaml:"assertions,omitempty" json:"assertions,omitempty"`
 	Tags                 []string      `yaml:"tags,omitempty" json:"tags,omitempty"`
 	CreatedAt            time.Time     `yaml:"created_at" json:"created_at"`
 	UpdatedAt            time.Time     `yaml:"updated_at" json:"updated_at"`
 }
 
+// FundingProfile configures the funding of one sender account per connection
+// from the client factory's faucet account before the load test
+type FundingProfile struct {
+	Amount  string        `yaml:"amount" json:"amount"`
+	Sweep   bool          `yaml:"sweep,omitempty" json:"sweep,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// ArrivalProfile configures an open-loop schedule on which each connection
+// sends transactions, instead of batches every send period
+type ArrivalProfile struct {
+	Model         string   `yaml:"model" json:"model"`
+	Jitter        *float64 `yaml:"jitter,omitempty" json:"jitter,omitempty"`
+	IntervalsFile string   `yaml:"intervals_file,omitempty" json:"intervals_file,omitempty"`
+	MaxInFlight   int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty"`
+}
+
+// config reads the recorded intervals, if any, and validates the schedule
+func (p *ArrivalProfile) config() (hybridloadtest.ArrivalConfig, error) {
+	model, err := hybridloadtest.ParseArrivalModel(p.Model)
+	if err != nil {
+		return hybridloadtest.ArrivalConfig{}, err
+	}
+	config := hybridloadtest.ArrivalConfig{
+		Model:       model,
+		Jitter:      p.Jitter,
+		MaxInFlight: p.MaxInFlight,
+	}
+	if p.IntervalsFile != "" {
+		if config.Intervals, err = hybridloadtest.ReadIntervals(p.IntervalsFile); err != nil {
+			return config, fmt.Errorf("failed to read arrival intervals: %w", err)
+		}
+	}
+	return config, config.Validate()
+}
+
+// StageProfile is one stage of a profile whose rate changes over the load
+// test. The stages run one after the other, and replace the profile's
+// duration and transactions per second.
+type StageProfile struct {
+	Name      string        `yaml:"name,omitempty" json:"name,omitempty"`
+	Shape     string        `yaml:"shape" json:"shape"`
+	Duration  time.Duration `yaml:"duration" json:"duration"`
+	Rate      int           `yaml:"rate,omitempty" json:"rate,omitempty"`
+	From      int           `yaml:"from,omitempty" json:"from,omitempty"`
+	To        int           `yaml:"to,omitempty" json:"to,omitempty"`
+	Amplitude int           `yaml:"amplitude,omitempty" json:"amplitude,omitempty"`
+	Period    time.Duration `yaml:"period,omitempty" json:"period,omitempty"`
+}
+
+// stagesConfig converts and validates the stages of a profile
+func stagesConfig(profiles []StageProfile) (hybridloadtest.Stages, error) {
+	stages := make(hybridloadtest.Stages, 0, len(profiles))
+	for _, p := range profiles {
+		stages = append(stages, hybridloadtest.Stage{
+			Name:      p.Name,
+			Shape:     hybridloadtest.StageShape(p.Shape),
+			Duration:  p.Duration,
+			Rate:      p.Rate,
+			From:      p.From,
+			To:        p.To,
+			Amplitude: p.Amplitude,
+			Period:    p.Period,
+		})
+	}
+	return stages, stages.Validate()
+}
+
 // ConfigManager handles configuration profiles
 type ConfigManager struct {
 	configDir string
@@ -170,6 +244,32 @@ func (cm *ConfigManager) LoadProfile(name string) (*ConfigProfile, error) {
 			WithDetails(err.Error())
 	}
 
+	// The arrival schedule may name a file of intervals, read here
+	if profile.Arrival != nil {
+		if _, err := profile.Arrival.config(); err != nil {
+			return nil, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+				"invalid arrival schedule").
+				WithContext("profile_name", name).
+				WithDetails(err.Error())
+		}
+	}
+
+	if _, err := stagesConfig(profile.Stages); err != nil {
+		return nil, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"invalid stages").
+			WithContext("profile_name", name).
+			WithDetails(err.Error())
+	}
+
+	if profile.Assertions != nil {
+		if err := profile.Assertions.Validate(); err != nil {
+			return nil, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+				"invalid assertions").
+				WithContext("profile_name", name).
+				WithDetails(err.Error())
+		}
+	}
+
 	log.WithFields(logger.Fields{
 		"filename": filename,
 		"size":     len(data),
@@ -375,18 +475,24 @@ func ValidateConfig(profile *ConfigProfile) error {
 		return fmt.Errorf("connections must be greater than 0")
 	}
 
-	if profile.Duration <= 0 {
-		return fmt.Errorf("duration must be greater than 0")
+	// Stages replace the duration and the rate
+	if len(profile.Stages) > 0 {
+		if _, err := stagesConfig(profile.Stages); err != nil {
+			return fmt.Errorf("invalid stages: %w", err)
+		}
+	} else {
+		if profile.Duration <= 0 {
+			return fmt.Errorf("duration must be greater than 0")
+		}
+		if profile.TransactionsPerSecond <= 0 {
+			return fmt.Errorf("transactions per second must be greater than 0")
+		}
 	}
 
 	if profile.SendPeriod <= 0 {
 		return fmt.Errorf("send period must be greater than 0")
 	}
 
-	if profile.TransactionsPerSecond <= 0 {
-		return fmt.Errorf("transactions per second must be greater than 0")
-	}
-
 	if profile.TransactionSize < 40 {
 		return fmt.Errorf("transaction size must be at least 40 bytes")
 	}
@@ -425,6 +531,30 @@ func ValidateConfig(profile *ConfigProfile) error {
 		return fmt.Errorf("invalid endpoint select method: %s (valid: supplied, discovered, any)", profile.EndpointSelectMethod)
 	}
 
+	// Validate account funding
+	if profile.Funding != nil {
+		if profile.Funding.Amount == "" {
+			return fmt.Errorf("funding amount is required")
+		}
+		if profile.Funding.Timeout < 0 {
+			return fmt.Errorf("funding timeout must not be negative")
+		}
+	}
+
+	// Validate the arrival schedule
+	if profile.Arrival != nil {
+		if _, err := profile.Arrival.config(); err != nil {
+			return fmt.Errorf("invalid arrival schedule: %w", err)
+		}
+	}
+
+	// Validate the assertions
+	if profile.Assertions != nil {
+		if err := profile.Assertions.Validate(); err != nil {
+			return fmt.Errorf("invalid assertions: %w", err)
+		}
+	}
+
 	return nil
 }
 
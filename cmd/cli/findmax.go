@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	"github.com/orijtech/cosmosloadtester/pkg/logger"
+)
+
+// handleFindMax runs load tests at increasing rates, with the configuration
+// of --profile or of the flags otherwise, until one breaks the SLO, and
+// reports the knee: the highest rate that met it.
+func (cli *CLI) handleFindMax() error {
+	log := logger.WithComponent("find_max")
+
+	search, err := flagSaturationSearch()
+	if err != nil {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"invalid find-max search").
+			WithContext("find_max_search", *findMaxSearch).
+			WithDetails(err.Error())
+	}
+	if *findMaxStepDuration < time.Second {
+		return errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"find-max step duration must be at least 1s").
+			WithContext("find_max_step_duration", findMaxStepDuration.String())
+	}
+
+	var config loadtest.Config
+	var opts runOptions
+	if *profile != "" {
+		p, err := cli.configManager.LoadProfile(*profile)
+		if err != nil {
+			return fmt.Errorf("failed to load profile: %w", err)
+		}
+		config, opts = profileToConfig(p), runOptionsFor(p)
+	} else {
+		if config, err = buildConfig(); err != nil {
+			return err
+		}
+		opts = runOptionsFor(nil)
+	}
+	// Every step holds its own rate
+	config.Time = int(findMaxStepDuration.Seconds())
+	opts.stages = nil
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	live := *outputFormat == "live" && !*quiet
+	if live {
+		color.Green("Searching for the highest rate meeting the SLO (%s search from %d to %d TPS per connection)...",
+			search.Mode, search.Start, search.Max)
+	}
+	tried := 0
+	result, err := search.Run(ctx, func(ctx context.Context, rate int) (*hybridloadtest.SaturationStep, error) {
+		if tried++; tried > 1 && *findMaxCooldown > 0 {
+			select {
+			case <-time.After(*findMaxCooldown):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if live {
+			color.White("\nTrying %d TPS per connection for %s...", rate, *findMaxStepDuration)
+		}
+		config.Rate = rate
+		step, err := runSaturationStep(ctx, config, opts)
+		if err == nil && live {
+			color.White("  sent %.2f TPS, p99 %s, errors %.2f%%, committed %s",
+				step.SentTPS, step.P99Latency.Round(time.Millisecond), step.ErrorRate*100, committedTPS(step))
+		}
+		return step, err
+	})
+	if err != nil {
+		if len(result.Steps) == 0 {
+			return errors.WrapError(err, errors.ErrorTypeLoadTest,
+				errors.ErrCodeLoadTestFailed, "find-max search failed")
+		}
+		log.WithError(err).Warn("Find-max search stopped early")
+		color.Yellow("\nSearch stopped early: %v", err)
+	}
+
+	return displaySaturationResult(config, search, result)
+}
+
+// flagSaturationSearch returns the saturation search set by flags.
+func flagSaturationSearch() (hybridloadtest.SaturationSearch, error) {
+	mode, err := hybridloadtest.ParseSearchMode(*findMaxSearch)
+	if err != nil {
+		return hybridloadtest.SaturationSearch{}, err
+	}
+	search := hybridloadtest.SaturationSearch{
+		Mode:  mode,
+		Start: *findMaxStart,
+		Step:  *findMaxStep,
+		Max:   *findMaxLimit,
+		SLO: hybridloadtest.SLO{
+			MaxP99Latency:  *sloP99Latency,
+			MaxErrorRate:   *sloMaxErrorRate / 100,
+			MinCommitRatio: *sloMinCommitRatio,
+		},
+	}
+	return search, search.Validate()
+}
+
+// runSaturationStep runs one load test at config.Rate and sums it up.
+func runSaturationStep(ctx context.Context, config loadtest.Config, opts runOptions) (*hybridloadtest.SaturationStep, error) {
+	run, err := runTransactors(ctx, config, opts)
+	if err != nil {
+		return nil, err
+	}
+	var samples []hybridloadtest.TxSample
+	for _, endpointSamples := range run.samples {
+		samples = append(samples, endpointSamples...)
+	}
+	stats := hybridloadtest.ComputeStats(run.startTime, run.totalTime, samples)
+	return hybridloadtest.NewSaturationStep(config.Rate, stats, run.chain), nil
+}
+
+// committedTPS formats the committed TPS of a step, which is unknown when the
+// chain could not be polled.
+func committedTPS(step *hybridloadtest.SaturationStep) string {
+	if step.CommittedTPS < 0 {
+		return "unknown"
+	}
+	return fmt.Sprintf("%.2f TPS", step.CommittedTPS)
+}
+
+// displaySaturationResult shows every step of the search and its knee, in
+// the output format.
+func displaySaturationResult(config loadtest.Config, search hybridloadtest.SaturationSearch, result *hybridloadtest.SaturationResult) error {
+	// The rates are per connection, the TPS over all of them
+	senders := config.Connections * len(config.Endpoints)
+
+	switch *outputFormat {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Search      string `json:"search"`
+			Connections int    `json:"connections"`
+			*hybridloadtest.SaturationResult
+		}{string(search.Mode), senders, result})
+	case "csv":
+		fmt.Println("rate,target_tps,sent_tps,committed_tps,p99_latency_us,error_rate,ok")
+		for _, step := range result.Steps {
+			fmt.Printf("%d,%d,%.2f,%.2f,%d,%.2f,%t\n",
+				step.Rate,
+				step.Rate*senders,
+				step.SentTPS,
+				step.CommittedTPS,
+				step.P99Latency.Microseconds(),
+				step.ErrorRate*100,
+				step.OK(),
+			)
+		}
+		return nil
+	case "summary":
+		fmt.Printf("KNEE_RATE=%d\n", result.Knee)
+		fmt.Printf("KNEE_TPS=%d\n", result.Knee*senders)
+		fmt.Printf("BREAKING_RATE=%d\n", result.Breaking)
+		fmt.Printf("STEPS=%d\n", len(result.Steps))
+		return nil
+	}
+
+	color.Green("\n=== Find-Max Steps ===")
+	color.White("%8s %10s %10s %12s %12s %8s  %s", "Rate", "Target", "Sent TPS", "Committed", "P99", "Errors", "Result")
+	for _, step := range result.Steps {
+		committed := "-"
+		if step.CommittedTPS >= 0 {
+			committed = fmt.Sprintf("%.2f", step.CommittedTPS)
+		}
+		line := fmt.Sprintf("%8d %10d %10.2f %12s %12s %7.2f%%", step.Rate, step.Rate*senders, step.SentTPS,
+			committed, step.P99Latency.Round(time.Millisecond), step.ErrorRate*100)
+		if step.OK() {
+			color.Green("%s  ✓", line)
+		} else {
+			color.Red("%s  ✗ %s", line, strings.Join(step.Violations, ", "))
+		}
+	}
+
+	color.Green("\n=== Knee ===")
+	switch {
+	case result.Knee == 0:
+		color.Red("Even %d TPS per connection broke the SLO", search.Start)
+	case result.Breaking == 0:
+		color.Yellow("%d TPS per connection (%d TPS total) met the SLO without saturating the node; raise --find-max-limit",
+			result.Knee, result.Knee*senders)
+	default:
+		color.White("Highest sustained rate: %d TPS per connection (%d TPS total)", result.Knee, result.Knee*senders)
+		color.White("SLO broken at: %d TPS per connection (%d TPS total)", result.Breaking, result.Breaking*senders)
+	}
+	return nil
+}
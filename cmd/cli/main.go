@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -19,11 +21,12 @@ import (
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
 	"github.com/schollz/progressbar/v3"
 
-	"github.com/orijtech/cosmosloadtester/clients/aiw3defi"
-	"github.com/orijtech/cosmosloadtester/clients/myabciapp"
+	"github.com/orijtech/cosmosloadtester/clients"
 	"github.com/orijtech/cosmosloadtester/pkg/errors"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
 	"github.com/orijtech/cosmosloadtester/pkg/logger"
 	"github.com/orijtech/cosmosloadtester/pkg/recovery"
+	"github.com/orijtech/cosmosloadtester/pkg/results"
 )
 
 // CLI flags
@@ -43,6 +46,16 @@ var (
 	minConnectivity      = flag.Int("min-connectivity", 0, "Minimum peer connectivity")
 	peerConnectTimeout   = flag.Duration("peer-connect-timeout", 5*time.Second, "Timeout for peer connections")
 	statsOutputFile      = flag.String("stats-output", "", "File to store statistics (CSV format)")
+	confirmMode          = flag.String("confirm", "none", "Track the inclusion of accepted transactions in blocks: none, subscribe, or poll")
+	confirmTimeout       = flag.Duration("confirm-timeout", hybridloadtest.DefaultConfirmationTimeout, "How long to wait for a transaction to be included before counting it as dropped")
+	arrival              = flag.String("arrival", "batch", "When each connection sends transactions: batch (up to --rate every send period), or on an open-loop schedule at --rate per second: constant, poisson, uniform, or replay")
+	arrivalJitter        = flag.Float64("arrival-jitter", hybridloadtest.DefaultArrivalJitter, "Fraction of the mean gap by which gaps vary with --arrival=uniform")
+	arrivalIntervals     = flag.String("arrival-intervals", "", "File of recorded gaps between transactions to replay with --arrival=replay, one duration or number of seconds per line")
+	maxInFlight          = flag.Int("max-in-flight", 0, "Maximum number of broadcasts in flight per connection with an open-loop --arrival (0 for 256, or 1 for clients whose transactions carry account sequences)")
+	fundAmount           = flag.String("fund-amount", "", "Fund one sender account per connection with these coins from the faucet account before the load test, e.g. 1000000uaiw")
+	fundSweep            = flag.Bool("fund-sweep", false, "Send the balances of the funded sender accounts back to the faucet account after the load test")
+	fundTimeout          = flag.Duration("fund-timeout", hybridloadtest.DefaultConfirmationTimeout, "How long to wait for the funding and sweep transactions to be included")
+	clientParams         = flag.String("client-params", "", "Comma-separated key=value parameters of the client factory, e.g. chain_id=mychain,gas_price=0.025")
 	outputFormat         = flag.String("output-format", "live", "Output format: live, json, csv, or summary")
 	quiet                = flag.Bool("quiet", false, "Suppress progress output")
 	logLevel             = flag.String("log-level", "info", "Log level: debug, info, warn, error")
@@ -58,10 +71,40 @@ var (
 	validateConfig       = flag.Bool("validate-config", false, "Validate configuration")
 	dryRun               = flag.Bool("dry-run", false, "Run without actually executing transactions")
 	checkEndpoints       = flag.Bool("check-endpoints", false, "Check endpoint connectivity")
-	benchmark            = flag.String("benchmark", "", "Run a specific benchmark")
+	benchmark            = flag.String("benchmark", "", "Run a specific benchmark: quick, standard, stress, or find-max")
+	findMaxSearch        = flag.String("find-max-search", "step", "How --benchmark=find-max picks rates: step (from --find-max-start by --find-max-step) or binary (between --find-max-start and --find-max-limit)")
+	findMaxStart         = flag.Int("find-max-start", 100, "First rate per connection tried by --benchmark=find-max")
+	findMaxStep          = flag.Int("find-max-step", 100, "Rate increment of --benchmark=find-max, or the resolution of its binary search")
+	findMaxLimit         = flag.Int("find-max-limit", 10000, "Highest rate per connection tried by --benchmark=find-max")
+	findMaxStepDuration  = flag.Duration("find-max-step-duration", 30*time.Second, "Duration of each rate tried by --benchmark=find-max")
+	findMaxCooldown      = flag.Duration("find-max-cooldown", 5*time.Second, "Pause between the rates tried by --benchmark=find-max, for the mempool to drain")
+	sloP99Latency        = flag.Duration("slo-p99-latency", 0, "Highest P99 broadcast latency at which --benchmark=find-max counts a rate as sustained (0 to not check)")
+	sloMaxErrorRate      = flag.Float64("slo-max-error-rate", 0, "Highest percentage of failed transactions at which --benchmark=find-max counts a rate as sustained (0 to not check)")
+	sloMinCommitRatio    = flag.Float64("slo-min-commit-ratio", 0, "Lowest ratio of committed to sent TPS, from 0 to 1, at which --benchmark=find-max counts a rate as sustained (0 to not check)")
+	assertMinTPS         = flag.Float64("assert-min-tps", 0, "Fail with exit code 10 if the average TPS is below this (not checked unless set)")
+	assertMaxP95Latency  = flag.Duration("assert-max-p95-latency", 0, "Fail with exit code 11 if the P95 broadcast latency is above this (not checked unless set)")
+	assertMaxP99Latency  = flag.Duration("assert-max-p99-latency", 0, "Fail with exit code 11 if the P99 broadcast latency is above this (not checked unless set)")
+	assertMaxErrorRate   = flag.Float64("assert-max-error-rate", 0, "Fail with exit code 12 if the percentage of failed transactions is above this, so 0 fails on any (not checked unless set)")
+	assertMinInclusion   = flag.Float64("assert-min-inclusion-rate", 0, "Fail with exit code 13 if the percentage of transactions included in blocks is below this, with --confirm (not checked unless set)")
+	compare              = flag.String("compare", "", "Compare two load test results, BASELINE,CANDIDATE, each a JSON result file or the ID of a load test stored by --compare-server, and exit with code 14 on regressions")
+	compareServer        = flag.String("compare-server", "http://localhost:8080", "HTTP address of the server to fetch the load tests compared by --compare from")
+	reportFormat         = flag.String("report-format", "text", "Format of the --compare report: text, markdown, html, or json")
+	reportOutput         = flag.String("report-output", "", "File to write the --compare report to (default stdout)")
+	toleranceTPS         = flag.Float64("tolerance-tps", results.DefaultTolerances.TPS, "Percentage by which the TPS compared by --compare may drop before it counts as a regression")
+	toleranceBytes       = flag.Float64("tolerance-bytes", results.DefaultTolerances.Bytes, "Percentage by which the bytes per second compared by --compare may drop before they count as a regression")
+	toleranceLatency     = flag.Float64("tolerance-latency", results.DefaultTolerances.Latency, "Percentage by which the latency percentiles compared by --compare may rise before they count as a regression")
+	significance         = flag.Float64("significance", results.DefaultSignificance, "P-value below which a difference found by --compare is significant")
 	profile              = flag.String("profile", "", "Use a specific profile for the load test")
+	txTemplates          = flag.String("tx-templates", "", "Comma-separated transaction template files, or directories of them, to register as client factories")
+	generateCorpus       = flag.String("generate-corpus", "", "Pre-sign transactions with the client factory to this corpus file, for the replay factory, instead of running a load test")
+	corpusTxs            = flag.Int("corpus-txs", 1000, "Number of transactions to pre-sign per sender (connection) with --generate-corpus")
+	pluginsDir           = flag.String("plugins-dir", "", "Directory of plugin binaries whose client factories to register")
+	exportTxs            = flag.String("export-txs", "", "Export the transactions of the --mainnet-rpc blocks from --mainnet-from-height to --mainnet-to-height to this JSONL file, for the mainnet-replay factory")
 )
 
+// The accounts and parameters of the client factories
+var factoryConfig = clients.DefaultConfig()
+
 const (
 	version = "1.0.0"
 	banner  = `
@@ -82,10 +125,57 @@ type Stats struct {
 	TotalBytes          int64                    `json:"total_bytes"`
 	AvgTxsPerSecond     float64                  `json:"avg_txs_per_second"`
 	AvgBytesPerSecond   float64                  `json:"avg_bytes_per_second"`
+	AcceptedTxs         int64                    `json:"accepted_txs"`
+	FailedTxs           int64                    `json:"failed_txs"`
+	SuccessRate         float64                  `json:"success_rate"`
+	Outcomes            []OutcomeStats           `json:"outcomes"`
+	IncludedTxs         int64                    `json:"included_txs"`
+	DroppedTxs          int64                    `json:"dropped_txs"`
+	InclusionRate       float64                  `json:"inclusion_rate"`
+	LatencyP50          time.Duration            `json:"latency_p50"`
+	LatencyP95          time.Duration            `json:"latency_p95"`
+	LatencyP99          time.Duration            `json:"latency_p99"`
+	InclusionLatencyP50 time.Duration            `json:"inclusion_latency_p50"`
+	InclusionLatencyP90 time.Duration            `json:"inclusion_latency_p90"`
+	InclusionLatencyP99 time.Duration            `json:"inclusion_latency_p99"`
+	Chain               *ChainStats              `json:"chain,omitempty"`
 	PerSecondStats      []PerSecondStats         `json:"per_second_stats"`
 	EndpointStats       map[string]EndpointStats `json:"endpoint_stats"`
 	ClientFactoryUsed   string                   `json:"client_factory_used"`
 	ConfigurationUsed   loadtest.Config          `json:"configuration_used"`
+	Assertions          []AssertionResult        `json:"assertions,omitempty"`
+}
+
+// OutcomeStats represents the number of transactions with the same outcome
+// and, for rejected transactions, the same code
+type OutcomeStats struct {
+	Outcome   string `json:"outcome"`
+	Code      int    `json:"code,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	Count     int64  `json:"count"`
+}
+
+// ChainStats represents what the chain committed during the load test
+type ChainStats struct {
+	Blocks                int                `json:"blocks"`
+	CommittedTxs          int64              `json:"committed_txs"`
+	CommittedTxsPerSecond float64            `json:"committed_txs_per_second"`
+	AvgBlockTime          time.Duration      `json:"avg_block_time"`
+	AvgBlockBytes         float64            `json:"avg_block_bytes"`
+	AvgGasUsed            float64            `json:"avg_gas_used"`
+	MaxMempoolTxs         int64              `json:"max_mempool_txs"`
+	PerSecond             []ChainSecondStats `json:"per_second"`
+}
+
+// ChainSecondStats represents the blocks committed within one second
+type ChainSecondStats struct {
+	Second       int64         `json:"second"`
+	Blocks       int           `json:"blocks"`
+	CommittedTxs int64         `json:"committed_txs"`
+	BlockBytes   int64         `json:"block_bytes"`
+	GasUsed      int64         `json:"gas_used"`
+	AvgBlockTime time.Duration `json:"avg_block_time"`
+	MempoolTxs   int64         `json:"mempool_txs"`
 }
 
 // PerSecondStats represents per-second statistics
@@ -100,6 +190,9 @@ type PerSecondStats struct {
 	LatencyP99      time.Duration      `json:"latency_p99"`
 	SuccessRate     float64            `json:"success_rate"`
 	ErrorCount      int64              `json:"error_count"`
+	IncludedTxs     int64              `json:"included_txs"`
+	DroppedTxs      int64              `json:"dropped_txs"`
+	Stage           string             `json:"stage,omitempty"`
 }
 
 // EndpointStats represents statistics for a specific endpoint
@@ -110,6 +203,7 @@ type EndpointStats struct {
 	TotalBytes      int64         `json:"total_bytes"`
 	AvgLatency      time.Duration `json:"avg_latency"`
 	ErrorCount      int64         `json:"error_count"`
+	SuccessRate     float64       `json:"success_rate"`
 	ConnectionCount int           `json:"connection_count"`
 }
 
@@ -117,6 +211,7 @@ type EndpointStats struct {
 type ProgressReporter struct {
 	startTime    time.Time
 	progressBar  *progressbar.ProgressBar
+	stages       hybridloadtest.Stages
 	stats        *Stats
 	mu           sync.RWMutex
 	quiet        bool
@@ -132,6 +227,7 @@ func main() {
 		}
 	}()
 
+	factoryConfig.RegisterFlags(flag.CommandLine)
 	flag.Parse()
 
 	// Setup logging system
@@ -177,6 +273,10 @@ func main() {
 	err = recovery.SafeExecute(func() error {
 		return cli.Run()
 	})
+	if code, ok := exitCodeOf(err); ok {
+		log.WithError(err).Error("Results failed their checks")
+		os.Exit(code)
+	}
 	if err != nil {
 		log.WithError(err).Fatal("CLI command failed")
 	}
@@ -194,8 +294,12 @@ func main() {
 
 	// Run load test with recovery
 	err = recovery.SafeExecute(func() error {
-		return runLoadTest(config)
+		return runLoadTest(config, runOptionsFor(nil))
 	})
+	if code, ok := exitCodeOf(err); ok {
+		log.WithError(err).Error("Load test failed its assertions")
+		os.Exit(code)
+	}
 	if err != nil {
 		log.WithError(err).Fatal("Load test failed")
 	}
@@ -207,7 +311,8 @@ func shouldRunStandardLoadTest() bool {
 	// Don't run standard load test if any of these management commands were used
 	if *listProfiles || *showProfile != "" || *deleteProfile != "" || 
 	   *generateTemplate != "" || *exportProfiles != "" || *importProfiles != "" ||
-	   *interactive || *validateConfig || *dryRun || *checkEndpoints || *benchmark != "" {
+	   *interactive || *validateConfig || *dryRun || *checkEndpoints || *benchmark != "" ||
+	   *generateCorpus != "" || *exportTxs != "" || *compare != "" {
 		return false
 	}
 
@@ -242,28 +347,16 @@ func setupLogging() (logger.Logger, error) {
 
 func registerClientFactories() error {
 	log := logger.WithComponent("client_factory_registration")
-	
+
 	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
 
-	// Register the default test client factory
-	log.Debug("Registering test-cosmos-client-factory")
-	cosmosClientFactory := myabciapp.NewCosmosClientFactory(txConfig)
-	if err := loadtest.RegisterClientFactory("test-cosmos-client-factory", cosmosClientFactory); err != nil {
-		return errors.NewClientFactoryError(errors.ErrCodeClientFactoryNotFound,
-			"failed to register test-cosmos-client-factory").
-			WithContext("factory_name", "test-cosmos-client-factory").
-			WithDetails(err.Error())
+	factoryConfig.PluginsDir = *pluginsDir
+	if *txTemplates != "" {
+		factoryConfig.TxTemplates = strings.Split(*txTemplates, ",")
 	}
-
-	// Register the AIW3 DeFi client factory
-	log.Debug("Registering aiw3defi-bank-send")
-	aiw3defiClientFactory := aiw3defi.NewAIW3DefiClientFactory(txConfig)
-	if err := loadtest.RegisterClientFactory("aiw3defi-bank-send", aiw3defiClientFactory); err != nil {
-		return errors.NewClientFactoryError(errors.ErrCodeClientFactoryNotFound,
-			"failed to register aiw3defi-bank-send").
-			WithContext("factory_name", "aiw3defi-bank-send").
-			WithDetails(err.Error())
+	if err := clients.RegisterAll(txConfig, factoryConfig); err != nil {
+		return err
 	}
 
 	log.Info("Successfully registered all client factories")
@@ -271,9 +364,7 @@ func registerClientFactories() error {
 }
 
 func listAvailableFactories() {
-	// Since there's no public API to get registered factories, 
-	// we'll list the ones we know are registered
-	factories := []string{"test-cosmos-client-factory", "aiw3defi-bank-send"}
+	factories := hybridloadtest.ClientFactoryNames()
 	color.Green("Available Client Factories:")
 	for _, factory := range factories {
 		color.White("  • %s", factory)
@@ -362,17 +453,51 @@ func buildConfig() (loadtest.Config, error) {
 			WithDetails("Minimum transaction size is 40 bytes")
 	}
 
-	// Create temporary stats file if not provided
-	statsFile := *statsOutputFile
-	if statsFile == "" {
-		tmpFile, err := os.CreateTemp("", "cosmosloadtester-*.csv")
-		if err != nil {
-			return config, errors.NewFileSystemError(errors.ErrCodeFileWriteFailed,
-				"failed to create temporary stats file").
+	// Validate confirmation tracking
+	if _, err := hybridloadtest.ParseConfirmationMode(*confirmMode); err != nil {
+		return config, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"invalid confirmation mode").
+			WithContext("confirm", *confirmMode).
+			WithDetails("Valid confirmation modes are: none, subscribe, poll")
+	}
+	if *confirmTimeout <= 0 {
+		return config, errors.NewValidationError(errors.ErrCodeInvalidDuration,
+			"confirmation timeout must be positive").
+			WithContext("confirm_timeout", confirmTimeout.String())
+	}
+
+	// Validate the assertions
+	if assertions := flagAssertions(); assertions != nil {
+		if err := assertions.Validate(); err != nil {
+			return config, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+				"invalid assertions").
+				WithDetails(err.Error())
+		}
+	}
+
+	// Validate the arrival schedule
+	if arrivalProfile := flagArrival(); arrivalProfile != nil {
+		if _, err := arrivalProfile.config(); err != nil {
+			return config, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+				"invalid arrival schedule").
+				WithContext("arrival", *arrival).
 				WithDetails(err.Error())
 		}
-		statsFile = tmpFile.Name()
-		tmpFile.Close()
+	}
+
+	// Validate account funding
+	if *fundTimeout <= 0 {
+		return config, errors.NewValidationError(errors.ErrCodeInvalidDuration,
+			"funding timeout must be positive").
+			WithContext("fund_timeout", fundTimeout.String())
+	}
+
+	// Validate client parameters
+	if _, err := hybridloadtest.ParseClientParams(*clientParams); err != nil {
+		return config, errors.NewValidationError(errors.ErrCodeInvalidConfig,
+			"invalid client parameters").
+			WithContext("client_params", *clientParams).
+			WithDetails(err.Error())
 	}
 
 	config = loadtest.Config{
@@ -390,7 +515,7 @@ func buildConfig() (loadtest.Config, error) {
 		MaxEndpoints:         *maxEndpoints,
 		MinConnectivity:      *minConnectivity,
 		PeerConnectTimeout:   int(peerConnectTimeout.Seconds()),
-		StatsOutputFile:      statsFile,
+		StatsOutputFile:      *statsOutputFile,
 		NoTrapInterrupts:     false,
 	}
 
@@ -411,7 +536,7 @@ func buildConfig() (loadtest.Config, error) {
 	return config, nil
 }
 
-func runLoadTest(config loadtest.Config) error {
+func runLoadTest(config loadtest.Config, opts runOptions) error {
 	log := logger.WithComponent("load_test_execution")
 	
 	// Setup signal handling with context
@@ -424,6 +549,7 @@ func runLoadTest(config loadtest.Config) error {
 	// Setup progress reporter
 	reporter := &ProgressReporter{
 		startTime:    time.Now(),
+		stages:       opts.stages,
 		quiet:        *quiet,
 		outputFormat: *outputFormat,
 		stats: &Stats{
@@ -460,7 +586,9 @@ func runLoadTest(config loadtest.Config) error {
 
 	// Start load test in a goroutine with recovery
 	var loadTestErr error
+	done := make(chan struct{})
 	recovery.SafeGoWithContext(ctx, func(ctx context.Context) {
+		defer close(done)
 		defer func() {
 			if err := recovery.Recover(); err != nil {
 				log.WithError(err).Error("Panic recovered during load test execution")
@@ -469,16 +597,16 @@ func runLoadTest(config loadtest.Config) error {
 			}
 		}()
 		
-		if err := executeLoadTest(ctx, config, reporter); err != nil {
+		if err := executeLoadTest(ctx, config, opts, reporter); err != nil {
 			log.WithError(err).Error("Load test execution failed")
 			loadTestErr = err
-			cancel()
 		}
+		cancel()
 	})
 
 	// Wait for completion or interruption
 	select {
-	case <-ctx.Done():
+	case <-done:
 		if loadTestErr != nil {
 			return errors.WrapError(loadTestErr, errors.ErrorTypeLoadTest,
 				errors.ErrCodeLoadTestFailed, "load test execution failed")
@@ -490,16 +618,19 @@ func runLoadTest(config loadtest.Config) error {
 		}).Warn("Received interrupt signal, stopping load test")
 		color.Yellow("\nReceived interrupt signal, stopping load test...")
 		cancel()
-		time.Sleep(2 * time.Second) // Give time for cleanup
+		// The transactors report what they sent once they have stopped
+		<-done
 	}
 
+	reporter.stats.Assertions = opts.assertions.Evaluate(reporter.stats)
+
 	// Display final results with error handling
 	if err := displayResults(reporter.stats); err != nil {
 		return errors.WrapError(err, errors.ErrorTypeInternal,
 			errors.ErrCodeUnexpectedError, "failed to display results")
 	}
 
-	return nil
+	return checkAssertions(reporter.stats.Assertions)
 }
 
 func displayConfiguration(config loadtest.Config) {
@@ -528,7 +659,7 @@ func displayConfiguration(config loadtest.Config) {
 	color.Green("================================\n")
 }
 
-func executeLoadTest(ctx context.Context, config loadtest.Config, reporter *ProgressReporter) error {
+func executeLoadTest(ctx context.Context, config loadtest.Config, opts runOptions, reporter *ProgressReporter) error {
 	log := logger.WithComponent("load_test_executor")
 	
 	// Start periodic reporting with recovery
@@ -546,11 +677,12 @@ func executeLoadTest(ctx context.Context, config loadtest.Config, reporter *Prog
 		"connections":   config.Connections,
 	}).Info("Executing load test")
 
-	// Execute the load test using the existing tm-load-test framework
-	psL, err := loadtest.ExecuteStandaloneWithStats(config)
+	// Execute the load test with the hybrid transactors, which record the
+	// outcome of every transaction
+	run, err := runTransactors(ctx, config, opts)
 	if err != nil {
 		return errors.WrapError(err, errors.ErrorTypeLoadTest,
-			errors.ErrCodeLoadTestFailed, "tm-load-test execution failed").
+			errors.ErrCodeLoadTestFailed, "load test execution failed").
 			WithContext("config", config)
 	}
 
@@ -559,60 +691,422 @@ func executeLoadTest(ctx context.Context, config loadtest.Config, reporter *Prog
 	defer reporter.mu.Unlock()
 
 	log.Debug("Processing load test results")
-	
-	for i, ps := range psL {
+
+	var samples []hybridloadtest.TxSample
+	for i, endpointSample := range run.samples {
+		endpoint := config.Endpoints[i]
+		endpointStats := hybridloadtest.ComputeStats(run.startTime, run.totalTime, endpointSample)
 		log.WithFields(logger.Fields{
-			"result_index": i,
-			"total_txs":    ps.TotalTxs,
-			"total_time":   ps.TotalTime,
+			"endpoint":   endpoint,
+			"total_txs":  endpointStats.TotalTxs,
+			"failed_txs": endpointStats.FailedTxs,
 		}).Debug("Processing result set")
-		
-		reporter.stats.TotalTxs += int64(ps.TotalTxs)
-		reporter.stats.TotalBytes += int64(ps.TotalBytes)
-		reporter.stats.TotalTime = ps.TotalTime
-		reporter.stats.AvgTxsPerSecond = ps.AvgTxPerSecond
-		reporter.stats.AvgBytesPerSecond = ps.AvgBytesPerSecond
-
-		// Process per-second stats with error recovery
-		for _, perSec := range ps.PerSecond {
-			stats := PerSecondStats{
-				Second:         int64(perSec.Sec),
-				TxsPerSecond:   float64(perSec.QPS),
-				BytesPerSecond: float64(perSec.Bytes),
-			}
 
-			// Extract latency percentiles if available with safety checks
-			if perSec.LatencyRankings != nil {
-				if perSec.LatencyRankings.P50thLatency != nil {
-					stats.LatencyP50 = perSec.LatencyRankings.P50thLatency.Latency
-				}
-				if perSec.LatencyRankings.P75thLatency != nil {
-					stats.LatencyP75 = perSec.LatencyRankings.P75thLatency.Latency
-				}
-				if perSec.LatencyRankings.P90thLatency != nil {
-					stats.LatencyP90 = perSec.LatencyRankings.P90thLatency.Latency
-				}
-				if perSec.LatencyRankings.P95thLatency != nil {
-					stats.LatencyP95 = perSec.LatencyRankings.P95thLatency.Latency
-				}
-				if perSec.LatencyRankings.P99thLatency != nil {
-					stats.LatencyP99 = perSec.LatencyRankings.P99thLatency.Latency
-				}
-			}
+		reporter.stats.EndpointStats[endpoint] = EndpointStats{
+			Endpoint:        endpoint,
+			Protocol:        endpointProtocol(endpoint),
+			TotalTxs:        int64(endpointStats.TotalTxs),
+			TotalBytes:      endpointStats.TotalBytes,
+			AvgLatency:      averageLatency(endpointSample),
+			ErrorCount:      int64(endpointStats.FailedTxs),
+			SuccessRate:     successRate(endpointStats.AcceptedTxs, endpointStats.TotalTxs),
+			ConnectionCount: config.Connections,
+		}
+		samples = append(samples, endpointSample...)
+	}
 
-			reporter.stats.PerSecondStats = append(reporter.stats.PerSecondStats, stats)
+	stats := hybridloadtest.ComputeStats(run.startTime, run.totalTime, samples)
+	opts.stages.Mark(stats.PerSecond)
+	reporter.stats.TotalTxs = int64(stats.TotalTxs)
+	reporter.stats.TotalBytes = stats.TotalBytes
+	reporter.stats.TotalTime = stats.TotalTime
+	reporter.stats.AvgTxsPerSecond = stats.AvgTxsPerSecond
+	reporter.stats.AvgBytesPerSecond = stats.AvgBytesPerSecond
+	reporter.stats.AcceptedTxs = int64(stats.AcceptedTxs)
+	reporter.stats.FailedTxs = int64(stats.FailedTxs)
+	reporter.stats.SuccessRate = successRate(stats.AcceptedTxs, stats.TotalTxs)
+	reporter.stats.Outcomes = outcomeStats(stats.Outcomes)
+	reporter.stats.IncludedTxs = int64(stats.IncludedTxs)
+	reporter.stats.DroppedTxs = int64(stats.DroppedTxs)
+	reporter.stats.InclusionRate = stats.InclusionRate * 100
+	if rankings := stats.LatencyRankings; rankings != nil {
+		reporter.stats.LatencyP50 = rankings.P50.Latency
+		reporter.stats.LatencyP95 = rankings.P95.Latency
+		reporter.stats.LatencyP99 = rankings.P99.Latency
+	}
+	if rankings := stats.InclusionLatencyRankings; rankings != nil {
+		reporter.stats.InclusionLatencyP50 = rankings.P50.Latency
+		reporter.stats.InclusionLatencyP90 = rankings.P90.Latency
+		reporter.stats.InclusionLatencyP99 = rankings.P99.Latency
+	}
+
+	for _, perSec := range stats.PerSecond {
+		ps := PerSecondStats{
+			Second:         int64(perSec.Sec),
+			TxsPerSecond:   float64(perSec.QPS),
+			BytesPerSecond: float64(perSec.Bytes),
+			SuccessRate:    successRate(perSec.AcceptedTxs, perSec.QPS),
+			ErrorCount:     int64(perSec.FailedTxs),
+			IncludedTxs:    int64(perSec.IncludedTxs),
+			DroppedTxs:     int64(perSec.DroppedTxs),
+			Stage:          perSec.Stage,
+		}
+		if perSec.LatencyRankings != nil {
+			ps.LatencyP50 = perSec.LatencyRankings.P50.Latency
+			ps.LatencyP75 = perSec.LatencyRankings.P75.Latency
+			ps.LatencyP90 = perSec.LatencyRankings.P90.Latency
+			ps.LatencyP95 = perSec.LatencyRankings.P95.Latency
+			ps.LatencyP99 = perSec.LatencyRankings.P99.Latency
+		}
+		reporter.stats.PerSecondStats = append(reporter.stats.PerSecondStats, ps)
+	}
+
+	if run.chain != nil {
+		reporter.stats.Chain = chainStats(run.chain)
+	}
+
+	if config.StatsOutputFile != "" {
+		if err := writeStatsFile(config.StatsOutputFile, reporter.stats); err != nil {
+			return err
 		}
 	}
 
 	log.WithFields(logger.Fields{
-		"total_transactions": reporter.stats.TotalTxs,
-		"total_time":        reporter.stats.TotalTime,
-		"avg_tps":           reporter.stats.AvgTxsPerSecond,
+		"total_transactions":  reporter.stats.TotalTxs,
+		"failed_transactions": reporter.stats.FailedTxs,
+		"total_time":          reporter.stats.TotalTime,
+		"avg_tps":             reporter.stats.AvgTxsPerSecond,
 	}).Info("Load test execution completed successfully")
 
 	return nil
 }
 
+// loadTestRun holds what runTransactors recorded.
+type loadTestRun struct {
+	startTime time.Time
+	totalTime time.Duration
+	// The samples recorded for each endpoint
+	samples [][]hybridloadtest.TxSample
+	// What the chain committed, nil if the endpoints could not be polled
+	chain *hybridloadtest.ChainStats
+}
+
+// runTransactors sends transactions to every endpoint until the duration of
+// the load test has elapsed or ctx is cancelled, following the blocks the
+// chain commits meanwhile. Sender accounts are funded first if configured.
+func runTransactors(ctx context.Context, config loadtest.Config, opts runOptions) (*loadTestRun, error) {
+	log := logger.WithComponent("load_test_executor")
+
+	if err := hybridloadtest.ValidateClientConfig(config, opts.clientParams); err != nil {
+		return nil, errors.WrapError(err, errors.ErrorTypeValidation,
+			errors.ErrCodeInvalidConfig, "client factory validation failed").
+			WithContext("client_params", opts.clientParams.String())
+	}
+
+	// The transactors create their clients, which sign with the funded
+	// accounts, when they start
+	teardown, err := hybridloadtest.FundAccounts(config, opts.clientParams, opts.funding)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.ErrorTypeLoadTest,
+			errors.ErrCodeLoadTestFailed, "failed to fund accounts").
+			WithContext("fund_amount", opts.funding.Amount)
+	}
+	defer func() {
+		if err := teardown(); err != nil {
+			log.WithError(err).Error("Failed to sweep funded accounts")
+		}
+	}()
+
+	release, err := hybridloadtest.Prepare(config, opts.clientParams)
+	if err != nil {
+		return nil, errors.WrapError(err, errors.ErrorTypeLoadTest,
+			errors.ErrCodeLoadTestFailed, "failed to prepare client factory").
+			WithContext("client_factory", config.ClientFactory)
+	}
+	defer func() {
+		if err := release(); err != nil {
+			log.WithError(err).Error("Failed to release clients")
+		}
+	}()
+
+	// Validated by buildConfig
+	mode, _ := hybridloadtest.ParseConfirmationMode(*confirmMode)
+	confirmation := hybridloadtest.ConfirmationConfig{
+		Mode:    mode,
+		Timeout: *confirmTimeout,
+	}
+
+	factory := hybridloadtest.NewTransactorFactory()
+	var transactors []hybridloadtest.TransactorInterface
+	for _, endpoint := range config.Endpoints {
+		transactor, err := factory.CreateTransactor(endpoint, &config)
+		if err != nil {
+			for _, t := range transactors {
+				t.Cancel()
+				t.Wait()
+			}
+			return nil, errors.WrapError(err, errors.ErrorTypeEndpoint,
+				errors.ErrCodeConnectionFailed, "failed to create transactor").
+				WithContext("endpoint", endpoint)
+		}
+		transactor.SetConfirmation(confirmation)
+		transactor.SetClientParams(opts.clientParams)
+		transactor.SetArrival(opts.arrival)
+		transactor.SetStages(opts.stages)
+		transactors = append(transactors, transactor)
+	}
+
+	// Chain metrics are best effort
+	collector, err := hybridloadtest.NewChainCollector(config.Endpoints)
+	if err == nil {
+		err = collector.Start()
+	}
+	if err != nil {
+		log.WithError(err).Warn("Not collecting chain metrics")
+		collector = nil
+	}
+
+	run := &loadTestRun{startTime: time.Now()}
+	for _, transactor := range transactors {
+		transactor.Start()
+	}
+
+	timer := time.NewTimer(time.Duration(config.Time) * time.Second)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	// The run ends when the transactors stop sending, not once the
+	// transactions in flight are confirmed
+	for _, transactor := range transactors {
+		transactor.Cancel()
+	}
+	for _, transactor := range transactors {
+		transactor.WaitSent()
+	}
+	run.totalTime = time.Since(run.startTime)
+
+	run.samples = make([][]hybridloadtest.TxSample, len(transactors))
+	for i, transactor := range transactors {
+		if err := transactor.Wait(); err != nil {
+			log.WithError(err).WithFields(logger.Fields{
+				"endpoint": config.Endpoints[i],
+			}).Error("Transactor stopped with an error")
+		}
+		run.samples[i] = transactor.GetTxSamples()
+	}
+
+	if collector != nil {
+		collector.Stop()
+		run.chain = collector.Stats(run.startTime, run.totalTime)
+	}
+	return run, nil
+}
+
+// runOptions are the options of a load test that tm-load-test's Config has
+// no room for.
+type runOptions struct {
+	funding      hybridloadtest.FundingConfig
+	clientParams hybridloadtest.ClientParams
+	arrival      hybridloadtest.ArrivalConfig
+	stages       hybridloadtest.Stages
+	assertions   *Assertions
+}
+
+// runOptionsFor returns the options set by flags, or the profile's where
+// profile is not nil and sets them.
+func runOptionsFor(profile *ConfigProfile) runOptions {
+	// Validated by buildConfig
+	params, _ := hybridloadtest.ParseClientParams(*clientParams)
+	opts := runOptions{
+		funding: hybridloadtest.FundingConfig{
+			Amount:  *fundAmount,
+			Sweep:   *fundSweep,
+			Timeout: *fundTimeout,
+		},
+		clientParams: params,
+	}
+	if arrivalProfile := flagArrival(); arrivalProfile != nil {
+		opts.arrival, _ = arrivalProfile.config()
+	}
+	opts.assertions = flagAssertions()
+	if profile == nil {
+		return opts
+	}
+	if profile.Funding != nil {
+		opts.funding = hybridloadtest.FundingConfig{
+			Amount:  profile.Funding.Amount,
+			Sweep:   profile.Funding.Sweep,
+			Timeout: profile.Funding.Timeout,
+		}
+	}
+	if len(profile.ClientParams) > 0 {
+		opts.clientParams = hybridloadtest.ClientParams(profile.ClientParams)
+	}
+	if profile.Arrival != nil {
+		// Validated when the profile was loaded
+		opts.arrival, _ = profile.Arrival.config()
+	}
+	opts.stages, _ = stagesConfig(profile.Stages)
+	if !profile.Assertions.Empty() {
+		opts.assertions = profile.Assertions
+	}
+	return opts
+}
+
+// flagAssertions returns the assertions set by flags, or nil if none is. Only
+// flags set on the command line are checked, even to 0.
+func flagAssertions() *Assertions {
+	assertions := &Assertions{}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "assert-min-tps":
+			assertions.MinTPS = assertMinTPS
+		case "assert-max-p95-latency":
+			assertions.MaxP95Latency = assertMaxP95Latency
+		case "assert-max-p99-latency":
+			assertions.MaxP99Latency = assertMaxP99Latency
+		case "assert-max-error-rate":
+			assertions.MaxErrorRate = assertMaxErrorRate
+		case "assert-min-inclusion-rate":
+			assertions.MinInclusionRate = assertMinInclusion
+		}
+	})
+	if assertions.Empty() {
+		return nil
+	}
+	return assertions
+}
+
+// flagArrival returns the arrival schedule set by flags, or nil for the
+// default batches.
+func flagArrival() *ArrivalProfile {
+	if *arrival == "" || *arrival == "batch" {
+		return nil
+	}
+	return &ArrivalProfile{
+		Model:         *arrival,
+		Jitter:        arrivalJitter,
+		IntervalsFile: *arrivalIntervals,
+		MaxInFlight:   *maxInFlight,
+	}
+}
+
+// chainStats converts the chain metrics of a load test for display.
+func chainStats(stats *hybridloadtest.ChainStats) *ChainStats {
+	ret := &ChainStats{
+		Blocks:                stats.Blocks,
+		CommittedTxs:          int64(stats.CommittedTxs),
+		CommittedTxsPerSecond: stats.CommittedTxsPerSecond,
+		AvgBlockTime:          stats.AvgBlockTime,
+		AvgBlockBytes:         stats.AvgBlockBytes,
+		AvgGasUsed:            stats.AvgGasUsed,
+		MaxMempoolTxs:         int64(stats.MaxMempoolTxs),
+	}
+	for _, ps := range stats.PerSecond {
+		ret.PerSecond = append(ret.PerSecond, ChainSecondStats{
+			Second:       int64(ps.Sec),
+			Blocks:       ps.Blocks,
+			CommittedTxs: int64(ps.CommittedTxs),
+			BlockBytes:   ps.BlockBytes,
+			GasUsed:      ps.GasUsed,
+			AvgBlockTime: ps.AvgBlockTime,
+			MempoolTxs:   int64(ps.MempoolTxs),
+		})
+	}
+	return ret
+}
+
+// outcomeStats converts the outcome counts of a load test for display.
+func outcomeStats(outcomes []*hybridloadtest.OutcomeCount) []OutcomeStats {
+	var ret []OutcomeStats
+	for _, oc := range outcomes {
+		ret = append(ret, OutcomeStats{
+			Outcome:   oc.Outcome.String(),
+			Code:      oc.Code,
+			Codespace: oc.Codespace,
+			Count:     int64(oc.Count),
+		})
+	}
+	return ret
+}
+
+// successRate returns the percentage of accepted transactions.
+func successRate(accepted, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(accepted) / float64(total) * 100
+}
+
+func averageLatency(samples []hybridloadtest.TxSample) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum time.Duration
+	for _, sample := range samples {
+		sum += sample.Latency
+	}
+	return sum / time.Duration(len(samples))
+}
+
+func endpointProtocol(endpoint string) string {
+	if i := strings.Index(endpoint, "://"); i >= 0 {
+		return endpoint[:i]
+	}
+	return ""
+}
+
+// writeStatsFile writes the aggregate statistics in tm-load-test's CSV format,
+// followed by the outcome of the transactions.
+func writeStatsFile(path string, stats *Stats) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.NewFileSystemError(errors.ErrCodeFileWriteFailed,
+			"failed to create stats output file").
+			WithContext("path", path).
+			WithDetails(err.Error())
+	}
+	defer f.Close()
+
+	records := [][]string{
+		{"Parameter", "Value", "Units"},
+		{"total_time", strconv.FormatFloat(stats.TotalTime.Seconds(), 'f', 3, 64), "seconds"},
+		{"total_txs", strconv.FormatInt(stats.TotalTxs, 10), "count"},
+		{"total_bytes", strconv.FormatInt(stats.TotalBytes, 10), "bytes"},
+		{"avg_tx_rate", strconv.FormatFloat(stats.AvgTxsPerSecond, 'f', 6, 64), "transactions per second"},
+		{"avg_data_rate", strconv.FormatFloat(stats.AvgBytesPerSecond, 'f', 6, 64), "bytes per second"},
+		{"accepted_txs", strconv.FormatInt(stats.AcceptedTxs, 10), "count"},
+		{"failed_txs", strconv.FormatInt(stats.FailedTxs, 10), "count"},
+		{"included_txs", strconv.FormatInt(stats.IncludedTxs, 10), "count"},
+		{"dropped_txs", strconv.FormatInt(stats.DroppedTxs, 10), "count"},
+		{"inclusion_rate", strconv.FormatFloat(stats.InclusionRate, 'f', 2, 64), "percent"},
+	}
+	if chain := stats.Chain; chain != nil {
+		records = append(records,
+			[]string{"blocks", strconv.Itoa(chain.Blocks), "count"},
+			[]string{"committed_txs", strconv.FormatInt(chain.CommittedTxs, 10), "count"},
+			[]string{"committed_tx_rate", strconv.FormatFloat(chain.CommittedTxsPerSecond, 'f', 6, 64), "transactions per second"},
+			[]string{"avg_block_time", strconv.FormatFloat(chain.AvgBlockTime.Seconds(), 'f', 3, 64), "seconds"},
+			[]string{"avg_block_size", strconv.FormatFloat(chain.AvgBlockBytes, 'f', 1, 64), "bytes"},
+			[]string{"avg_gas_used", strconv.FormatFloat(chain.AvgGasUsed, 'f', 1, 64), "gas per block"},
+			[]string{"max_mempool_txs", strconv.FormatInt(chain.MaxMempoolTxs, 10), "count"},
+		)
+	}
+
+	w := csv.NewWriter(f)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		return errors.NewFileSystemError(errors.ErrCodeFileWriteFailed,
+			"failed to write stats output file").
+			WithContext("path", path).
+			WithDetails(err.Error())
+	}
+	return nil
+}
+
 func (r *ProgressReporter) startPeriodicReporting(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
@@ -633,6 +1127,9 @@ func (r *ProgressReporter) updateProgress(ctx context.Context) {
 
 	if r.progressBar != nil {
 		elapsed := time.Since(r.startTime)
+		if i, rate := r.stages.At(elapsed); i >= 0 {
+			r.progressBar.Describe(fmt.Sprintf("Stage %s (%.0f TPS)", r.stages.Name(i), rate))
+		}
 		r.progressBar.Set(int(elapsed.Seconds()))
 	}
 }
@@ -657,6 +1154,42 @@ func displayLiveResults(stats *Stats) error {
 	color.White("Total Bytes: %s", formatBytes(stats.TotalBytes))
 	color.White("Average TPS: %.2f", stats.AvgTxsPerSecond)
 	color.White("Average Throughput: %s/sec", formatBytes(int64(stats.AvgBytesPerSecond)))
+	color.White("Success Rate: %.2f%% (%s accepted)", stats.SuccessRate, formatNumber(stats.AcceptedTxs))
+	if stats.FailedTxs > 0 {
+		color.Red("Failed Transactions: %s", formatNumber(stats.FailedTxs))
+	}
+
+	if stats.FailedTxs > 0 {
+		color.Green("\n=== Transaction Outcomes ===")
+		for _, outcome := range stats.Outcomes {
+			if outcome.Code != 0 {
+				color.White("%s (%s code %d): %s", outcome.Outcome, outcome.Codespace, outcome.Code, formatNumber(outcome.Count))
+			} else {
+				color.White("%s: %s", outcome.Outcome, formatNumber(outcome.Count))
+			}
+		}
+	}
+
+	if stats.IncludedTxs+stats.DroppedTxs > 0 {
+		color.Green("\n=== Block Inclusion ===")
+		color.White("Inclusion Rate: %.2f%% (%s included)", stats.InclusionRate, formatNumber(stats.IncludedTxs))
+		if stats.DroppedTxs > 0 {
+			color.Red("Dropped Transactions: %s", formatNumber(stats.DroppedTxs))
+		}
+		color.White("Inclusion Latency P50: %s", stats.InclusionLatencyP50.Round(time.Millisecond))
+		color.White("Inclusion Latency P90: %s", stats.InclusionLatencyP90.Round(time.Millisecond))
+		color.White("Inclusion Latency P99: %s", stats.InclusionLatencyP99.Round(time.Millisecond))
+	}
+
+	if chain := stats.Chain; chain != nil {
+		color.Green("\n=== Chain Metrics ===")
+		color.White("Blocks Committed: %d", chain.Blocks)
+		color.White("Committed TPS: %.2f (%s transactions)", chain.CommittedTxsPerSecond, formatNumber(chain.CommittedTxs))
+		color.White("Avg Block Time: %s", chain.AvgBlockTime.Round(time.Millisecond))
+		color.White("Avg Block Size: %s", formatBytes(int64(chain.AvgBlockBytes)))
+		color.White("Avg Gas Used: %.0f per block", chain.AvgGasUsed)
+		color.White("Max Mempool Depth: %s", formatNumber(chain.MaxMempoolTxs))
+	}
 
 	if len(stats.PerSecondStats) > 0 {
 		color.Green("\n=== Latency Percentiles (Last Second) ===")
@@ -668,6 +1201,15 @@ func displayLiveResults(stats *Stats) error {
 		color.White("P99: %s", lastSec.LatencyP99.Round(time.Microsecond))
 	}
 
+	if stages := stageSummaries(stats.PerSecondStats); len(stages) > 0 {
+		color.Green("\n=== Stages ===")
+		for _, stage := range stages {
+			color.White("%s: %.2f TPS over %ds, P99 %s, %s errors", stage.name,
+				stage.txs/float64(stage.seconds), stage.seconds,
+				stage.latencyP99.Round(time.Microsecond), formatNumber(stage.errors))
+		}
+	}
+
 	color.Green("\n=== Endpoint Statistics ===")
 	for endpoint, endpointStats := range stats.EndpointStats {
 		color.White("Endpoint: %s (%s)", endpoint, endpointStats.Protocol)
@@ -675,11 +1217,16 @@ func displayLiveResults(stats *Stats) error {
 		color.White("  Bytes: %s", formatBytes(endpointStats.TotalBytes))
 		color.White("  Avg Latency: %s", endpointStats.AvgLatency.Round(time.Microsecond))
 		color.White("  Connections: %d", endpointStats.ConnectionCount)
+		color.White("  Success Rate: %.2f%%", endpointStats.SuccessRate)
 		if endpointStats.ErrorCount > 0 {
 			color.Red("  Errors: %d", endpointStats.ErrorCount)
 		}
 	}
 
+	if len(stats.Assertions) > 0 {
+		displayAssertions(stats.Assertions)
+	}
+
 	color.Green("\n=== Configuration Used ===")
 	color.White("Client Factory: %s", stats.ClientFactoryUsed)
 	color.White("Connections: %d per endpoint", stats.ConfigurationUsed.Connections)
@@ -688,6 +1235,38 @@ func displayLiveResults(stats *Stats) error {
 	return nil
 }
 
+// stageSummary sums up the seconds of one stage.
+type stageSummary struct {
+	name    string
+	seconds int
+	txs     float64
+	errors  int64
+	// The highest P99 latency of any of its seconds
+	latencyP99 time.Duration
+}
+
+// stageSummaries sums up the per-second stats of each stage, in the order
+// the stages ran.
+func stageSummaries(perSecond []PerSecondStats) []*stageSummary {
+	var summaries []*stageSummary
+	for _, ps := range perSecond {
+		if ps.Stage == "" {
+			continue
+		}
+		if len(summaries) == 0 || summaries[len(summaries)-1].name != ps.Stage {
+			summaries = append(summaries, &stageSummary{name: ps.Stage})
+		}
+		summary := summaries[len(summaries)-1]
+		summary.seconds++
+		summary.txs += ps.TxsPerSecond
+		summary.errors += ps.ErrorCount
+		if ps.LatencyP99 > summary.latencyP99 {
+			summary.latencyP99 = ps.LatencyP99
+		}
+	}
+	return summaries
+}
+
 func displayJSONResults(stats *Stats) error {
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
@@ -702,12 +1281,36 @@ func displayCSVResults(stats *Stats) error {
 	fmt.Printf("total_bytes,%d\n", stats.TotalBytes)
 	fmt.Printf("avg_txs_per_second,%.2f\n", stats.AvgTxsPerSecond)
 	fmt.Printf("avg_bytes_per_second,%.2f\n", stats.AvgBytesPerSecond)
+	fmt.Printf("accepted_txs,%d\n", stats.AcceptedTxs)
+	fmt.Printf("failed_txs,%d\n", stats.FailedTxs)
+	fmt.Printf("success_rate,%.2f\n", stats.SuccessRate)
+	fmt.Printf("included_txs,%d\n", stats.IncludedTxs)
+	fmt.Printf("dropped_txs,%d\n", stats.DroppedTxs)
+	fmt.Printf("inclusion_rate,%.2f\n", stats.InclusionRate)
+	fmt.Printf("inclusion_latency_p50_us,%d\n", stats.InclusionLatencyP50.Microseconds())
+	fmt.Printf("inclusion_latency_p90_us,%d\n", stats.InclusionLatencyP90.Microseconds())
+	fmt.Printf("inclusion_latency_p99_us,%d\n", stats.InclusionLatencyP99.Microseconds())
+	if chain := stats.Chain; chain != nil {
+		fmt.Printf("blocks,%d\n", chain.Blocks)
+		fmt.Printf("committed_txs,%d\n", chain.CommittedTxs)
+		fmt.Printf("committed_txs_per_second,%.2f\n", chain.CommittedTxsPerSecond)
+		fmt.Printf("avg_block_time_ms,%d\n", chain.AvgBlockTime.Milliseconds())
+		fmt.Printf("avg_block_bytes,%.1f\n", chain.AvgBlockBytes)
+		fmt.Printf("avg_gas_used,%.1f\n", chain.AvgGasUsed)
+		fmt.Printf("max_mempool_txs,%d\n", chain.MaxMempoolTxs)
+	}
 	fmt.Printf("client_factory,%s\n", stats.ClientFactoryUsed)
 
+	// Transaction outcomes
+	fmt.Println("\noutcome,codespace,code,count")
+	for _, outcome := range stats.Outcomes {
+		fmt.Printf("%s,%s,%d,%d\n", outcome.Outcome, outcome.Codespace, outcome.Code, outcome.Count)
+	}
+
 	// Per-second statistics
-	fmt.Println("\nsecond,txs_per_second,bytes_per_second,latency_p50_us,latency_p75_us,latency_p90_us,latency_p95_us,latency_p99_us")
+	fmt.Println("\nsecond,txs_per_second,bytes_per_second,latency_p50_us,latency_p75_us,latency_p90_us,latency_p95_us,latency_p99_us,success_rate,error_count,included_txs,dropped_txs,stage")
 	for _, ps := range stats.PerSecondStats {
-		fmt.Printf("%d,%.2f,%.2f,%d,%d,%d,%d,%d\n",
+		fmt.Printf("%d,%.2f,%.2f,%d,%d,%d,%d,%d,%.2f,%d,%d,%d,%s\n",
 			ps.Second,
 			ps.TxsPerSecond,
 			ps.BytesPerSecond,
@@ -716,9 +1319,38 @@ func displayCSVResults(stats *Stats) error {
 			ps.LatencyP90.Nanoseconds()/1000,
 			ps.LatencyP95.Nanoseconds()/1000,
 			ps.LatencyP99.Nanoseconds()/1000,
+			ps.SuccessRate,
+			ps.ErrorCount,
+			ps.IncludedTxs,
+			ps.DroppedTxs,
+			ps.Stage,
 		)
 	}
 
+	// Per-second chain statistics
+	if chain := stats.Chain; chain != nil {
+		fmt.Println("\nsecond,blocks,committed_txs,block_bytes,gas_used,avg_block_time_ms,mempool_txs")
+		for _, ps := range chain.PerSecond {
+			fmt.Printf("%d,%d,%d,%d,%d,%d,%d\n",
+				ps.Second,
+				ps.Blocks,
+				ps.CommittedTxs,
+				ps.BlockBytes,
+				ps.GasUsed,
+				ps.AvgBlockTime.Milliseconds(),
+				ps.MempoolTxs,
+			)
+		}
+	}
+
+	// Assertions
+	if len(stats.Assertions) > 0 {
+		fmt.Println("\nassertion,threshold,actual,passed")
+		for _, result := range stats.Assertions {
+			fmt.Printf("%s,%s,%s,%t\n", result.Name, result.Threshold, result.Actual, result.Passed)
+		}
+	}
+
 	return nil
 }
 
@@ -728,6 +1360,22 @@ func displaySummaryResults(stats *Stats) error {
 	fmt.Printf("TOTAL_BYTES=%d\n", stats.TotalBytes)
 	fmt.Printf("AVG_TPS=%.2f\n", stats.AvgTxsPerSecond)
 	fmt.Printf("AVG_THROUGHPUT=%.2f\n", stats.AvgBytesPerSecond)
+	fmt.Printf("ACCEPTED_TXS=%d\n", stats.AcceptedTxs)
+	fmt.Printf("FAILED_TXS=%d\n", stats.FailedTxs)
+	fmt.Printf("SUCCESS_RATE=%.2f\n", stats.SuccessRate)
+	fmt.Printf("INCLUDED_TXS=%d\n", stats.IncludedTxs)
+	fmt.Printf("DROPPED_TXS=%d\n", stats.DroppedTxs)
+	fmt.Printf("INCLUSION_RATE=%.2f\n", stats.InclusionRate)
+	fmt.Printf("INCLUSION_LATENCY_P50=%d\n", stats.InclusionLatencyP50.Nanoseconds()/1000)
+	fmt.Printf("INCLUSION_LATENCY_P99=%d\n", stats.InclusionLatencyP99.Nanoseconds()/1000)
+	if chain := stats.Chain; chain != nil {
+		fmt.Printf("BLOCKS=%d\n", chain.Blocks)
+		fmt.Printf("COMMITTED_TXS=%d\n", chain.CommittedTxs)
+		fmt.Printf("COMMITTED_TPS=%.2f\n", chain.CommittedTxsPerSecond)
+		fmt.Printf("AVG_BLOCK_TIME=%d\n", chain.AvgBlockTime.Milliseconds())
+		fmt.Printf("AVG_GAS_USED=%.0f\n", chain.AvgGasUsed)
+		fmt.Printf("MAX_MEMPOOL_TXS=%d\n", chain.MaxMempoolTxs)
+	}
 	fmt.Printf("CLIENT_FACTORY=%s\n", stats.ClientFactoryUsed)
 
 	if len(stats.PerSecondStats) > 0 {
@@ -739,6 +1387,17 @@ func displaySummaryResults(stats *Stats) error {
 		fmt.Printf("LATENCY_P99=%d\n", lastSec.LatencyP99.Nanoseconds()/1000)
 	}
 
+	if len(stats.Assertions) > 0 {
+		failed := 0
+		for _, result := range stats.Assertions {
+			if !result.Passed {
+				failed++
+			}
+		}
+		fmt.Printf("ASSERTIONS=%d\n", len(stats.Assertions))
+		fmt.Printf("ASSERTIONS_FAILED=%d\n", failed)
+	}
+
 	return nil
 }
 
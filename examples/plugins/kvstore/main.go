@@ -0,0 +1,69 @@
+// Command kvstore is an example cosmosloadtester plugin. It serves the
+// kvstore-plugin client factory, whose clients send random key=value
+// transactions for Tendermint's kvstore ABCI application. Build it into the
+// plugins directory with
+//
+//	go build -o plugins/kvstore ./examples/plugins/kvstore
+//
+// and run the load tester with --plugins-dir=plugins.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/plugin"
+)
+
+// The size of the random key of each transaction, hex encoded
+const keySize = 8
+
+// KVStoreClientFactory creates clients of the kvstore application
+type KVStoreClientFactory struct{}
+
+// KVStoreClient sends key=value transactions padded to the load test's size
+type KVStoreClient struct {
+	size int
+}
+
+var (
+	_ loadtest.ClientFactory = (*KVStoreClientFactory)(nil)
+	_ loadtest.Client        = (*KVStoreClient)(nil)
+)
+
+func (f *KVStoreClientFactory) ValidateConfig(cfg loadtest.Config) error {
+	if min := 2*keySize + 1; cfg.Size < min {
+		return fmt.Errorf("size must be at least %d bytes", min)
+	}
+	return nil
+}
+
+func (f *KVStoreClientFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
+	return &KVStoreClient{size: cfg.Size}, nil
+}
+
+// GenerateTx returns a random key, "=" and a random value filling the rest
+// of the size
+func (c *KVStoreClient) GenerateTx() ([]byte, error) {
+	raw := make([]byte, (c.size-1)/2)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	tx := []byte(hex.EncodeToString(raw))
+	tx = append(tx[:2*keySize], append([]byte("="), tx[2*keySize:]...)...)
+	return tx, nil
+}
+
+func main() {
+	err := plugin.Serve(map[string]loadtest.ClientFactory{
+		"kvstore-plugin": &KVStoreClientFactory{},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
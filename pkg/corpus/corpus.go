@@ -0,0 +1,267 @@
+// Package corpus reads and writes files of pre-signed transactions, so that
+// load tests can replay them without signing during the run.
+//
+// A corpus starts with the magic bytes, followed by the length-prefixed JSON
+// header. The transactions follow, each prefixed by its length as an unsigned
+// varint, sender by sender: the TxsPerSender transactions of the first sender
+// in the order they were signed, then those of the second sender, and so on.
+package corpus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// The bytes every corpus starts with
+const magic = "CLTCORP\x00"
+
+// Version is the version of the format written by Writer
+const Version = 1
+
+// The largest header and transaction a corpus can hold, to fail on corrupt
+// lengths rather than allocate them
+const (
+	maxHeaderSize = 1 << 20
+	maxTxSize     = 64 << 20
+)
+
+// Header describes the transactions of a corpus.
+type Header struct {
+	Version int `json:"version"`
+	// The chain the transactions are signed for, empty if the client factory
+	// doesn't report it
+	ChainID string `json:"chain_id"`
+	// The client factory, and its parameters, that generated the
+	// transactions
+	Factory      string            `json:"factory"`
+	ClientParams map[string]string `json:"client_params,omitempty"`
+	// How many senders signed transactions, and how many each signed
+	Senders      int       `json:"senders"`
+	TxsPerSender int       `json:"txs_per_sender"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Txs returns the number of transactions in the corpus.
+func (h Header) Txs() int {
+	return h.Senders * h.TxsPerSender
+}
+
+// Writer writes a corpus.
+type Writer struct {
+	w      *bufio.Writer
+	closer io.Closer
+	header Header
+	n      int
+}
+
+// NewWriter writes the header of a corpus to w. The header's version is set
+// to Version.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Version = Version
+	if header.Senders <= 0 || header.TxsPerSender <= 0 {
+		return nil, fmt.Errorf("a corpus needs at least one sender and one transaction per sender")
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	cw := &Writer{w: bufio.NewWriter(w), header: header}
+	if _, err := cw.w.WriteString(magic); err != nil {
+		return nil, err
+	}
+	if err := cw.writeRecord(data); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// Create creates the corpus file at path, and writes its header.
+func Create(path string, header Header) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewWriter(f, header)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	w.closer = f
+	return w, nil
+}
+
+// Write appends a transaction of the current sender.
+func (w *Writer) Write(tx []byte) error {
+	if w.n == w.header.Txs() {
+		return fmt.Errorf("corpus already holds %d transactions", w.n)
+	}
+	if err := w.writeRecord(tx); err != nil {
+		return err
+	}
+	w.n++
+	return nil
+}
+
+// Close flushes the corpus, and closes its file if it was created by Create.
+// It fails if fewer transactions than the header announced were written.
+func (w *Writer) Close() error {
+	err := w.w.Flush()
+	if w.closer != nil {
+		if cerr := w.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err == nil && w.n != w.header.Txs() {
+		err = fmt.Errorf("corpus holds %d of %d transactions", w.n, w.header.Txs())
+	}
+	return err
+}
+
+func (w *Writer) writeRecord(data []byte) error {
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(data)))
+	if _, err := w.w.Write(length[:n]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(data)
+	return err
+}
+
+// Reader reads the transactions of one sender of a corpus.
+type Reader struct {
+	r      *bufio.Reader
+	f      *os.File
+	header Header
+	left   int
+}
+
+// ReadHeader reads the header of the corpus file at path.
+func ReadHeader(path string) (Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Header{}, err
+	}
+	defer f.Close()
+	return readHeader(bufio.NewReader(f))
+}
+
+// OpenSender opens the corpus file at path to read the transactions of the
+// given sender, counting from 0.
+func OpenSender(path string, sender int) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{r: bufio.NewReader(f), f: f}
+	if r.header, err = readHeader(r.r); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if sender < 0 || sender >= r.header.Senders {
+		f.Close()
+		return nil, fmt.Errorf("corpus has no sender %d, only %d senders", sender, r.header.Senders)
+	}
+
+	// Skip the transactions of the senders before
+	for i := 0; i < sender*r.header.TxsPerSender; i++ {
+		length, err := readLength(r.r, maxTxSize)
+		if err == nil {
+			_, err = r.r.Discard(length)
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to skip to sender %d: %w", sender, unexpectedEOF(err))
+		}
+	}
+	r.left = r.header.TxsPerSender
+	return r, nil
+}
+
+// Header returns the header of the corpus.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the sender's next transaction, or io.EOF once all of them were
+// read, at which point the file is closed.
+func (r *Reader) Next() ([]byte, error) {
+	if r.left == 0 {
+		return nil, io.EOF
+	}
+	tx, err := readRecord(r.r, maxTxSize)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	r.left--
+	if r.left == 0 {
+		r.Close()
+	}
+	return tx, nil
+}
+
+// Close closes the corpus file, unless it already was.
+func (r *Reader) Close() error {
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+func readHeader(r *bufio.Reader) (Header, error) {
+	var header Header
+	prefix := make([]byte, len(magic))
+	if _, err := io.ReadFull(r, prefix); err != nil || string(prefix) != magic {
+		return header, fmt.Errorf("not a transaction corpus")
+	}
+	data, err := readRecord(r, maxHeaderSize)
+	if err != nil {
+		return header, fmt.Errorf("failed to read corpus header: %w", unexpectedEOF(err))
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return header, fmt.Errorf("failed to decode corpus header: %w", err)
+	}
+	if header.Version != Version {
+		return header, fmt.Errorf("unsupported corpus version %d, expected %d", header.Version, Version)
+	}
+	return header, nil
+}
+
+func readRecord(r *bufio.Reader, max int) ([]byte, error) {
+	length, err := readLength(r, max)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func readLength(r *bufio.Reader, max int) (int, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if length > uint64(max) {
+		return 0, fmt.Errorf("record of %d bytes exceeds the maximum of %d", length, max)
+	}
+	return int(length), nil
+}
+
+// unexpectedEOF reports a corpus that ends early as truncated.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
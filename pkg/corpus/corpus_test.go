@@ -0,0 +1,213 @@
+package corpus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCorpus writes a corpus of the given senders, whose transactions are
+// "s<sender>-t<index>", and returns its path.
+func writeCorpus(t *testing.T, senders, txsPerSender int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	w, err := Create(path, Header{
+		ChainID:      "test-chain",
+		Factory:      "test-factory",
+		ClientParams: map[string]string{"denom": "stake"},
+		Senders:      senders,
+		TxsPerSender: txsPerSender,
+		CreatedAt:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for sender := 0; sender < senders; sender++ {
+		for i := 0; i < txsPerSender; i++ {
+			if err := w.Write(txOf(sender, i)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func txOf(sender, i int) []byte {
+	return []byte(fmt.Sprintf("s%d-t%d", sender, i))
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		senders, txsPerSender int
+	}{
+		{1, 1},
+		{1, 5},
+		{3, 2},
+		{4, 100},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%dx%d", tt.senders, tt.txsPerSender), func(t *testing.T) {
+			path := writeCorpus(t, tt.senders, tt.txsPerSender)
+
+			header, err := ReadHeader(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if header.Version != Version || header.ChainID != "test-chain" || header.Factory != "test-factory" ||
+				header.ClientParams["denom"] != "stake" || header.Senders != tt.senders || header.TxsPerSender != tt.txsPerSender {
+				t.Errorf("header = %+v", header)
+			}
+			if header.Txs() != tt.senders*tt.txsPerSender {
+				t.Errorf("Txs() = %d, want %d", header.Txs(), tt.senders*tt.txsPerSender)
+			}
+
+			// Read the senders in reverse, each from its own reader
+			for sender := tt.senders - 1; sender >= 0; sender-- {
+				r, err := OpenSender(path, sender)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i := 0; i < tt.txsPerSender; i++ {
+					tx, err := r.Next()
+					if err != nil {
+						t.Fatalf("sender %d, transaction %d: %v", sender, i, err)
+					}
+					if want := txOf(sender, i); !bytes.Equal(tx, want) {
+						t.Errorf("sender %d, transaction %d = %q, want %q", sender, i, tx, want)
+					}
+				}
+				if _, err := r.Next(); err != io.EOF {
+					t.Errorf("sender %d: Next() after the last transaction = %v, want io.EOF", sender, err)
+				}
+				// Next already closed the file
+				if err := r.Close(); err != nil {
+					t.Errorf("sender %d: Close() = %v", sender, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncation(t *testing.T) {
+	path := writeCorpus(t, 2, 3)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each transaction is 5 bytes, prefixed by a 1-byte length
+	const record = 6
+	full := len(data)
+
+	tests := []struct {
+		name   string
+		length int
+		// The sender to open, and how many of its transactions can be read
+		sender int
+		reads  int
+		// Whether opening fails, and how
+		openErr    bool
+		unexpected bool
+	}{
+		{name: "inside the magic bytes", length: 4, openErr: true},
+		{name: "after the magic bytes", length: len(magic), openErr: true, unexpected: true},
+		{name: "inside the header", length: len(magic) + 10, openErr: true, unexpected: true},
+		{name: "inside the first sender", length: full - 4*record - 3, sender: 0, reads: 1, unexpected: true},
+		{name: "between the senders, reading the first", length: full - 3*record, sender: 0, reads: 3},
+		{name: "between the senders, reading the second", length: full - 3*record, sender: 1, reads: 0, unexpected: true},
+		{name: "inside the first sender, reading the second", length: full - 4*record - 3, sender: 1, openErr: true, unexpected: true},
+		{name: "inside the last transaction", length: full - 1, sender: 1, reads: 2, unexpected: true},
+		{name: "after a length prefix", length: full - record + 1, sender: 1, reads: 2, unexpected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			truncated := filepath.Join(t.TempDir(), "truncated.bin")
+			if err := os.WriteFile(truncated, data[:tt.length], 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := OpenSender(truncated, tt.sender)
+			if tt.openErr {
+				if err == nil {
+					r.Close()
+					t.Fatal("OpenSender() succeeded, want an error")
+				}
+				if tt.unexpected && !errors.Is(err, io.ErrUnexpectedEOF) {
+					t.Errorf("OpenSender() = %v, want io.ErrUnexpectedEOF", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			for i := 0; i < tt.reads; i++ {
+				if _, err := r.Next(); err != nil {
+					t.Fatalf("transaction %d: %v", i, err)
+				}
+			}
+			_, err = r.Next()
+			switch {
+			case tt.unexpected && !errors.Is(err, io.ErrUnexpectedEOF):
+				t.Errorf("Next() past the truncation = %v, want io.ErrUnexpectedEOF", err)
+			case !tt.unexpected && err != io.EOF:
+				t.Errorf("Next() after the last transaction = %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestWriterCount(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	w, err := Create(path, Header{Senders: 1, TxsPerSender: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err == nil {
+		t.Error("Close() of a corpus missing transactions succeeded")
+	}
+
+	w, err = NewWriter(io.Discard, Header{Senders: 1, TxsPerSender: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]byte("b")); err == nil {
+		t.Error("Write() beyond the announced transactions succeeded")
+	}
+
+	if _, err := NewWriter(io.Discard, Header{Senders: 0, TxsPerSender: 1}); err == nil {
+		t.Error("NewWriter() without senders succeeded")
+	}
+}
+
+func TestOpenSender(t *testing.T) {
+	path := writeCorpus(t, 2, 1)
+	for _, sender := range []int{-1, 2} {
+		if r, err := OpenSender(path, sender); err == nil {
+			r.Close()
+			t.Errorf("OpenSender(%d) of a corpus of 2 senders succeeded", sender)
+		}
+	}
+
+	notCorpus := filepath.Join(t.TempDir(), "txs.jsonl")
+	if err := os.WriteFile(notCorpus, []byte(`{"tx": "AAAA"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadHeader(notCorpus); err == nil {
+		t.Error("ReadHeader() of a file that is not a corpus succeeded")
+	}
+}
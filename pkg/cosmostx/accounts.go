@@ -0,0 +1,408 @@
+package cosmostx
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+// How long to wait for an account query
+const accountQueryTimeout = 10 * time.Second
+
+// AccountConfig tells a client factory which funded accounts to sign with,
+// either sub-accounts derived from a mnemonic or keys in a keyring.
+type AccountConfig struct {
+	// The mnemonic of a funded account, or a file holding it.
+	Mnemonic     string
+	MnemonicFile string
+	// The number of sub-accounts to derive from the mnemonic, at
+	// m/44'/118'/0'/0/0 to m/44'/118'/0'/0/{Accounts-1}. Defaults to 1.
+	Accounts int
+	// A keyring holding funded keys, used instead of a mnemonic.
+	KeyringDir     string
+	KeyringBackend string
+	KeyNames       []string
+	// The bech32 prefix of account addresses.
+	AddressPrefix string
+	// The gRPC address of a node to query accounts through. If empty,
+	// accounts are queried with /abci_query on the first load test endpoint.
+	GRPCAddress string
+}
+
+// RegisterFlags binds the configuration to flags prefixed with prefix-, with
+// name identifying the client factory in their usage. The mnemonic can also be
+// given in the PREFIX_MNEMONIC environment variable, so that it doesn't show
+// up in the process list.
+func (c *AccountConfig) RegisterFlags(fs *flag.FlagSet, prefix, name string) {
+	envVar := strings.ToUpper(strings.ReplaceAll(prefix, "-", "_")) + "_MNEMONIC"
+	c.Mnemonic = os.Getenv(envVar)
+	fs.StringVar(&c.MnemonicFile, prefix+"-mnemonic-file", "", fmt.Sprintf("File holding the mnemonic of the funded account to sign %s transactions with (or set %s)", name, envVar))
+	fs.IntVar(&c.Accounts, prefix+"-accounts", 1, fmt.Sprintf("Number of sub-accounts to derive from the %s mnemonic", name))
+	fs.StringVar(&c.KeyringDir, prefix+"-keyring-dir", "", fmt.Sprintf("Keyring directory holding the funded %s keys, instead of a mnemonic", name))
+	fs.StringVar(&c.KeyringBackend, prefix+"-keyring-backend", keyring.BackendTest, fmt.Sprintf("Backend of the %s keyring: os, file or test", name))
+	fs.Func(prefix+"-key-names", fmt.Sprintf("Comma-separated names of the %s keys in the keyring", name), func(s string) error {
+		c.KeyNames = strings.Split(s, ",")
+		return nil
+	})
+	fs.StringVar(&c.AddressPrefix, prefix+"-address-prefix", sdk.Bech32MainPrefix, fmt.Sprintf("Bech32 prefix of %s account addresses", name))
+	fs.StringVar(&c.GRPCAddress, prefix+"-grpc", "", fmt.Sprintf("gRPC address of a node to query %s accounts through, e.g. localhost:9090 (defaults to /abci_query on the first endpoint)", name))
+}
+
+// Account is a funded account whose sequence is shared by every client
+// signing with it.
+type Account struct {
+	address sdk.AccAddress
+	// The bech32 form of address, with the configured prefix
+	bech32 string
+	pubKey cryptotypes.PubKey
+	sign   func(msg []byte) ([]byte, error)
+
+	mtx      sync.Mutex
+	number   uint64
+	sequence uint64
+}
+
+// NextSequence reserves the next sequence for a transaction.
+func (a *Account) NextSequence() (accountNumber, sequence uint64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	sequence = a.sequence
+	a.sequence++
+	return a.number, sequence
+}
+
+// Current returns the account number and the next sequence without
+// reserving it.
+func (a *Account) Current() (accountNumber, sequence uint64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return a.number, a.sequence
+}
+
+// Address returns the address of the account.
+func (a *Account) Address() sdk.AccAddress {
+	return a.address
+}
+
+// String returns the address of the account in bech32, with the configured
+// prefix.
+func (a *Account) String() string {
+	return a.bech32
+}
+
+func (a *Account) setSequence(sequence uint64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.sequence = sequence
+}
+
+// AccountPool hands out the configured accounts round-robin.
+type AccountPool struct {
+	config   AccountConfig
+	registry codectypes.InterfaceRegistry
+	// The mnemonic the accounts were derived from, empty for keyrings
+	mnemonic string
+	accounts []*Account
+	next     int
+	mtx      sync.Mutex
+}
+
+// NewAccountPool loads the configured accounts.
+func NewAccountPool(config AccountConfig) (*AccountPool, error) {
+	pool := NewEmptyPool(config)
+
+	mnemonic := config.Mnemonic
+	if config.MnemonicFile != "" {
+		blob, err := os.ReadFile(config.MnemonicFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mnemonic file: %w", err)
+		}
+		mnemonic = string(blob)
+	}
+	mnemonic = strings.TrimSpace(mnemonic)
+
+	switch {
+	case mnemonic != "":
+		n := config.Accounts
+		if n <= 0 {
+			n = 1
+		}
+		if err := pool.Derive(mnemonic, 0, n); err != nil {
+			return nil, err
+		}
+	case config.KeyringDir != "":
+		if len(config.KeyNames) == 0 {
+			return nil, fmt.Errorf("no key names given for keyring %s", config.KeyringDir)
+		}
+		kr, err := keyring.New(sdk.KeyringServiceName(), config.KeyringBackend, config.KeyringDir, os.Stdin, codec.NewProtoCodec(pool.registry))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open keyring: %w", err)
+		}
+		for _, name := range config.KeyNames {
+			name := strings.TrimSpace(name)
+			record, err := kr.Key(name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get key %q: %w", name, err)
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get public key of %q: %w", name, err)
+			}
+			pool.add(pubKey, func(msg []byte) ([]byte, error) {
+				sig, _, err := kr.Sign(name, msg)
+				return sig, err
+			})
+		}
+	default:
+		return nil, fmt.Errorf("no funded account configured: set a mnemonic or a keyring")
+	}
+	return pool, nil
+}
+
+// Accounts returns the accounts of the pool.
+func (p *AccountPool) Accounts() []*Account {
+	return p.accounts
+}
+
+// NewEmptyPool creates a pool without accounts, which queries the chain as
+// configured.
+func NewEmptyPool(config AccountConfig) *AccountPool {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+
+	if config.AddressPrefix == "" {
+		config.AddressPrefix = sdk.Bech32MainPrefix
+	}
+	return &AccountPool{config: config, registry: registry}
+}
+
+// Derive adds the first n accounts derived from the mnemonic at
+// m/44'/118'/{hdAccount}'/0/{i}.
+func (p *AccountPool) Derive(mnemonic string, hdAccount uint32, n int) error {
+	p.mnemonic = mnemonic
+	for i := 0; i < n; i++ {
+		hdPath := hd.CreateHDPath(sdk.CoinType, hdAccount, uint32(i)).String()
+		derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, "", hdPath)
+		if err != nil {
+			return fmt.Errorf("failed to derive account %s: %w", hdPath, err)
+		}
+		privKey := hd.Secp256k1.Generate()(derivedPriv)
+		p.add(privKey.PubKey(), privKey.Sign)
+	}
+	return nil
+}
+
+func (p *AccountPool) add(pubKey cryptotypes.PubKey, sign func([]byte) ([]byte, error)) {
+	address := sdk.AccAddress(pubKey.Address())
+	p.accounts = append(p.accounts, &Account{
+		address: address,
+		bech32:  sdk.MustBech32ifyAddressBytes(p.config.AddressPrefix, address),
+		pubKey:  pubKey,
+		sign:    sign,
+	})
+}
+
+// Acquire returns the next account, with its account number and sequence
+// refreshed from the chain through the given endpoints. Accounts are shared
+// when there are more clients than accounts.
+func (p *AccountPool) Acquire(endpoints []string) (*Account, error) {
+	p.mtx.Lock()
+	acc := p.accounts[p.next%len(p.accounts)]
+	p.next++
+	p.mtx.Unlock()
+
+	if err := p.Refresh(endpoints, acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// Refresh sets the account number and sequence of the account to the ones
+// on chain.
+func (p *AccountPool) Refresh(endpoints []string, acc *Account) error {
+	number, sequence, err := p.Query(endpoints, acc)
+	if err != nil {
+		return err
+	}
+	acc.mtx.Lock()
+	acc.number = number
+	acc.sequence = sequence
+	acc.mtx.Unlock()
+	return nil
+}
+
+// After returns the account that follows acc in the pool, or acc itself if it
+// is the only one.
+func (p *AccountPool) After(acc *Account) *Account {
+	for i, a := range p.accounts {
+		if a == acc {
+			return p.accounts[(i+1)%len(p.accounts)]
+		}
+	}
+	return acc
+}
+
+// Before returns the account that precedes acc in the pool, or acc itself if
+// it is the only one.
+func (p *AccountPool) Before(acc *Account) *Account {
+	for i, a := range p.accounts {
+		if a == acc {
+			return p.accounts[(i+len(p.accounts)-1)%len(p.accounts)]
+		}
+	}
+	return acc
+}
+
+// Query returns the account number and sequence of the account on chain.
+func (p *AccountPool) Query(endpoints []string, acc *Account) (uint64, uint64, error) {
+	var res authtypes.QueryAccountResponse
+	if err := p.queryApp(endpoints, "/cosmos.auth.v1beta1.Query/Account", &authtypes.QueryAccountRequest{Address: acc.bech32}, &res); err != nil {
+		return 0, 0, fmt.Errorf("failed to query account %s, is it funded? %w", acc.bech32, err)
+	}
+
+	var accountI authtypes.AccountI
+	if err := p.registry.UnpackAny(res.Account, &accountI); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode account %s: %w", acc.bech32, err)
+	}
+	return accountI.GetAccountNumber(), accountI.GetSequence(), nil
+}
+
+// Balance returns the balance of the account in the given denom.
+func (p *AccountPool) Balance(endpoints []string, acc *Account, denom string) (sdk.Int, error) {
+	var res banktypes.QueryBalanceResponse
+	if err := p.queryApp(endpoints, "/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{Address: acc.bech32, Denom: denom}, &res); err != nil {
+		return sdk.Int{}, fmt.Errorf("failed to query the balance of %s: %w", acc.bech32, err)
+	}
+	if res.Balance == nil {
+		return sdk.ZeroInt(), nil
+	}
+	return res.Balance.Amount, nil
+}
+
+// Simulate returns the gas the signed transaction uses when simulated.
+func (p *AccountPool) Simulate(endpoints []string, tx []byte) (uint64, error) {
+	var res txtypes.SimulateResponse
+	if err := p.queryApp(endpoints, "/cosmos.tx.v1beta1.Service/Simulate", &txtypes.SimulateRequest{TxBytes: tx}, &res); err != nil {
+		return 0, fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+	if res.GasInfo == nil {
+		return 0, fmt.Errorf("failed to simulate transaction: no gas info")
+	}
+	return res.GasInfo.GasUsed, nil
+}
+
+// queryApp calls the gRPC query method at path, through the configured gRPC
+// address or else through /abci_query on the first endpoint.
+func (p *AccountPool) queryApp(endpoints []string, path string, req, res codec.ProtoMarshaler) error {
+	if p.config.GRPCAddress != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), accountQueryTimeout)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, p.config.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", p.config.GRPCAddress, err)
+		}
+		defer conn.Close()
+		return conn.Invoke(ctx, path, req, res)
+	}
+
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no endpoint to query through")
+	}
+	client, err := httprpc.NewHTTPRPCClient(rpcURL(endpoints[0]))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	data, err := req.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode query: %w", err)
+	}
+	queryRes, err := client.ABCIQuery(path, data)
+	if err != nil {
+		return err
+	}
+	if queryRes.Response.Code != 0 {
+		return fmt.Errorf("query failed with code %d: %s", queryRes.Response.Code, queryRes.Response.Log)
+	}
+	if err := res.Unmarshal(queryRes.Response.Value); err != nil {
+		return fmt.Errorf("failed to decode query response: %w", err)
+	}
+	return nil
+}
+
+// Resync brings the sequence of the account back in line with the chain
+// after a transaction was rejected for the wrong sequence.
+func (p *AccountPool) Resync(endpoints []string, acc *Account, log string) {
+	if expected, ok := expectedSequence(log); ok {
+		acc.setSequence(expected)
+		return
+	}
+	_, sequence, err := p.Query(endpoints, acc)
+	if err != nil {
+		logrus.Debugf("Failed to resync the sequence of %s: %v", acc.bech32, err)
+		return
+	}
+	acc.setSequence(sequence)
+}
+
+var sequenceMismatchRE = regexp.MustCompile(`expected (\d+), got \d+`)
+
+// expectedSequence parses the sequence the chain expected out of the log of
+// an ErrWrongSequence rejection.
+func expectedSequence(log string) (uint64, bool) {
+	m := sequenceMismatchRE.FindStringSubmatch(log)
+	if m == nil {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(m[1], 10, 64)
+	return sequence, err == nil
+}
+
+// IsWrongSequence reports whether the code is the SDK's ErrWrongSequence.
+func IsWrongSequence(code int, codespace string) bool {
+	return codespace == sdkerrors.ErrWrongSequence.Codespace() && code == int(sdkerrors.ErrWrongSequence.ABCICode())
+}
+
+// rpcURL returns the HTTP RPC URL of a WebSocket or HTTP endpoint.
+func rpcURL(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	}
+	u.Path = ""
+	return u.String()
+}
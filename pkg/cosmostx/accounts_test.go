@@ -0,0 +1,284 @@
+package cosmostx
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// The well-known all-"abandon" test mnemonic, which holds no funds anywhere
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// newTestPool derives n accounts from the test mnemonic.
+func newTestPool(t *testing.T, n int) *AccountPool {
+	t.Helper()
+	pool, err := NewAccountPool(AccountConfig{Mnemonic: testMnemonic, Accounts: n})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+// newTestNode starts a JSON-RPC server that answers account queries through
+// abci_query with the given account number and sequence, and counts them.
+func newTestNode(t *testing.T, number, sequence uint64) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var queries atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Path string `json:"path"`
+			} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Method != "abci_query" || req.Params.Path != "/cosmos.auth.v1beta1.Query/Account" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		queries.Add(1)
+		account, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{AccountNumber: number, Sequence: sequence})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		value, err := (&authtypes.QueryAccountResponse{Account: account}).Marshal()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"response": map[string]any{"code": 0, "value": value}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &queries
+}
+
+func TestExpectedSequence(t *testing.T) {
+	tests := []struct {
+		log    string
+		want   uint64
+		wantOK bool
+	}{
+		{"account sequence mismatch, expected 12, got 10: incorrect account sequence", 12, true},
+		{"expected 0, got 3", 0, true},
+		{"insufficient fees", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := expectedSequence(tt.log)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("expectedSequence(%q) = %d, %t, want %d, %t", tt.log, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestIsWrongSequence(t *testing.T) {
+	tests := []struct {
+		code      int
+		codespace string
+		want      bool
+	}{
+		{int(sdkerrors.ErrWrongSequence.ABCICode()), sdkerrors.ErrWrongSequence.Codespace(), true},
+		{int(sdkerrors.ErrWrongSequence.ABCICode()), "wasm", false},
+		{int(sdkerrors.ErrInsufficientFee.ABCICode()), sdkerrors.ErrInsufficientFee.Codespace(), false},
+		{0, "", false},
+	}
+	for _, tt := range tests {
+		if got := IsWrongSequence(tt.code, tt.codespace); got != tt.want {
+			t.Errorf("IsWrongSequence(%d, %q) = %t, want %t", tt.code, tt.codespace, got, tt.want)
+		}
+	}
+}
+
+func TestRPCURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"ws://localhost:26657/websocket", "http://localhost:26657"},
+		{"wss://rpc.example.com/websocket", "https://rpc.example.com"},
+		{"http://localhost:26657", "http://localhost:26657"},
+		{"https://rpc.example.com/", "https://rpc.example.com"},
+	}
+	for _, tt := range tests {
+		if got := rpcURL(tt.endpoint); got != tt.want {
+			t.Errorf("rpcURL(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestAccountConfigRegisterFlags(t *testing.T) {
+	t.Setenv("MY_APP_MNEMONIC", testMnemonic)
+	var c AccountConfig
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	c.RegisterFlags(fs, "my-app", "my app")
+	err := fs.Parse([]string{
+		"--my-app-accounts=3",
+		"--my-app-key-names=a,b",
+		"--my-app-address-prefix=aiw3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Mnemonic != testMnemonic {
+		t.Errorf("Mnemonic = %q, want the one in MY_APP_MNEMONIC", c.Mnemonic)
+	}
+	if c.Accounts != 3 {
+		t.Errorf("Accounts = %d, want 3", c.Accounts)
+	}
+	if len(c.KeyNames) != 2 || c.KeyNames[0] != "a" || c.KeyNames[1] != "b" {
+		t.Errorf("KeyNames = %q, want [a b]", c.KeyNames)
+	}
+	if c.AddressPrefix != "aiw3" {
+		t.Errorf("AddressPrefix = %q, want aiw3", c.AddressPrefix)
+	}
+	if c.KeyringBackend != "test" {
+		t.Errorf("KeyringBackend = %q, want test", c.KeyringBackend)
+	}
+}
+
+func TestNewAccountPool(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       AccountConfig
+		wantAccounts int
+		wantPrefix   string
+		wantErr      bool
+	}{
+		{"one account by default", AccountConfig{Mnemonic: testMnemonic}, 1, "cosmos", false},
+		{"sub-accounts", AccountConfig{Mnemonic: testMnemonic, Accounts: 3, AddressPrefix: "aiw3"}, 3, "aiw3", false},
+		{"no accounts configured", AccountConfig{}, 0, "", true},
+		{"keyring without key names", AccountConfig{KeyringDir: t.TempDir()}, 0, "", true},
+		{"invalid mnemonic", AccountConfig{Mnemonic: "not a mnemonic"}, 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, err := NewAccountPool(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAccountPool() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(pool.accounts) != tt.wantAccounts {
+				t.Fatalf("%d accounts, want %d", len(pool.accounts), tt.wantAccounts)
+			}
+			seen := make(map[string]bool)
+			for _, acc := range pool.accounts {
+				prefix, _, err := bech32.DecodeAndConvert(acc.String())
+				if err != nil || prefix != tt.wantPrefix {
+					t.Errorf("address %s has prefix %q (%v), want %q", acc, prefix, err, tt.wantPrefix)
+				}
+				if seen[acc.String()] {
+					t.Errorf("account %s derived twice", acc)
+				}
+				seen[acc.String()] = true
+			}
+		})
+	}
+}
+
+func TestAccountPoolAcquire(t *testing.T) {
+	srv, queries := newTestNode(t, 7, 3)
+	pool := newTestPool(t, 2)
+
+	first, err := pool.Acquire([]string{srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := pool.Acquire([]string{srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	third, err := pool.Acquire([]string{srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second || third != first {
+		t.Errorf("Acquire() doesn't hand out the accounts round-robin")
+	}
+	if got := queries.Load(); got != 3 {
+		t.Errorf("%d account queries, want 3", got)
+	}
+	if pool.After(first) != second || pool.After(second) != first {
+		t.Errorf("After() doesn't return the next account of the pool")
+	}
+
+	for _, wantSequence := range []uint64{3, 4, 5} {
+		number, sequence := first.NextSequence()
+		if number != 7 || sequence != wantSequence {
+			t.Errorf("NextSequence() = %d, %d, want 7, %d", number, sequence, wantSequence)
+		}
+	}
+}
+
+func TestAccountPoolNeighbours(t *testing.T) {
+	pool := newTestPool(t, 3)
+	a, b, c := pool.accounts[0], pool.accounts[1], pool.accounts[2]
+	tests := []struct {
+		acc           *Account
+		after, before *Account
+	}{
+		{a, b, c},
+		{b, c, a},
+		{c, a, b},
+	}
+	for i, tt := range tests {
+		if got := pool.After(tt.acc); got != tt.after {
+			t.Errorf("After(account %d) = %s, want %s", i, got, tt.after)
+		}
+		if got := pool.Before(tt.acc); got != tt.before {
+			t.Errorf("Before(account %d) = %s, want %s", i, got, tt.before)
+		}
+	}
+
+	single := newTestPool(t, 1)
+	only := single.accounts[0]
+	if single.After(only) != only || single.Before(only) != only {
+		t.Errorf("After() and Before() of the only account aren't the account itself")
+	}
+}
+
+func TestAccountPoolResync(t *testing.T) {
+	tests := []struct {
+		name         string
+		log          string
+		wantSequence uint64
+		wantQueries  int64
+	}{
+		{"sequence in the log", "account sequence mismatch, expected 9, got 5: incorrect account sequence", 9, 0},
+		{"sequence queried", "incorrect account sequence", 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, queries := newTestNode(t, 7, 3)
+			pool := newTestPool(t, 1)
+			acc := pool.accounts[0]
+			acc.setSequence(5)
+
+			pool.Resync([]string{srv.URL}, acc, tt.log)
+			if _, sequence := acc.NextSequence(); sequence != tt.wantSequence {
+				t.Errorf("sequence after Resync() = %d, want %d", sequence, tt.wantSequence)
+			}
+			if got := queries.Load(); got != tt.wantQueries {
+				t.Errorf("%d account queries, want %d", got, tt.wantQueries)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package cosmostx
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// FactoryParams are the parameters of a client factory, which embed TxParams
+// and can be overridden for a load test by its client parameters.
+type FactoryParams[P any] interface {
+	WithOverrides(params hybridloadtest.ClientParams) (P, error)
+	Tx() TxParams
+}
+
+// Factory is what the factories of clients that sign Cosmos SDK transactions
+// share: their default parameters and the accounts their clients sign with.
+// Factories embed it, which implements everything but
+// NewClientWithParams, and only build their clients' messages.
+type Factory[P FactoryParams[P]] struct {
+	txConfig client.TxConfig
+	signers  *Signers
+	params   P
+	// The factory embedding this one, whose ValidateParams and
+	// NewClientWithParams ValidateConfig and NewClient call
+	outer hybridloadtest.ParamsClientFactory
+}
+
+// NewFactory creates the shared part of the factory outer, for clients that
+// sign with the given funded accounts, with the given default parameters.
+func NewFactory[P FactoryParams[P]](outer hybridloadtest.ParamsClientFactory, txConfig client.TxConfig, accounts AccountConfig, params P) Factory[P] {
+	return Factory[P]{
+		txConfig: txConfig,
+		signers:  NewSigners(txConfig, accounts),
+		params:   params,
+		outer:    outer,
+	}
+}
+
+// ValidateConfig validates the configuration with the factory's default
+// parameters.
+func (f *Factory[P]) ValidateConfig(cfg loadtest.Config) error {
+	return f.outer.ValidateParams(cfg, nil)
+}
+
+// ValidateParams validates the configuration along with the factory's
+// parameters overridden by params, and loads the configured accounts.
+func (f *Factory[P]) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	if cfg.Connections <= 0 {
+		return fmt.Errorf("connections must be > 0")
+	}
+	if cfg.Rate <= 0 {
+		return fmt.Errorf("rate must be > 0")
+	}
+	if _, err := f.Params(params); err != nil {
+		return err
+	}
+	_, err := f.signers.Pool()
+	return err
+}
+
+// FundAccounts funds one sender account per connection from the first
+// configured account, which the factory's clients sign with until the
+// teardown.
+func (f *Factory[P]) FundAccounts(cfg loadtest.Config, params hybridloadtest.ClientParams, funding hybridloadtest.FundingConfig) (hybridloadtest.Teardown, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	return f.signers.Fund(cfg, p.Tx(), funding)
+}
+
+// ChainID returns the chain ID the factory's clients sign for with params.
+func (f *Factory[P]) ChainID(params hybridloadtest.ClientParams) (string, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return "", err
+	}
+	return p.Tx().ChainID, nil
+}
+
+// PoolSize returns the number of accounts new clients sign with: the funded
+// sender accounts during a funded load test, the configured accounts
+// otherwise.
+func (f *Factory[P]) PoolSize(params hybridloadtest.ClientParams) (int, error) {
+	pool, err := f.signers.ClientPool()
+	if err != nil {
+		return 0, err
+	}
+	return len(pool.Accounts()), nil
+}
+
+// NewClient creates a client with the factory's default parameters.
+func (f *Factory[P]) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
+	return f.outer.NewClientWithParams(cfg, nil)
+}
+
+// Params returns the factory's parameters overridden by params.
+func (f *Factory[P]) Params(params hybridloadtest.ClientParams) (P, error) {
+	p, err := f.params.WithOverrides(params)
+	if err != nil {
+		return p, fmt.Errorf("invalid client parameters: %w", err)
+	}
+	return p, nil
+}
+
+// TxConfig returns the configuration transactions are encoded with.
+func (f *Factory[P]) TxConfig() client.TxConfig {
+	return f.txConfig
+}
+
+// Signers returns the accounts the factory's clients sign with.
+func (f *Factory[P]) Signers() *Signers {
+	return f.signers
+}
+
+// NewSender returns the sender of a new client, which signs with the next
+// account of the pool new clients sign with, refreshed from the chain.
+func (f *Factory[P]) NewSender(cfg loadtest.Config, p TxParams) (*Sender, error) {
+	pool, err := f.signers.ClientPool()
+	if err != nil {
+		return nil, err
+	}
+	acc, err := pool.Acquire(cfg.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+	return NewSender(f.txConfig, p, pool, acc, cfg.Endpoints), nil
+}
+
+// Sender is what clients that sign Cosmos SDK transactions share: the
+// account they sign with, the pool it belongs to and the endpoints to look it
+// up on. Clients embed it, which handles rejections for having the wrong
+// sequence.
+type Sender struct {
+	txConfig client.TxConfig
+	params   TxParams
+	// The account the client signs with, or signed its last transaction
+	// with if it signs with several
+	Account   *Account
+	Pool      *AccountPool
+	Endpoints []string
+}
+
+var (
+	_ hybridloadtest.RejectionHandler = (*Sender)(nil)
+	_ hybridloadtest.SequencedClient  = (*Sender)(nil)
+)
+
+// NewSender creates a sender that signs with the account of the pool.
+func NewSender(txConfig client.TxConfig, p TxParams, pool *AccountPool, acc *Account, endpoints []string) *Sender {
+	return &Sender{
+		txConfig:  txConfig,
+		params:    p,
+		Account:   acc,
+		Pool:      pool,
+		Endpoints: endpoints,
+	}
+}
+
+// Sign signs a transaction with the messages as the sender's account, paying
+// the fee of the gas limit.
+func (s *Sender) Sign(accountNumber, sequence uint64, msgs []sdk.Msg, gasLimit uint64, memo string) ([]byte, error) {
+	return SignTx(s.txConfig, s.params.ChainID, s.Account, accountNumber, sequence, msgs, gasLimit, s.params.Fee(gasLimit), memo)
+}
+
+// SimulateGas returns the gas limit of the transaction sign signs: the gas it
+// uses when simulated with the account's current sequence, times the gas
+// adjustment.
+func (s *Sender) SimulateGas(sign func(accountNumber, sequence uint64) ([]byte, error)) (uint64, error) {
+	accountNumber, sequence := s.Account.Current()
+	tx, err := sign(accountNumber, sequence)
+	if err != nil {
+		return 0, err
+	}
+	gasUsed, err := s.Pool.Simulate(s.Endpoints, tx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(float64(gasUsed) * s.params.GasAdjustment), nil
+}
+
+// Sequenced reports that the sender's transactions carry sequences.
+func (s *Sender) Sequenced() bool {
+	return true
+}
+
+// HandleRejection resyncs the sequence of the account when the node rejects a
+// transaction for having the wrong one. Other rejections leave the sequence
+// unused, which the next transaction's rejection recovers from.
+func (s *Sender) HandleRejection(code int, codespace, log string) {
+	if s.Account != nil && IsWrongSequence(code, codespace) {
+		s.Pool.Resync(s.Endpoints, s.Account, log)
+	}
+}
@@ -0,0 +1,145 @@
+package cosmostx
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// testFactory creates clients that only carry their sender.
+type testFactory struct {
+	Factory[TxParams]
+}
+
+func newTestFactory(accounts AccountConfig) *testFactory {
+	f := &testFactory{}
+	f.Factory = NewFactory[TxParams](f, newTestTxConfig(), accounts, testTxParams)
+	return f
+}
+
+func (f *testFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	p, err := f.Params(params)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := f.NewSender(cfg, p)
+	if err != nil {
+		return nil, err
+	}
+	return testClient{sender}, nil
+}
+
+type testClient struct {
+	*Sender
+}
+
+func (testClient) GenerateTx() ([]byte, error) { return nil, nil }
+
+func TestFactoryValidateParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      loadtest.Config
+		accounts AccountConfig
+		params   hybridloadtest.ClientParams
+		wantErr  bool
+	}{
+		{"valid", loadtest.Config{Connections: 1, Rate: 10}, AccountConfig{Mnemonic: testMnemonic}, nil, false},
+		{"valid parameters", loadtest.Config{Connections: 1, Rate: 10}, AccountConfig{Mnemonic: testMnemonic}, hybridloadtest.ClientParams{"gas_limit": "100000"}, false},
+		{"invalid parameters", loadtest.Config{Connections: 1, Rate: 10}, AccountConfig{Mnemonic: testMnemonic}, hybridloadtest.ClientParams{"gas_limit": "0"}, true},
+		{"unknown parameter", loadtest.Config{Connections: 1, Rate: 10}, AccountConfig{Mnemonic: testMnemonic}, hybridloadtest.ClientParams{"amount": "1"}, true},
+		{"no connections", loadtest.Config{Rate: 10}, AccountConfig{Mnemonic: testMnemonic}, nil, true},
+		{"no rate", loadtest.Config{Connections: 1}, AccountConfig{Mnemonic: testMnemonic}, nil, true},
+		{"no accounts", loadtest.Config{Connections: 1, Rate: 10}, AccountConfig{}, nil, true},
+	}
+	for _, tt := range tests {
+		f := newTestFactory(tt.accounts)
+		if err := f.ValidateParams(tt.cfg, tt.params); (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateParams() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+		if tt.params == nil {
+			if err := f.ValidateConfig(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("%s: ValidateConfig() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			}
+		}
+	}
+}
+
+func TestFactoryChainID(t *testing.T) {
+	tests := []struct {
+		params  hybridloadtest.ClientParams
+		want    string
+		wantErr bool
+	}{
+		{nil, "test-chain", false},
+		{hybridloadtest.ClientParams{"chain_id": "other-chain"}, "other-chain", false},
+		{hybridloadtest.ClientParams{"chain_id": ""}, "", true},
+	}
+	f := newTestFactory(AccountConfig{Mnemonic: testMnemonic})
+	for _, tt := range tests {
+		got, err := f.ChainID(tt.params)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ChainID(%v) = %q, %v, want %q", tt.params, got, err, tt.want)
+		}
+	}
+}
+
+func TestSenderHandleRejection(t *testing.T) {
+	wrongSequence := int(sdkerrors.ErrWrongSequence.ABCICode())
+	tests := []struct {
+		name         string
+		code         int
+		codespace    string
+		log          string
+		wantSequence uint64
+	}{
+		{"wrong sequence", wrongSequence, sdkerrors.ErrWrongSequence.Codespace(), "account sequence mismatch, expected 2, got 6", 2},
+		{"other rejection", int(sdkerrors.ErrInsufficientFee.ABCICode()), sdkerrors.ErrInsufficientFee.Codespace(), "insufficient fees", 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newTestNode(t, 1, 5)
+			f := newTestFactory(AccountConfig{Mnemonic: testMnemonic})
+			client, err := f.NewClient(loadtest.Config{Endpoints: []string{srv.URL}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sender := client.(testClient).Sender
+			sender.Account.NextSequence()
+			sender.Account.NextSequence()
+
+			sender.HandleRejection(tt.code, tt.codespace, tt.log)
+			if _, sequence := sender.Account.NextSequence(); sequence != tt.wantSequence {
+				t.Errorf("sequence after HandleRejection() = %d, want %d", sequence, tt.wantSequence)
+			}
+		})
+	}
+}
+
+func TestSenderSimulateGas(t *testing.T) {
+	chain := newFakeChain(t, 0, 0)
+	f := newTestFactory(AccountConfig{Mnemonic: testMnemonic})
+	sender, err := f.NewSender(loadtest.Config{Endpoints: []string{chain.URL}}, testTxParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signedSequence uint64 = 99
+	gasLimit, err := sender.SimulateGas(func(accountNumber, sequence uint64) ([]byte, error) {
+		signedSequence = sequence
+		return sender.Sign(accountNumber, sequence, nil, testTxParams.GasLimit, "")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The simulated 80000 gas times the gas adjustment of 1.5
+	if gasLimit != 120000 {
+		t.Errorf("SimulateGas() = %d, want 120000", gasLimit)
+	}
+	// Simulating doesn't reserve the sequence it signs with
+	if _, sequence := sender.Account.NextSequence(); signedSequence != 0 || sequence != 0 {
+		t.Errorf("simulated with sequence %d and reserved %d, want 0 and 0", signedSequence, sequence)
+	}
+}
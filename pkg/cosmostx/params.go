@@ -0,0 +1,117 @@
+package cosmostx
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// TxParams configure the chain, gas and fees of transactions. They can be
+// overridden for a load test by the client parameters chain_id, denom,
+// gas_limit, gas_price, simulate_gas and gas_adjustment.
+type TxParams struct {
+	ChainID string
+	// The denom of the fees
+	Denom string
+	// The gas limit of every transaction, unless SimulateGas is set
+	GasLimit uint64
+	// The fee per unit of gas, in Denom
+	GasPrice sdk.Dec
+	// Whether to estimate the gas limit by simulating a transaction with
+	// /cosmos.tx.v1beta1.Service/Simulate when a client is created, and the
+	// factor the estimate is multiplied by
+	SimulateGas   bool
+	GasAdjustment float64
+}
+
+// RegisterFlags binds the parameters to flags prefixed with prefix-, with the
+// current values as defaults and name identifying the client factory in their
+// usage.
+func (p *TxParams) RegisterFlags(fs *flag.FlagSet, prefix, name string) {
+	fs.StringVar(&p.ChainID, prefix+"-chain-id", p.ChainID, fmt.Sprintf("Chain ID of %s transactions", name))
+	fs.StringVar(&p.Denom, prefix+"-denom", p.Denom, fmt.Sprintf("Denom of %s transfers and fees", name))
+	fs.Uint64Var(&p.GasLimit, prefix+"-gas-limit", p.GasLimit, fmt.Sprintf("Gas limit of %s transactions", name))
+	fs.Func(prefix+"-gas-price", fmt.Sprintf("Gas price of %s transactions, in the denom (default %s)", name, p.GasPrice), func(s string) error {
+		gasPrice, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return err
+		}
+		p.GasPrice = gasPrice
+		return nil
+	})
+	fs.BoolVar(&p.SimulateGas, prefix+"-simulate-gas", p.SimulateGas, fmt.Sprintf("Estimate the gas limit of %s transactions by simulating them", name))
+	fs.Float64Var(&p.GasAdjustment, prefix+"-gas-adjustment", p.GasAdjustment, "Factor to multiply simulated gas estimates by")
+}
+
+// Validate checks that the parameters make valid transactions.
+func (p TxParams) Validate() error {
+	if p.ChainID == "" {
+		return fmt.Errorf("chain_id is required")
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if !p.SimulateGas && p.GasLimit == 0 {
+		return fmt.Errorf("gas_limit must be > 0")
+	}
+	if p.GasPrice.IsNil() || p.GasPrice.IsNegative() {
+		return fmt.Errorf("gas_price must be >= 0")
+	}
+	if p.SimulateGas && p.GasAdjustment < 1 {
+		return fmt.Errorf("gas_adjustment must be >= 1")
+	}
+	return nil
+}
+
+// Override sets the parameter with the given client parameter name to value.
+// It reports false if name isn't one of the parameters.
+func (p *TxParams) Override(name, value string) (bool, error) {
+	var err error
+	switch name {
+	case "chain_id":
+		p.ChainID = value
+	case "denom":
+		p.Denom = value
+	case "gas_limit":
+		p.GasLimit, err = strconv.ParseUint(value, 10, 64)
+	case "gas_price":
+		p.GasPrice, err = sdk.NewDecFromStr(value)
+	case "simulate_gas":
+		p.SimulateGas, err = strconv.ParseBool(value)
+	case "gas_adjustment":
+		p.GasAdjustment, err = strconv.ParseFloat(value, 64)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// Fee returns the fee for the given gas limit.
+func (p TxParams) Fee(gasLimit uint64) sdk.Coins {
+	feeAmount := p.GasPrice.MulInt64(int64(gasLimit)).TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(p.Denom, feeAmount))
+}
+
+// Tx returns the transaction parameters, of the parameters that embed them.
+func (p TxParams) Tx() TxParams {
+	return p
+}
+
+// WithOverrides returns the parameters with the given client parameters
+// applied, and validated.
+func (p TxParams) WithOverrides(params hybridloadtest.ClientParams) (TxParams, error) {
+	for name, value := range params {
+		ok, err := p.Override(name, value)
+		if !ok {
+			return p, fmt.Errorf("unknown parameter %q", name)
+		}
+		if err != nil {
+			return p, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+	return p, p.Validate()
+}
@@ -0,0 +1,99 @@
+package cosmostx
+
+import (
+	"flag"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+func TestTxParamsWithOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  hybridloadtest.ClientParams
+		want    func(p *TxParams)
+		wantErr bool
+	}{
+		{"no overrides", nil, func(p *TxParams) {}, false},
+		{
+			"every parameter",
+			hybridloadtest.ClientParams{
+				"chain_id":       "other-chain",
+				"denom":          "stake",
+				"gas_limit":      "300000",
+				"gas_price":      "0.025",
+				"simulate_gas":   "true",
+				"gas_adjustment": "1.2",
+			},
+			func(p *TxParams) {
+				p.ChainID = "other-chain"
+				p.Denom = "stake"
+				p.GasLimit = 300000
+				p.GasPrice = sdk.NewDecWithPrec(25, 3)
+				p.SimulateGas = true
+				p.GasAdjustment = 1.2
+			},
+			false,
+		},
+		{"simulated gas needs no gas limit", hybridloadtest.ClientParams{"simulate_gas": "true", "gas_limit": "0"}, nil, false},
+		{"unknown parameter", hybridloadtest.ClientParams{"min_amount": "1"}, nil, true},
+		{"invalid number", hybridloadtest.ClientParams{"gas_limit": "lots"}, nil, true},
+		{"empty chain ID", hybridloadtest.ClientParams{"chain_id": ""}, nil, true},
+		{"invalid denom", hybridloadtest.ClientParams{"denom": "1"}, nil, true},
+		{"zero gas limit", hybridloadtest.ClientParams{"gas_limit": "0"}, nil, true},
+		{"negative gas price", hybridloadtest.ClientParams{"gas_price": "-1"}, nil, true},
+		{"gas adjustment below 1", hybridloadtest.ClientParams{"simulate_gas": "true", "gas_adjustment": "0.5"}, nil, true},
+	}
+	for _, tt := range tests {
+		got, err := testTxParams.WithOverrides(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: WithOverrides() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.want == nil {
+			continue
+		}
+		want := testTxParams
+		tt.want(&want)
+		if got.ChainID != want.ChainID || got.Denom != want.Denom || got.GasLimit != want.GasLimit ||
+			!got.GasPrice.Equal(want.GasPrice) || got.SimulateGas != want.SimulateGas || got.GasAdjustment != want.GasAdjustment {
+			t.Errorf("%s: WithOverrides() = %+v, want %+v", tt.name, got, want)
+		}
+	}
+}
+
+func TestTxParamsFee(t *testing.T) {
+	tests := []struct {
+		gasPrice sdk.Dec
+		gasLimit uint64
+		want     string
+	}{
+		{sdk.NewDecWithPrec(1, 3), 200000, "200uaiw"},
+		{sdk.NewDecWithPrec(25, 3), 100001, "2500uaiw"},
+		{sdk.ZeroDec(), 200000, ""},
+	}
+	for _, tt := range tests {
+		p := TxParams{Denom: "uaiw", GasPrice: tt.gasPrice}
+		if got := p.Fee(tt.gasLimit).String(); got != tt.want {
+			t.Errorf("Fee(%d) at %s = %q, want %q", tt.gasLimit, tt.gasPrice, got, tt.want)
+		}
+	}
+}
+
+func TestTxParamsRegisterFlags(t *testing.T) {
+	p := testTxParams
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	p.RegisterFlags(fs, "my-app", "my app")
+	if err := fs.Parse([]string{"--my-app-chain-id=other-chain", "--my-app-gas-price=0.5", "--my-app-simulate-gas"}); err != nil {
+		t.Fatal(err)
+	}
+	if p.ChainID != "other-chain" || !p.GasPrice.Equal(sdk.NewDecWithPrec(5, 1)) || !p.SimulateGas {
+		t.Errorf("parsed parameters = %+v", p)
+	}
+	// Flags that aren't set keep the current values
+	if p.Denom != "uaiw" || p.GasLimit != 200000 || p.GasAdjustment != 1.5 {
+		t.Errorf("parameters without flags changed: %+v", p)
+	}
+}
@@ -0,0 +1,46 @@
+package cosmostx
+
+import (
+	"bytes"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRawMsgPack(t *testing.T) {
+	msg := &RawMsg{
+		TypeURL: "/ibc.applications.transfer.v1.MsgTransfer",
+		Value:   []byte{0x0a, 0x08, 't', 'r', 'a', 'n', 's', 'f', 'e', 'r'},
+	}
+	packed, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if packed.TypeUrl != msg.TypeURL {
+		t.Errorf("type URL = %q, want %q", packed.TypeUrl, msg.TypeURL)
+	}
+	if !bytes.Equal(packed.Value, msg.Value) {
+		t.Errorf("value = %X, want %X", packed.Value, msg.Value)
+	}
+	if got := sdk.MsgTypeURL(msg); got != msg.TypeURL {
+		t.Errorf("MsgTypeURL() = %q, want %q", got, msg.TypeURL)
+	}
+}
+
+func TestRawMsgValidateBasic(t *testing.T) {
+	tests := []struct {
+		typeURL string
+		wantErr bool
+	}{
+		{"/cosmwasm.wasm.v1.MsgExecuteContract", false},
+		{"", true},
+		{"/", true},
+	}
+	for _, tt := range tests {
+		err := (&RawMsg{TypeURL: tt.typeURL}).ValidateBasic()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateBasic() of %q error = %v, wantErr %t", tt.typeURL, err, tt.wantErr)
+		}
+	}
+}
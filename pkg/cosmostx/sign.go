@@ -0,0 +1,74 @@
+package cosmostx
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SignTx builds a transaction with the given messages, signs it as the given
+// account and encodes it.
+func SignTx(txConfig client.TxConfig, chainID string, signer *Account, accountNumber, sequence uint64, msgs []sdk.Msg, gasLimit uint64, fee sdk.Coins, memo string) ([]byte, error) {
+	// Create transaction builder
+	txBuilder := txConfig.NewTxBuilder()
+
+	// Set messages
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, fmt.Errorf("failed to set messages: %w", err)
+	}
+
+	txBuilder.SetGasLimit(gasLimit)
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetMemo(memo)
+
+	// Create signature data
+	sigV2 := signing.SignatureV2{
+		PubKey: signer.pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: nil,
+		},
+		Sequence: sequence,
+	}
+
+	// Set the signature (empty for now)
+	if err := txBuilder.SetSignatures(sigV2); err != nil {
+		return nil, fmt.Errorf("failed to set signatures: %w", err)
+	}
+
+	// Create signing data
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}
+
+	// Sign the transaction
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sign bytes: %w", err)
+	}
+
+	signature, err := signer.sign(signBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	// Update signature with actual signature
+	sigV2.Data.(*signing.SingleSignatureData).Signature = signature
+	if err := txBuilder.SetSignatures(sigV2); err != nil {
+		return nil, fmt.Errorf("failed to set final signatures: %w", err)
+	}
+
+	// Encode transaction to bytes
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	return txBytes, nil
+}
@@ -0,0 +1,75 @@
+package cosmostx
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// newTestTxConfig returns a transaction configuration that decodes bank
+// messages.
+func newTestTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+func TestSignTx(t *testing.T) {
+	txConfig := newTestTxConfig()
+	pool := newTestPool(t, 2)
+	from, to := pool.accounts[0], pool.accounts[1]
+	msg := banktypes.NewMsgSend(from.Address(), to.Address(), sdk.NewCoins(sdk.NewInt64Coin("uaiw", 1000)))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uaiw", 200))
+
+	txBytes, err := SignTx(txConfig, "test-chain", from, 7, 3, []sdk.Msg{msg}, 200000, fee, "LoadTest:memo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := decoded.(authsigning.Tx)
+
+	if msgs := tx.GetMsgs(); len(msgs) != 1 || msgs[0].String() != msg.String() {
+		t.Errorf("messages = %v, want [%v]", msgs, msg)
+	}
+	if got := tx.GetGas(); got != 200000 {
+		t.Errorf("gas = %d, want 200000", got)
+	}
+	if got := tx.GetFee(); !got.IsEqual(fee) {
+		t.Errorf("fee = %s, want %s", got, fee)
+	}
+	if got := tx.GetMemo(); got != "LoadTest:memo" {
+		t.Errorf("memo = %q, want LoadTest:memo", got)
+	}
+
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sigs) != 1 || sigs[0].Sequence != 3 {
+		t.Fatalf("signatures = %v, want one with sequence 3", sigs)
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := sigs[0].Data.(*signing.SingleSignatureData).Signature
+	if !from.pubKey.VerifySignature(signBytes, sig) {
+		t.Errorf("the signature doesn't verify for chain test-chain, account 7, sequence 3")
+	}
+}
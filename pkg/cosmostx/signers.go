@@ -0,0 +1,281 @@
+package cosmostx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+const (
+	// The number of sender accounts funded by each multi-send transaction,
+	// which keeps its gas well below common block gas limits
+	fundingBatchSize = 50
+	// Gas limits of funding and sweep transactions
+	multiSendBaseGas      = 100000
+	multiSendGasPerOutput = 30000
+	sweepGas              = 200000
+	// The HD account sender accounts are derived at, so that they don't
+	// collide with the sub-accounts of the faucet mnemonic
+	senderHDAccount = 1
+)
+
+// Signers are the funded accounts a client factory's clients sign with. For a
+// load test with account funding, they are sender accounts funded from the
+// configured accounts instead.
+type Signers struct {
+	txConfig client.TxConfig
+	config   AccountConfig
+
+	// The configured accounts, loaded on first use, and the sender accounts
+	// funded from them for the running load test, if any
+	mtx    sync.Mutex
+	pool   *AccountPool
+	funded *AccountPool
+}
+
+// NewSigners creates the signers of the configured accounts.
+func NewSigners(txConfig client.TxConfig, config AccountConfig) *Signers {
+	return &Signers{txConfig: txConfig, config: config}
+}
+
+// Pool returns the configured accounts, which are loaded once.
+func (s *Signers) Pool() (*AccountPool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.pool == nil {
+		pool, err := NewAccountPool(s.config)
+		if err != nil {
+			return nil, err
+		}
+		s.pool = pool
+	}
+	return s.pool, nil
+}
+
+// ClientPool returns the accounts new clients sign with: the funded sender
+// accounts during a funded load test, the configured accounts otherwise.
+func (s *Signers) ClientPool() (*AccountPool, error) {
+	s.mtx.Lock()
+	funded := s.funded
+	s.mtx.Unlock()
+	if funded != nil {
+		return funded, nil
+	}
+	return s.Pool()
+}
+
+// Fund multi-sends the funding amount from the faucet account, the first
+// configured account, to one sender account per connection. Sender accounts
+// are derived from the faucet mnemonic at m/44'/118'/1'/0/{i}, or from a new
+// mnemonic when signing with a keyring. Until the teardown, ClientPool returns
+// the sender accounts, so only one funded load test can run at a time. If
+// funding fails, whatever reached the sender accounts is swept back.
+func (s *Signers) Fund(cfg loadtest.Config, p TxParams, funding hybridloadtest.FundingConfig) (hybridloadtest.Teardown, error) {
+	amount, err := sdk.ParseCoinsNormalized(funding.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid funding amount %q: %w", funding.Amount, err)
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("funding amount must be positive")
+	}
+
+	faucetPool, err := s.Pool()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	if s.funded != nil {
+		s.mtx.Unlock()
+		return nil, fmt.Errorf("another load test is using funded accounts")
+	}
+	// Reserve the funded pool while funding
+	senders := NewEmptyPool(s.config)
+	s.funded = senders
+	s.mtx.Unlock()
+	release := func() {
+		s.mtx.Lock()
+		s.funded = nil
+		s.mtx.Unlock()
+	}
+
+	mnemonic := faucetPool.mnemonic
+	if mnemonic == "" {
+		if mnemonic, err = newMnemonic(); err != nil {
+			release()
+			return nil, err
+		}
+		if !funding.Sweep {
+			logrus.Warnf("Sender accounts are derived from a new mnemonic; their balances can't be recovered without sweeping")
+		}
+	}
+	n := len(cfg.Endpoints) * cfg.Connections
+	if err := senders.Derive(mnemonic, senderHDAccount, n); err != nil {
+		release()
+		return nil, err
+	}
+
+	faucet := faucetPool.accounts[0]
+	if err := faucetPool.Refresh(cfg.Endpoints, faucet); err != nil {
+		release()
+		return nil, err
+	}
+	logrus.Infof("Funding %d sender accounts with %s each from %s", n, amount, faucet.bech32)
+
+	var txs [][]byte
+	for start := 0; start < n; start += fundingBatchSize {
+		end := start + fundingBatchSize
+		if end > n {
+			end = n
+		}
+		msg := &banktypes.MsgMultiSend{
+			Inputs: []banktypes.Input{banktypes.NewInput(faucet.address, amount.MulInt(sdk.NewInt(int64(end-start))))},
+		}
+		for _, sender := range senders.accounts[start:end] {
+			msg.Outputs = append(msg.Outputs, banktypes.NewOutput(sender.address, amount))
+		}
+
+		gasLimit := uint64(multiSendBaseGas + multiSendGasPerOutput*(end-start))
+		accountNumber, sequence := faucet.NextSequence()
+		tx, err := SignTx(s.txConfig, p.ChainID, faucet, accountNumber, sequence, []sdk.Msg{msg}, gasLimit, p.Fee(gasLimit), "LoadTest:funding")
+		if err != nil {
+			release()
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	if err := BroadcastAndWait(cfg.Endpoints, txs, funding.Timeout); err != nil {
+		// Earlier batches may have been included, so their funds are sent
+		// back as well as possible.
+		if sweepErr := s.sweep(cfg.Endpoints, p, senders, faucet, amount, funding.Timeout); sweepErr != nil {
+			logrus.Errorf("Failed to sweep partly funded sender accounts: %v", sweepErr)
+			if faucetPool.mnemonic == "" {
+				logrus.Errorf("Sender accounts are derived at m/44'/118'/%d'/0/{0..%d} from the mnemonic %q", senderHDAccount, n-1, mnemonic)
+			}
+		}
+		release()
+		return nil, fmt.Errorf("failed to fund sender accounts: %w", err)
+	}
+
+	return func() error {
+		defer release()
+		if !funding.Sweep {
+			return nil
+		}
+		return s.sweep(cfg.Endpoints, p, senders, faucet, amount, funding.Timeout)
+	}, nil
+}
+
+// sweep sends the balances of the sender accounts in the funded denoms back
+// to the faucet account, less the fees. Accounts without a balance, which
+// may not exist on chain, are skipped.
+func (s *Signers) sweep(endpoints []string, p TxParams, senders *AccountPool, faucet *Account, funded sdk.Coins, timeout time.Duration) error {
+	sweepFee := p.Fee(sweepGas)
+
+	var txs [][]byte
+	for _, sender := range senders.accounts {
+		var balances sdk.Coins
+		for _, coin := range funded {
+			balance, err := senders.Balance(endpoints, sender, coin.Denom)
+			if err != nil {
+				return err
+			}
+			balance = balance.Sub(sweepFee.AmountOf(coin.Denom))
+			if balance.IsPositive() {
+				balances = balances.Add(sdk.NewCoin(coin.Denom, balance))
+			}
+		}
+		if balances.IsZero() {
+			continue
+		}
+		accountNumber, sequence, err := senders.Query(endpoints, sender)
+		if err != nil {
+			return err
+		}
+
+		msg := banktypes.NewMsgSend(sender.address, faucet.address, balances)
+		tx, err := SignTx(s.txConfig, p.ChainID, sender, accountNumber, sequence, []sdk.Msg{msg}, sweepGas, sweepFee, "LoadTest:sweep")
+		if err != nil {
+			return err
+		}
+		txs = append(txs, tx)
+	}
+
+	logrus.Infof("Sweeping the balances of %d sender accounts back to %s", len(txs), faucet.bech32)
+	if err := BroadcastAndWait(endpoints, txs, timeout); err != nil {
+		return fmt.Errorf("failed to sweep sender accounts: %w", err)
+	}
+	return nil
+}
+
+// BroadcastAndWait broadcasts the transactions in order through the first
+// endpoint and waits until all of them are included in a block.
+func BroadcastAndWait(endpoints []string, txs [][]byte, timeout time.Duration) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no endpoint to broadcast through")
+	}
+	client, err := httprpc.NewHTTPRPCClient(rpcURL(endpoints[0]))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	pending := make(map[string]bool)
+	for _, tx := range txs {
+		res, err := client.BroadcastTx("broadcast_tx_sync", tx)
+		if err != nil {
+			return err
+		}
+		if code, codespace := res.RejectionCode(); code != 0 {
+			return fmt.Errorf("transaction rejected with code %d (%s): %s", code, codespace, res.RejectionLog())
+		}
+		pending[strings.ToUpper(res.Hash)] = true
+	}
+
+	deadline := time.Now().Add(timeout)
+	for len(pending) > 0 {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%d transactions were not included within %s", len(pending), timeout)
+		}
+		time.Sleep(hybridloadtest.DefaultPollInterval)
+		for hash := range pending {
+			res, err := client.Tx(hash)
+			if err != nil {
+				// Not found until it is included
+				continue
+			}
+			if res.TxResult.Code != 0 {
+				return fmt.Errorf("transaction %s failed at height %s with code %d: %s", hash, res.Height, res.TxResult.Code, res.TxResult.Log)
+			}
+			delete(pending, hash)
+		}
+	}
+	return nil
+}
+
+// newMnemonic generates a random mnemonic
+func newMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate entropy: %w", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate mnemonic: %w", err)
+	}
+	return mnemonic, nil
+}
@@ -0,0 +1,262 @@
+package cosmostx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+// fakeChain is a JSON-RPC server that answers account and balance queries
+// and simulations, accepts broadcasts with the given code and reports every transaction as
+// included.
+type fakeChain struct {
+	*httptest.Server
+	balance int64
+	code    int
+	// If set, only the broadcast with this number, counting from 1, gets code
+	rejectAt int
+
+	mtx sync.Mutex
+	txs [][]byte
+}
+
+func newFakeChain(t *testing.T, balance int64, code int) *fakeChain {
+	t.Helper()
+	c := &fakeChain{balance: balance, code: code}
+	c.Server = httptest.NewServer(http.HandlerFunc(c.serve))
+	t.Cleanup(c.Close)
+	return c
+}
+
+func (c *fakeChain) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int64  `json:"id"`
+		Method string `json:"method"`
+		Params struct {
+			Path string `json:"path"`
+			Tx   []byte `json:"tx"`
+		} `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result any
+	switch req.Method {
+	case "abci_query":
+		var res interface{ Marshal() ([]byte, error) }
+		switch req.Params.Path {
+		case "/cosmos.auth.v1beta1.Query/Account":
+			account, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{AccountNumber: 1})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			res = &authtypes.QueryAccountResponse{Account: account}
+		case "/cosmos.bank.v1beta1.Query/Balance":
+			balance := sdk.NewInt64Coin("uaiw", c.balance)
+			res = &banktypes.QueryBalanceResponse{Balance: &balance}
+		case "/cosmos.tx.v1beta1.Service/Simulate":
+			res = &txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: 80000}}
+		default:
+			http.Error(w, "unexpected query "+req.Params.Path, http.StatusBadRequest)
+			return
+		}
+		value, err := res.Marshal()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		result = map[string]any{"response": map[string]any{"code": 0, "value": value}}
+	case "broadcast_tx_sync":
+		c.mtx.Lock()
+		c.txs = append(c.txs, req.Params.Tx)
+		hash := fmt.Sprintf("%04X", len(c.txs))
+		code := c.code
+		if c.rejectAt > 0 && len(c.txs) != c.rejectAt {
+			code = 0
+		}
+		c.mtx.Unlock()
+		result = map[string]any{"code": code, "log": "rejected", "hash": hash}
+	case "tx":
+		result = map[string]any{"height": "5", "tx_result": map[string]any{"code": 0}}
+	default:
+		http.Error(w, "unexpected method "+req.Method, http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// msgs decodes the messages of the broadcast transactions.
+func (c *fakeChain) msgs(t *testing.T) []sdk.Msg {
+	t.Helper()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var msgs []sdk.Msg
+	for _, txBytes := range c.txs {
+		tx, err := newTestTxConfig().TxDecoder()(txBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, tx.GetMsgs()...)
+	}
+	return msgs
+}
+
+var testTxParams = TxParams{
+	ChainID:       "test-chain",
+	Denom:         "uaiw",
+	GasLimit:      200000,
+	GasPrice:      sdk.NewDecWithPrec(1, 3),
+	GasAdjustment: 1.5,
+}
+
+func TestSignersFund(t *testing.T) {
+	tests := []struct {
+		name       string
+		sweep      bool
+		wantSweeps int
+	}{
+		{"without sweep", false, 0},
+		{"with sweep", true, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t, 5000, 0)
+			s := NewSigners(newTestTxConfig(), AccountConfig{Mnemonic: testMnemonic})
+			cfg := loadtest.Config{Endpoints: []string{chain.URL}, Connections: 3}
+			funding := hybridloadtest.FundingConfig{Amount: "1000uaiw", Sweep: tt.sweep, Timeout: 5 * time.Second}
+
+			teardown, err := s.Fund(cfg, testTxParams, funding)
+			if err != nil {
+				t.Fatal(err)
+			}
+			faucet, err := s.Pool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			senders, err := s.ClientPool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if senders == faucet || len(senders.accounts) != 3 {
+				t.Fatalf("ClientPool() during the load test has %d accounts, want the 3 sender accounts", len(senders.accounts))
+			}
+			if _, err := s.Fund(cfg, testTxParams, funding); err == nil {
+				t.Errorf("Fund() succeeded while another load test uses funded accounts")
+			}
+
+			msgs := chain.msgs(t)
+			if len(msgs) != 1 {
+				t.Fatalf("%d messages broadcast, want one multi-send", len(msgs))
+			}
+			multiSend, ok := msgs[0].(*banktypes.MsgMultiSend)
+			if !ok || len(multiSend.Outputs) != 3 {
+				t.Fatalf("funding message = %v, want a multi-send to 3 accounts", msgs[0])
+			}
+			if got := multiSend.Inputs[0].Coins.String(); got != "3000uaiw" {
+				t.Errorf("funding input = %s, want 3000uaiw", got)
+			}
+			for i, output := range multiSend.Outputs {
+				if output.Address != senders.accounts[i].String() || output.Coins.String() != "1000uaiw" {
+					t.Errorf("funding output %d = %v, want 1000uaiw to %s", i, output, senders.accounts[i])
+				}
+			}
+
+			if err := teardown(); err != nil {
+				t.Fatalf("teardown: %v", err)
+			}
+			sweeps := chain.msgs(t)[1:]
+			if len(sweeps) != tt.wantSweeps {
+				t.Fatalf("%d sweeps, want %d", len(sweeps), tt.wantSweeps)
+			}
+			for _, msg := range sweeps {
+				send := msg.(*banktypes.MsgSend)
+				// The balance less the fee of the sweep gas
+				if send.ToAddress != faucet.accounts[0].String() || send.Amount.String() != "4800uaiw" {
+					t.Errorf("sweep = %v, want 4800uaiw to %s", send, faucet.accounts[0])
+				}
+			}
+			if pool, _ := s.ClientPool(); pool != faucet {
+				t.Errorf("ClientPool() after the teardown isn't the configured accounts")
+			}
+		})
+	}
+}
+
+func TestSignersFundErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		code   int
+	}{
+		{"invalid amount", "lots", 0},
+		{"zero amount", "0uaiw", 0},
+		{"funding rejected", "1000uaiw", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t, 0, tt.code)
+			s := NewSigners(newTestTxConfig(), AccountConfig{Mnemonic: testMnemonic})
+			cfg := loadtest.Config{Endpoints: []string{chain.URL}, Connections: 2}
+			funding := hybridloadtest.FundingConfig{Amount: tt.amount, Timeout: 5 * time.Second}
+
+			if _, err := s.Fund(cfg, testTxParams, funding); err == nil {
+				t.Fatalf("Fund() succeeded, want an error")
+			}
+			faucet, err := s.Pool()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pool, _ := s.ClientPool(); pool != faucet {
+				t.Errorf("ClientPool() after a failed funding isn't the configured accounts")
+			}
+		})
+	}
+}
+
+func TestSignersFundSweepsAfterFailure(t *testing.T) {
+	// The second of two funding batches is rejected
+	chain := newFakeChain(t, 5000, 5)
+	chain.rejectAt = 2
+	s := NewSigners(newTestTxConfig(), AccountConfig{Mnemonic: testMnemonic})
+	cfg := loadtest.Config{Endpoints: []string{chain.URL}, Connections: fundingBatchSize + 10}
+	funding := hybridloadtest.FundingConfig{Amount: "1000uaiw", Timeout: 5 * time.Second}
+
+	if _, err := s.Fund(cfg, testTxParams, funding); err == nil {
+		t.Fatalf("Fund() succeeded, want an error")
+	}
+	faucet, err := s.Pool()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sweeping doesn't need to be enabled. The fake chain reports a balance
+	// for every sender account.
+	sweeps := chain.msgs(t)[2:]
+	if len(sweeps) != cfg.Connections {
+		t.Fatalf("%d sweeps after a failed funding, want %d", len(sweeps), cfg.Connections)
+	}
+	for _, msg := range sweeps {
+		if send, ok := msg.(*banktypes.MsgSend); !ok || send.ToAddress != faucet.accounts[0].String() {
+			t.Errorf("sweep = %v, want a send to %s", msg, faucet.accounts[0])
+		}
+	}
+	if pool, _ := s.ClientPool(); pool != faucet {
+		t.Errorf("ClientPool() after a failed funding isn't the configured accounts")
+	}
+}
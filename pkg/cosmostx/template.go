@@ -0,0 +1,49 @@
+package cosmostx
+
+import (
+	"crypto/rand"
+	mathrand "math/rand"
+	"text/template"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// TemplateVars are the variables that client factories render message
+// templates with, e.g.
+//
+//	{"transfer": {"recipient": "{{.Recipient}}", "amount": "{{randInt 1 100}}"}}
+type TemplateVars struct {
+	// The address of the account signing the transaction
+	Sender string
+	// The address of the account after the sender in the pool
+	Recipient string
+	// The sequence of the transaction
+	Sequence uint64
+	// The number of transactions the client generated before
+	Counter uint64
+}
+
+// RandomAddress returns a random address, with the bech32 prefix of the
+// sender.
+func (v TemplateVars) RandomAddress() string {
+	prefix := sdk.Bech32MainPrefix
+	if hrp, _, err := bech32.DecodeAndConvert(v.Sender); err == nil {
+		prefix = hrp
+	}
+	address := make([]byte, 20)
+	rand.Read(address)
+	return sdk.MustBech32ifyAddressBytes(prefix, address)
+}
+
+// TemplateFuncs are the functions message templates can call:
+//
+//   - randInt min max returns a random integer in [min, max]
+var TemplateFuncs = template.FuncMap{
+	"randInt": func(min, max int64) int64 {
+		if max <= min {
+			return min
+		}
+		return min + mathrand.Int63n(max-min+1)
+	},
+}
@@ -0,0 +1,37 @@
+package cosmostx
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestTemplateVarsRandomAddress(t *testing.T) {
+	osmoSender, err := bech32.ConvertAndEncode("osmo", make([]byte, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		sender     string
+		wantPrefix string
+	}{
+		{"", "cosmos"},
+		{"not an address", "cosmos"},
+		{newTestPool(t, 1).accounts[0].String(), "cosmos"},
+		{osmoSender, "osmo"},
+	}
+	for _, tt := range tests {
+		vars := TemplateVars{Sender: tt.sender}
+		address := vars.RandomAddress()
+		prefix, bz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			t.Fatalf("RandomAddress() = %q: %v", address, err)
+		}
+		if prefix != tt.wantPrefix || len(bz) != 20 {
+			t.Errorf("RandomAddress() with sender %q = %q, want a 20-byte %s address", tt.sender, address, tt.wantPrefix)
+		}
+		if address == vars.RandomAddress() {
+			t.Errorf("RandomAddress() returned %q twice", address)
+		}
+	}
+}
@@ -2,17 +2,23 @@ package httprpc
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
+// ErrTimeout is returned when no response to a request arrives in time.
+var ErrTimeout = errors.New("timed out waiting for response")
+
 // HTTPRPCClient provides HTTP RPC functionality as an alternative to WebSocket
 type HTTPRPCClient struct {
 	baseURL    string
@@ -67,6 +73,103 @@ func NewHTTPRPCClient(endpoint string) (*HTTPRPCClient, error) {
 
 // BroadcastTx sends a transaction via HTTP RPC
 func (c *HTTPRPCClient) BroadcastTx(method string, txBytes []byte) (*BroadcastTxResponse, error) {
+	rpcResponse, err := c.call(method, map[string]interface{}{
+		"tx": txBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the result based on the broadcast method
+	var result BroadcastTxResponse
+	if err := rpcResponse.DecodeResult(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Tx looks up a transaction by its hex-encoded hash. The node answers with an
+// RPC error until the transaction has been included in a block.
+func (c *HTTPRPCClient) Tx(hash string) (*TxResponse, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash %q: %w", hash, err)
+	}
+
+	var result TxResponse
+	if err := c.callResult("tx", map[string]interface{}{"hash": hashBytes}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Block returns the block at the given height, or the latest block if height
+// is 0.
+func (c *HTTPRPCClient) Block(height int64) (*BlockResponse, error) {
+	var result BlockResponse
+	if err := c.callResult("block", heightParams(height), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BlockResults returns the results of the transactions in the block at the
+// given height.
+func (c *HTTPRPCClient) BlockResults(height int64) (*BlockResultsResponse, error) {
+	var result BlockResultsResponse
+	if err := c.callResult("block_results", heightParams(height), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// NumUnconfirmedTxs returns the number of transactions in the node's mempool.
+func (c *HTTPRPCClient) NumUnconfirmedTxs() (*NumUnconfirmedTxsResponse, error) {
+	var result NumUnconfirmedTxsResponse
+	if err := c.callResult("num_unconfirmed_txs", map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ABCIQuery queries the application at the given path, e.g. a gRPC query
+// method such as /cosmos.auth.v1beta1.Query/Account with its protobuf-encoded
+// request as data.
+func (c *HTTPRPCClient) ABCIQuery(path string, data []byte) (*ABCIQueryResponse, error) {
+	params := map[string]interface{}{
+		"path":  path,
+		"data":  hex.EncodeToString(data),
+		"prove": false,
+	}
+	var result ABCIQueryResponse
+	if err := c.callResult("abci_query", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// heightParams encodes the height parameter the way Tendermint expects
+// 64-bit integers, leaving it out for the latest height.
+func heightParams(height int64) map[string]interface{} {
+	params := map[string]interface{}{}
+	if height > 0 {
+		params["height"] = strconv.FormatInt(height, 10)
+	}
+	return params
+}
+
+// callResult sends a JSON-RPC request and decodes its result into v.
+func (c *HTTPRPCClient) callResult(method string, params interface{}, v interface{}) error {
+	rpcResponse, err := c.call(method, params)
+	if err != nil {
+		return err
+	}
+	return rpcResponse.DecodeResult(v)
+}
+
+// call sends a JSON-RPC request and returns the response.
+func (c *HTTPRPCClient) call(method string, params interface{}) (*JSONRPCResponse, error) {
 	c.mutex.Lock()
 	reqID := c.requestID
 	c.requestID++
@@ -77,9 +180,7 @@ func (c *HTTPRPCClient) BroadcastTx(method string, txBytes []byte) (*BroadcastTx
 		JSONRPC: "2.0",
 		ID:      reqID,
 		Method:  method,
-		Params: map[string]interface{}{
-			"tx": txBytes,
-		},
+		Params:  params,
 	}
 
 	requestBody, err := json.Marshal(request)
@@ -96,7 +197,7 @@ func (c *HTTPRPCClient) BroadcastTx(method string, txBytes []byte) (*BroadcastTx
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP error: %s (status %d)", resp.Status, resp.StatusCode)
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
 	// Parse response
@@ -109,23 +210,7 @@ func (c *HTTPRPCClient) BroadcastTx(method string, txBytes []byte) (*BroadcastTx
 	if err := json.Unmarshal(responseBody, &rpcResponse); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
-
-	if rpcResponse.Error != nil {
-		return nil, fmt.Errorf("RPC error: %s (code %d)", rpcResponse.Error.Message, rpcResponse.Error.Code)
-	}
-
-	// Parse the result based on the broadcast method
-	var result BroadcastTxResponse
-	resultBytes, err := json.Marshal(rpcResponse.Result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal result: %w", err)
-	}
-
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal broadcast result: %w", err)
-	}
-
-	return &result, nil
+	return &rpcResponse, nil
 }
 
 // Close cleans up the HTTP client
@@ -150,6 +235,24 @@ type JSONRPCResponse struct {
 	Error   *JSONRPCError          `json:"error,omitempty"`
 }
 
+// DecodeResult decodes the result of the response into v, returning the RPC
+// error instead if the call failed.
+func (r *JSONRPCResponse) DecodeResult(v interface{}) error {
+	if r.Error != nil {
+		return r.Error
+	}
+
+	resultBytes, err := json.Marshal(r.Result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	if err := json.Unmarshal(resultBytes, v); err != nil {
+		return fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+	return nil
+}
+
 // JSONRPCError represents a JSON-RPC 2.0 error
 type JSONRPCError struct {
 	Code    int    `json:"code"`
@@ -157,13 +260,126 @@ type JSONRPCError struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
-// BroadcastTxResponse represents the response from a broadcast_tx call
+func (e *JSONRPCError) Error() string {
+	return fmt.Sprintf("RPC error: %s (code %d)", e.Message, e.Code)
+}
+
+// HTTPError is returned when the endpoint answers with an HTTP error status.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP error: %s (status %d)", e.Status, e.StatusCode)
+}
+
+// BroadcastTxResponse represents the response from a broadcast_tx call.
+// broadcast_tx_commit reports the results of CheckTx and DeliverTx instead of
+// the top-level code, along with the height of the block the transaction was
+// included in.
 type BroadcastTxResponse struct {
+	Code      int       `json:"code"`
+	Data      string    `json:"data"`
+	Log       string    `json:"log"`
+	Hash      string    `json:"hash"`
+	Codespace string    `json:"codespace,omitempty"`
+	CheckTx   *TxResult `json:"check_tx,omitempty"`
+	DeliverTx *TxResult `json:"deliver_tx,omitempty"`
+	Height    string    `json:"height,omitempty"`
+}
+
+// TxResult is the result of CheckTx or DeliverTx in a broadcast_tx_commit
+// response, or of a transaction in a block. Tendermint encodes 64-bit
+// integers such as the gas as strings.
+type TxResult struct {
 	Code      int    `json:"code"`
 	Data      string `json:"data"`
 	Log       string `json:"log"`
-	Hash      string `json:"hash"`
 	Codespace string `json:"codespace,omitempty"`
+	GasWanted string `json:"gas_wanted,omitempty"`
+	GasUsed   string `json:"gas_used,omitempty"`
+}
+
+// TxResponse represents the response from a tx call
+type TxResponse struct {
+	Hash     string   `json:"hash"`
+	Height   string   `json:"height"`
+	Index    int      `json:"index"`
+	TxResult TxResult `json:"tx_result"`
+}
+
+// BlockResponse represents the response from a block call
+type BlockResponse struct {
+	Block Block `json:"block"`
+}
+
+// Block holds the parts of a block the load tester looks at
+type Block struct {
+	Header BlockHeader `json:"header"`
+	Data   BlockData   `json:"data"`
+}
+
+// BlockHeader represents the header of a block
+type BlockHeader struct {
+	ChainID string    `json:"chain_id"`
+	Height  string    `json:"height"`
+	Time    time.Time `json:"time"`
+}
+
+// BlockData holds the raw transactions of a block
+type BlockData struct {
+	Txs [][]byte `json:"txs"`
+}
+
+// BlockResultsResponse represents the response from a block_results call
+type BlockResultsResponse struct {
+	Height     string     `json:"height"`
+	TxsResults []TxResult `json:"txs_results"`
+}
+
+// NumUnconfirmedTxsResponse represents the response from a num_unconfirmed_txs call
+type NumUnconfirmedTxsResponse struct {
+	NTxs       string `json:"n_txs"`
+	Total      string `json:"total"`
+	TotalBytes string `json:"total_bytes"`
+}
+
+// ABCIQueryResponse represents the response from an abci_query call
+type ABCIQueryResponse struct {
+	Response ABCIQueryResult `json:"response"`
+}
+
+// ABCIQueryResult is the application's answer to a query
+type ABCIQueryResult struct {
+	Code      int    `json:"code"`
+	Log       string `json:"log"`
+	Codespace string `json:"codespace,omitempty"`
+	Value     []byte `json:"value"`
+	Height    string `json:"height"`
+}
+
+// RejectionCode returns the code and codespace the node rejected the
+// transaction with, or a zero code if it was accepted.
+func (r *BroadcastTxResponse) RejectionCode() (int, string) {
+	if r.CheckTx == nil {
+		return r.Code, r.Codespace
+	}
+	if r.CheckTx.Code != 0 || r.DeliverTx == nil {
+		return r.CheckTx.Code, r.CheckTx.Codespace
+	}
+	return r.DeliverTx.Code, r.DeliverTx.Codespace
+}
+
+// RejectionLog returns the log the node rejected the transaction with.
+func (r *BroadcastTxResponse) RejectionLog() string {
+	if r.CheckTx == nil {
+		return r.Log
+	}
+	if r.CheckTx.Code != 0 || r.DeliverTx == nil {
+		return r.CheckTx.Log
+	}
+	return r.DeliverTx.Log
 }
 
 // HealthCheck verifies the HTTP RPC endpoint is accessible
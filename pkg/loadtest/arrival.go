@@ -0,0 +1,233 @@
+package loadtest
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+const (
+	// DefaultArrivalJitter is the fraction of the mean gap by which gaps vary
+	// in ArrivalUniform mode.
+	DefaultArrivalJitter = 0.5
+	// DefaultMaxInFlight is how many broadcasts each connection may have in
+	// flight in open-loop modes, unless its client is sequenced.
+	DefaultMaxInFlight = 256
+)
+
+// ArrivalModel selects when transactors send transactions.
+type ArrivalModel string
+
+const (
+	// ArrivalBatch sends a batch of up to Rate transactions at the start of
+	// every send period, each once the previous one was answered. A slow
+	// node thus slows the load down, and its latencies are under-reported.
+	ArrivalBatch ArrivalModel = ""
+	// ArrivalConstant sends Rate transactions per second, evenly spaced.
+	ArrivalConstant ArrivalModel = "constant"
+	// ArrivalPoisson sends Rate transactions per second on average, with
+	// exponentially distributed gaps, as independent users would.
+	ArrivalPoisson ArrivalModel = "poisson"
+	// ArrivalUniform sends Rate transactions per second on average, with gaps
+	// uniformly distributed within Jitter of the mean gap.
+	ArrivalUniform ArrivalModel = "uniform"
+	// ArrivalReplay sends transactions with recorded gaps, cycling through
+	// them. Rate does not apply.
+	ArrivalReplay ArrivalModel = "replay"
+)
+
+// ParseArrivalModel parses batch, constant, poisson, uniform or replay.
+func ParseArrivalModel(s string) (ArrivalModel, error) {
+	switch s {
+	case "", "batch":
+		return ArrivalBatch, nil
+	case string(ArrivalConstant), string(ArrivalPoisson), string(ArrivalUniform), string(ArrivalReplay):
+		return ArrivalModel(s), nil
+	}
+	return ArrivalBatch, fmt.Errorf("unsupported arrival model: %s (supported: batch, constant, poisson, uniform, replay)", s)
+}
+
+// ArrivalConfig configures when transactors send transactions. In every
+// model but ArrivalBatch, the load is open-loop: each connection sends on a
+// schedule of its own, whether or not earlier transactions were answered, and
+// latencies are measured from when transactions were due rather than from
+// when they could be sent.
+type ArrivalConfig struct {
+	Model ArrivalModel
+	// The fraction of the mean gap, from 0 to 1, by which gaps vary in
+	// ArrivalUniform mode. Defaults to DefaultArrivalJitter if nil.
+	Jitter *float64
+	// The recorded gaps of ArrivalReplay mode.
+	Intervals []time.Duration
+	// How many broadcasts each connection may have in flight. Transactions
+	// due beyond it wait for one to be answered, and the wait counts towards
+	// their latency. Defaults to DefaultMaxInFlight, or to 1 for sequenced
+	// clients, whose transactions must reach the node in the order they were
+	// signed. Concurrent broadcasts can overtake each other, so with more in
+	// flight a sequenced client's transactions get rejected for having the
+	// wrong sequence, and resyncing the sequence after a rejection makes the
+	// transactions already signed wrong too. Connections whose clients share
+	// accounts can still reorder each other's transactions.
+	MaxInFlight int
+}
+
+// OpenLoop reports whether transactions are sent on a schedule.
+func (c ArrivalConfig) OpenLoop() bool {
+	return c.Model != ArrivalBatch
+}
+
+// Validate checks the configuration.
+func (c ArrivalConfig) Validate() error {
+	if _, err := ParseArrivalModel(string(c.Model)); err != nil {
+		return err
+	}
+	if c.Jitter != nil && (*c.Jitter < 0 || *c.Jitter > 1) {
+		return fmt.Errorf("arrival jitter must be between 0 and 1")
+	}
+	if c.MaxInFlight < 0 {
+		return fmt.Errorf("max in flight must be >= 0")
+	}
+	if c.Model == ArrivalReplay {
+		if len(c.Intervals) == 0 {
+			return fmt.Errorf("the replay arrival model needs recorded intervals")
+		}
+		var total time.Duration
+		for _, interval := range c.Intervals {
+			if interval < 0 {
+				return fmt.Errorf("recorded intervals must be >= 0")
+			}
+			total += interval
+		}
+		if total == 0 {
+			return fmt.Errorf("recorded intervals must not all be 0")
+		}
+	} else if len(c.Intervals) > 0 {
+		return fmt.Errorf("recorded intervals only apply to the replay arrival model")
+	}
+	return nil
+}
+
+// SequencedClient is implemented by clients whose transactions carry the
+// sequences of the accounts that signed them, which nodes only accept in
+// order.
+type SequencedClient interface {
+	// Sequenced reports whether the client's transactions must reach the
+	// node in the order they were generated.
+	Sequenced() bool
+}
+
+// maxInFlight returns how many broadcasts the client's connection may have in
+// flight: the configured number, or else DefaultMaxInFlight unless the client
+// is sequenced, one otherwise.
+func (c ArrivalConfig) maxInFlight(client loadtest.Client) int {
+	if c.MaxInFlight > 0 {
+		return c.MaxInFlight
+	}
+	if sequenced, ok := client.(SequencedClient); ok && sequenced.Sequenced() {
+		return 1
+	}
+	return DefaultMaxInFlight
+}
+
+// PacedClient is implemented by clients whose transactions are due at times of
+// their own, such as replayed ones that keep their original pacing.
+// Transactors wait until a transaction is due before broadcasting it, and the
+// wait doesn't count towards its latency. Transactions are never sent before
+// they are due on the arrival schedule though.
+type PacedClient interface {
+	// GenerateTxDue returns the next transaction and the time it is due, or
+	// the zero time if it is due right away.
+	GenerateTxDue() ([]byte, time.Time, error)
+}
+
+// generateTx returns the client's next transaction and the time it is due,
+// the zero time unless the client is paced.
+func generateTx(client loadtest.Client) ([]byte, time.Time, error) {
+	if paced, ok := client.(PacedClient); ok {
+		return paced.GenerateTxDue()
+	}
+	tx, err := client.GenerateTx()
+	return tx, time.Time{}, err
+}
+
+// ReadIntervals reads recorded inter-arrival times, one per line, either as
+// Go durations like 15ms or as numbers of seconds. Blank lines and lines
+// starting with # are skipped.
+func ReadIntervals(path string) ([]time.Duration, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var intervals []time.Duration
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		interval, err := time.ParseDuration(line)
+		if err != nil {
+			seconds, serr := strconv.ParseFloat(line, 64)
+			if serr != nil {
+				return nil, fmt.Errorf("line %d: invalid interval %q, expected a duration or a number of seconds", n, line)
+			}
+			interval = time.Duration(seconds * float64(time.Second))
+		}
+		intervals = append(intervals, interval)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return intervals, nil
+}
+
+// How long open-loop connections wait before checking the rate again while
+// it is 0
+const idleStep = 10 * time.Millisecond
+
+// arrivalSchedule yields the gaps between the transactions of a connection.
+type arrivalSchedule struct {
+	config ArrivalConfig
+	jitter float64
+	rng    *rand.Rand
+	next   int
+}
+
+func newArrivalSchedule(config ArrivalConfig, seed int64) *arrivalSchedule {
+	jitter := DefaultArrivalJitter
+	if config.Jitter != nil {
+		jitter = *config.Jitter
+	}
+	return &arrivalSchedule{
+		config: config,
+		jitter: jitter,
+		rng:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+// gap returns the time between the previous transaction and the next one,
+// at the given rate in transactions per second.
+func (s *arrivalSchedule) gap(rate float64) time.Duration {
+	mean := float64(time.Second) / rate
+	switch s.config.Model {
+	case ArrivalPoisson:
+		return time.Duration(s.rng.ExpFloat64() * mean)
+	case ArrivalUniform:
+		jitter := (2*s.rng.Float64() - 1) * s.jitter
+		return time.Duration((1 + jitter) * mean)
+	case ArrivalReplay:
+		interval := s.config.Intervals[s.next%len(s.config.Intervals)]
+		s.next++
+		return interval
+	default:
+		return time.Duration(mean)
+	}
+}
@@ -0,0 +1,250 @@
+package loadtest
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestArrivalScheduleGap(t *testing.T) {
+	const samples = 10000
+	tests := []struct {
+		name   string
+		config ArrivalConfig
+		rate   float64
+		// The bounds every gap must be within, and the mean the gaps must
+		// average to within 5%
+		min, max time.Duration
+		mean     time.Duration
+	}{
+		{
+			name:   "constant",
+			config: ArrivalConfig{Model: ArrivalConstant},
+			rate:   100,
+			min:    10 * time.Millisecond,
+			max:    10 * time.Millisecond,
+			mean:   10 * time.Millisecond,
+		},
+		{
+			name:   "poisson",
+			config: ArrivalConfig{Model: ArrivalPoisson},
+			rate:   50,
+			min:    0,
+			max:    time.Duration(math.MaxInt64),
+			mean:   20 * time.Millisecond,
+		},
+		{
+			name:   "uniform with the default jitter",
+			config: ArrivalConfig{Model: ArrivalUniform},
+			rate:   10,
+			min:    50 * time.Millisecond,
+			max:    150 * time.Millisecond,
+			mean:   100 * time.Millisecond,
+		},
+		{
+			name:   "uniform with a narrow jitter",
+			config: ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(0.1)},
+			rate:   10,
+			min:    90 * time.Millisecond,
+			max:    110 * time.Millisecond,
+			mean:   100 * time.Millisecond,
+		},
+		{
+			// An explicit jitter of 0 is not the default
+			name:   "uniform without jitter",
+			config: ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(0.0)},
+			rate:   10,
+			min:    100 * time.Millisecond,
+			max:    100 * time.Millisecond,
+			mean:   100 * time.Millisecond,
+		},
+		{
+			name: "replay ignores the rate",
+			config: ArrivalConfig{Model: ArrivalReplay, Intervals: []time.Duration{
+				time.Millisecond, 5 * time.Millisecond, 0, 6 * time.Millisecond,
+			}},
+			rate: 1000,
+			min:  0,
+			max:  6 * time.Millisecond,
+			mean: 3 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newArrivalSchedule(tt.config, 1)
+			var total time.Duration
+			for i := 0; i < samples; i++ {
+				gap := schedule.gap(tt.rate)
+				if gap < tt.min || gap > tt.max {
+					t.Fatalf("gap %d = %s, want between %s and %s", i, gap, tt.min, tt.max)
+				}
+				total += gap
+			}
+			mean := total / samples
+			if diff := math.Abs(float64(mean - tt.mean)); diff > 0.05*float64(tt.mean) {
+				t.Errorf("mean gap = %s, want %s", mean, tt.mean)
+			}
+		})
+	}
+}
+
+func TestArrivalScheduleReplayOrder(t *testing.T) {
+	intervals := []time.Duration{time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond}
+	schedule := newArrivalSchedule(ArrivalConfig{Model: ArrivalReplay, Intervals: intervals}, 1)
+	for i := 0; i < 2*len(intervals); i++ {
+		if got, want := schedule.gap(1), intervals[i%len(intervals)]; got != want {
+			t.Errorf("gap %d = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestParseArrivalModel(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    ArrivalModel
+		wantErr bool
+	}{
+		{"", ArrivalBatch, false},
+		{"batch", ArrivalBatch, false},
+		{"constant", ArrivalConstant, false},
+		{"poisson", ArrivalPoisson, false},
+		{"uniform", ArrivalUniform, false},
+		{"replay", ArrivalReplay, false},
+		{"bursty", ArrivalBatch, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseArrivalModel(tt.s)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseArrivalModel(%q) = %q, %v, want %q", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestArrivalConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ArrivalConfig
+		wantErr bool
+	}{
+		{"batch", ArrivalConfig{}, false},
+		{"uniform", ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(1.0)}, false},
+		{"uniform without jitter", ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(0.0)}, false},
+		{"replay", ArrivalConfig{Model: ArrivalReplay, Intervals: []time.Duration{0, time.Millisecond}}, false},
+		{"unknown model", ArrivalConfig{Model: "bursty"}, true},
+		{"negative jitter", ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(-0.1)}, true},
+		{"jitter above 1", ArrivalConfig{Model: ArrivalUniform, Jitter: ptr(1.5)}, true},
+		{"negative max in flight", ArrivalConfig{Model: ArrivalPoisson, MaxInFlight: -1}, true},
+		{"replay without intervals", ArrivalConfig{Model: ArrivalReplay}, true},
+		{"negative interval", ArrivalConfig{Model: ArrivalReplay, Intervals: []time.Duration{time.Millisecond, -time.Millisecond}}, true},
+		{"zero intervals", ArrivalConfig{Model: ArrivalReplay, Intervals: []time.Duration{0, 0}}, true},
+		{"intervals without replay", ArrivalConfig{Model: ArrivalPoisson, Intervals: []time.Duration{time.Millisecond}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestReadIntervals(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []time.Duration
+		wantErr bool
+	}{
+		{"durations", "15ms\n1s\n", []time.Duration{15 * time.Millisecond, time.Second}, false},
+		{"seconds", "0.5\n2\n", []time.Duration{500 * time.Millisecond, 2 * time.Second}, false},
+		{"comments and blank lines", "# recorded gaps\n\n  10ms  \n", []time.Duration{10 * time.Millisecond}, false},
+		{"invalid", "10ms\nsoon\n", nil, true},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "intervals.txt")
+		if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ReadIntervals(path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ReadIntervals() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: ReadIntervals() = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: ReadIntervals() = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+type pacedClient struct{ due time.Time }
+
+func (c pacedClient) GenerateTx() ([]byte, error) { return []byte("tx"), nil }
+func (c pacedClient) GenerateTxDue() ([]byte, time.Time, error) {
+	return []byte("paced tx"), c.due, nil
+}
+
+type unpacedClient struct{}
+
+func (unpacedClient) GenerateTx() ([]byte, error) { return []byte("tx"), nil }
+
+func TestGenerateTx(t *testing.T) {
+	due := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		client  loadtest.Client
+		wantTx  string
+		wantDue time.Time
+	}{
+		{"paced", pacedClient{due}, "paced tx", due},
+		{"not paced", unpacedClient{}, "tx", time.Time{}},
+	}
+	for _, tt := range tests {
+		tx, gotDue, err := generateTx(tt.client)
+		if err != nil || string(tx) != tt.wantTx || !gotDue.Equal(tt.wantDue) {
+			t.Errorf("%s: generateTx() = %s, %s, %v, want %s, %s", tt.name, tx, gotDue, err, tt.wantTx, tt.wantDue)
+		}
+	}
+}
+
+type sequencedClient struct{ sequenced bool }
+
+func (c sequencedClient) GenerateTx() ([]byte, error) { return nil, nil }
+func (c sequencedClient) Sequenced() bool             { return c.sequenced }
+
+type unsequencedClient struct{}
+
+func (unsequencedClient) GenerateTx() ([]byte, error) { return nil, nil }
+
+func TestArrivalConfigMaxInFlight(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxInFlight int
+		client      loadtest.Client
+		want        int
+	}{
+		{"default", 0, unsequencedClient{}, DefaultMaxInFlight},
+		{"default for a sequenced client", 0, sequencedClient{true}, 1},
+		{"default for a client that is not sequenced", 0, sequencedClient{false}, DefaultMaxInFlight},
+		{"configured", 8, unsequencedClient{}, 8},
+		{"configured for a sequenced client", 8, sequencedClient{true}, 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ArrivalConfig{Model: ArrivalConstant, MaxInFlight: tt.maxInFlight}
+			if got := config.maxInFlight(tt.client); got != tt.want {
+				t.Errorf("maxInFlight() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package loadtest
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+// DefaultChainPollInterval is how often the ChainCollector polls the nodes.
+const DefaultChainPollInterval = time.Second
+
+// BlockSample records a block committed during a load test.
+type BlockSample struct {
+	Height int64
+	// The time in the block header.
+	Time time.Time
+	// The number of transactions in the block and their size in bytes.
+	Txs   int
+	Bytes int
+	// The gas used by all the transactions in the block.
+	GasUsed int64
+	// The time since the previous block.
+	Interval time.Duration
+}
+
+// MempoolSample records the depth of the mempools of the nodes under test.
+type MempoolSample struct {
+	At time.Time
+	// The largest number of unconfirmed transactions in the mempool of any of
+	// the nodes.
+	Txs int
+}
+
+// ChainStats summarizes what the chain processed during a load test, as
+// opposed to what was sent to it.
+type ChainStats struct {
+	Blocks                int
+	CommittedTxs          int
+	CommittedTxsPerSecond float64
+	AvgBlockTime          time.Duration
+	AvgBlockBytes         float64
+	AvgGasUsed            float64
+	MaxMempoolTxs         int
+	PerSecond             []*ChainSecondStats
+}
+
+// ChainSecondStats holds the blocks committed within one second of the run,
+// by block time, and the mempool depth sampled within it.
+type ChainSecondStats struct {
+	// The ordinal number of the second, starting at 0.
+	Sec          int
+	Blocks       int
+	CommittedTxs int
+	BlockBytes   int64
+	GasUsed      int64
+	AvgBlockTime time.Duration
+	MempoolTxs   int
+}
+
+// ChainCollector polls the nodes under test for the blocks they commit and
+// the depth of their mempools while a load test runs. Blocks are read from
+// the first endpoint; mempools from all of them.
+type ChainCollector struct {
+	clients  []*httprpc.HTTPRPCClient
+	interval time.Duration
+	logger   *logrus.Logger
+
+	// The last block recorded, only used by the polling goroutine and by
+	// Stop once it has exited
+	lastHeight int64
+	lastTime   time.Time
+
+	mtx     sync.Mutex
+	blocks  []BlockSample
+	mempool []MempoolSample
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewChainCollector creates a collector for the nodes behind the given
+// endpoints, which may use any of the protocols the transactors support.
+func NewChainCollector(endpoints []string) (*ChainCollector, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("at least one endpoint is required")
+	}
+
+	c := &ChainCollector{
+		interval: DefaultChainPollInterval,
+		logger:   logrus.WithField("component", "chain-collector").Logger,
+		stopCh:   make(chan struct{}),
+	}
+	for _, endpoint := range endpoints {
+		httpURL, err := rpcURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		client, err := httprpc.NewHTTPRPCClient(httpURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP RPC client for %s: %w", endpoint, err)
+		}
+		c.clients = append(c.clients, client)
+	}
+	return c, nil
+}
+
+// Start records the latest block as the starting point and starts polling.
+// Only the blocks committed after it are reported.
+func (c *ChainCollector) Start() error {
+	res, err := c.clients[0].Block(0)
+	if err != nil {
+		return fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	height, err := strconv.ParseInt(res.Block.Header.Height, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid block height %q: %w", res.Block.Header.Height, err)
+	}
+	c.lastHeight = height
+	c.lastTime = res.Block.Header.Time
+
+	c.wg.Add(1)
+	go c.run()
+	return nil
+}
+
+func (c *ChainCollector) run() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.poll()
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// Stop picks up the blocks committed since the last poll and stops polling.
+// It must only be called after Start succeeded.
+func (c *ChainCollector) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+	c.poll()
+	for _, client := range c.clients {
+		client.Close()
+	}
+}
+
+// poll records the mempool depth and every block committed since the
+// previous poll.
+func (c *ChainCollector) poll() {
+	sample := MempoolSample{At: time.Now()}
+	for _, client := range c.clients {
+		res, err := client.NumUnconfirmedTxs()
+		if err != nil {
+			c.logger.Debugf("Failed to get the number of unconfirmed transactions: %v", err)
+			continue
+		}
+		if n, err := strconv.Atoi(res.Total); err == nil && n > sample.Txs {
+			sample.Txs = n
+		}
+	}
+
+	latest, err := c.clients[0].Block(0)
+	if err != nil {
+		c.logger.Debugf("Failed to get the latest block: %v", err)
+		return
+	}
+	latestHeight, err := strconv.ParseInt(latest.Block.Header.Height, 10, 64)
+	if err != nil {
+		c.logger.Debugf("Invalid block height %q", latest.Block.Header.Height)
+		return
+	}
+
+	// Fetch the new blocks before locking, so that Stats isn't held up by
+	// the RPCs
+	var blocks []BlockSample
+	for height := c.lastHeight + 1; height <= latestHeight; height++ {
+		block := &latest.Block
+		if height != latestHeight {
+			res, err := c.clients[0].Block(height)
+			if err != nil {
+				// Try again at the next poll
+				c.logger.Debugf("Failed to get block %d: %v", height, err)
+				break
+			}
+			block = &res.Block
+		}
+		blocks = append(blocks, c.blockSample(height, block))
+		c.lastHeight = height
+		c.lastTime = block.Header.Time
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.mempool = append(c.mempool, sample)
+	c.blocks = append(c.blocks, blocks...)
+}
+
+// blockSample describes the block at the given height, which must be the one
+// after the last block recorded.
+func (c *ChainCollector) blockSample(height int64, block *httprpc.Block) BlockSample {
+	sample := BlockSample{
+		Height:   height,
+		Time:     block.Header.Time,
+		Txs:      len(block.Data.Txs),
+		Interval: block.Header.Time.Sub(c.lastTime),
+	}
+	for _, tx := range block.Data.Txs {
+		sample.Bytes += len(tx)
+	}
+	if sample.Txs > 0 {
+		results, err := c.clients[0].BlockResults(height)
+		if err != nil {
+			c.logger.Debugf("Failed to get the results of block %d: %v", height, err)
+		} else {
+			for _, result := range results.TxsResults {
+				if gas, err := strconv.ParseInt(result.GasUsed, 10, 64); err == nil {
+					sample.GasUsed += gas
+				}
+			}
+		}
+	}
+
+	return sample
+}
+
+// Stats summarizes the blocks and mempool depths collected so far, by second
+// relative to startTime, and computes rates over totalTime.
+func (c *ChainCollector) Stats(startTime time.Time, totalTime time.Duration) *ChainStats {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	stats := &ChainStats{Blocks: len(c.blocks)}
+	perSec := make(map[int]*ChainSecondStats)
+	maxSec := -1
+	second := func(t time.Time) *ChainSecondStats {
+		sec := int(t.Sub(startTime) / time.Second)
+		if sec < 0 {
+			sec = 0
+		}
+		if sec > maxSec {
+			maxSec = sec
+		}
+		if perSec[sec] == nil {
+			perSec[sec] = &ChainSecondStats{Sec: sec}
+		}
+		return perSec[sec]
+	}
+
+	var totalBlockTime time.Duration
+	var totalBytes, totalGas int64
+	blockTimes := make(map[int]time.Duration)
+	for _, block := range c.blocks {
+		stats.CommittedTxs += block.Txs
+		totalBlockTime += block.Interval
+		totalBytes += int64(block.Bytes)
+		totalGas += block.GasUsed
+
+		ps := second(block.Time)
+		ps.Blocks++
+		ps.CommittedTxs += block.Txs
+		ps.BlockBytes += int64(block.Bytes)
+		ps.GasUsed += block.GasUsed
+		blockTimes[ps.Sec] += block.Interval
+	}
+	for _, sample := range c.mempool {
+		ps := second(sample.At)
+		if sample.Txs > ps.MempoolTxs {
+			ps.MempoolTxs = sample.Txs
+		}
+		if sample.Txs > stats.MaxMempoolTxs {
+			stats.MaxMempoolTxs = sample.Txs
+		}
+	}
+
+	if stats.Blocks > 0 {
+		stats.AvgBlockTime = totalBlockTime / time.Duration(stats.Blocks)
+		stats.AvgBlockBytes = float64(totalBytes) / float64(stats.Blocks)
+		stats.AvgGasUsed = float64(totalGas) / float64(stats.Blocks)
+	}
+	if secs := totalTime.Seconds(); secs > 0 {
+		stats.CommittedTxsPerSecond = float64(stats.CommittedTxs) / secs
+	}
+
+	for sec := 0; sec <= maxSec; sec++ {
+		ps := perSec[sec]
+		if ps == nil {
+			ps = &ChainSecondStats{Sec: sec}
+		}
+		if ps.Blocks > 0 {
+			ps.AvgBlockTime = blockTimes[sec] / time.Duration(ps.Blocks)
+		}
+		stats.PerSecond = append(stats.PerSecond, ps)
+	}
+	return stats
+}
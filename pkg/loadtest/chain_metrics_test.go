@@ -0,0 +1,149 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeBlock struct {
+	time time.Time
+	txs  [][]byte
+	// The gas used by each transaction
+	gas []int64
+}
+
+// fakeChain serves the RPCs the ChainCollector polls.
+type fakeChain struct {
+	mtx     sync.Mutex
+	latest  int64
+	blocks  map[int64]fakeBlock
+	mempool int
+}
+
+func (c *fakeChain) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int64  `json:"id"`
+		Method string `json:"method"`
+		Params struct {
+			Height string `json:"height"`
+		} `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	height := c.latest
+	if req.Params.Height != "" {
+		height, _ = strconv.ParseInt(req.Params.Height, 10, 64)
+	}
+	block := c.blocks[height]
+
+	var result any
+	switch req.Method {
+	case "block":
+		result = map[string]any{"block": map[string]any{
+			"header": map[string]any{"height": strconv.FormatInt(height, 10), "time": block.time},
+			"data":   map[string]any{"txs": block.txs},
+		}}
+	case "block_results":
+		var txsResults []map[string]any
+		for _, gas := range block.gas {
+			txsResults = append(txsResults, map[string]any{"gas_used": strconv.FormatInt(gas, 10)})
+		}
+		result = map[string]any{"height": strconv.FormatInt(height, 10), "txs_results": txsResults}
+	case "num_unconfirmed_txs":
+		result = map[string]any{"n_txs": "0", "total": strconv.Itoa(c.mempool)}
+	default:
+		http.Error(w, "unexpected method "+req.Method, http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func TestChainCollector(t *testing.T) {
+	start := time.Now()
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	first := &fakeChain{
+		latest: 10,
+		blocks: map[int64]fakeBlock{
+			10: {time: at(0), txs: [][]byte{[]byte("before the start")}, gas: []int64{1000}},
+			11: {time: at(500 * time.Millisecond), txs: [][]byte{[]byte("tx1"), []byte("tx22")}, gas: []int64{100, 50}},
+			12: {time: at(1200 * time.Millisecond)},
+			13: {time: at(1800 * time.Millisecond), txs: [][]byte{[]byte("tx333")}, gas: []int64{70}},
+		},
+		mempool: 4,
+	}
+	second := &fakeChain{mempool: 9}
+	srv1, srv2 := httptest.NewServer(first), httptest.NewServer(second)
+	defer srv1.Close()
+	defer srv2.Close()
+
+	// The collector reads blocks from the first endpoint only
+	collector, err := NewChainCollector([]string{srv1.URL, srv2.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := collector.Start(); err != nil {
+		t.Fatal(err)
+	}
+	first.mtx.Lock()
+	first.latest = 13
+	first.mtx.Unlock()
+	collector.Stop()
+
+	stats := collector.Stats(start, 2*time.Second)
+	if stats.Blocks != 3 || stats.CommittedTxs != 3 || stats.CommittedTxsPerSecond != 1.5 {
+		t.Errorf("chain stats = %d blocks, %d txs, %g tx/s, want 3 blocks, 3 txs, 1.5 tx/s",
+			stats.Blocks, stats.CommittedTxs, stats.CommittedTxsPerSecond)
+	}
+	if stats.AvgBlockTime != 600*time.Millisecond {
+		t.Errorf("average block time = %s, want 600ms", stats.AvgBlockTime)
+	}
+	if want := 220.0 / 3; stats.AvgGasUsed != want || stats.AvgBlockBytes != 4 {
+		t.Errorf("average gas = %g, bytes = %g, want %g, 4", stats.AvgGasUsed, stats.AvgBlockBytes, want)
+	}
+	if stats.MaxMempoolTxs != 9 {
+		t.Errorf("max mempool depth = %d, want the deepest mempool's 9", stats.MaxMempoolTxs)
+	}
+
+	want := []ChainSecondStats{
+		{Sec: 0, Blocks: 1, CommittedTxs: 2, BlockBytes: 7, GasUsed: 150, AvgBlockTime: 500 * time.Millisecond, MempoolTxs: 9},
+		{Sec: 1, Blocks: 2, CommittedTxs: 1, BlockBytes: 5, GasUsed: 70, AvgBlockTime: 650 * time.Millisecond},
+	}
+	if len(stats.PerSecond) != len(want) {
+		t.Fatalf("%d seconds, want %d", len(stats.PerSecond), len(want))
+	}
+	for i := range want {
+		if *stats.PerSecond[i] != want[i] {
+			t.Errorf("second %d = %+v, want %+v", i, *stats.PerSecond[i], want[i])
+		}
+	}
+}
+
+func TestNewChainCollector(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []string
+		wantErr   bool
+	}{
+		{"no endpoints", nil, true},
+		{"unsupported protocol", []string{"tcp://localhost:26657"}, true},
+		{"mixed protocols", []string{"ws://localhost:26657/websocket", "https://rpc.example.com"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewChainCollector(tt.endpoints)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChainCollector(%q) error = %v, wantErr %t", tt.endpoints, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package loadtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+// ClientParams are parameters of a client factory by name, such as the chain
+// ID or the gas price, which override the factory's defaults for one load
+// test.
+type ClientParams map[string]string
+
+// ParseClientParams parses comma-separated key=value pairs.
+func ParseClientParams(s string) (ClientParams, error) {
+	params := make(ClientParams)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid client parameter %q, expected key=value", pair)
+		}
+		params[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return params, nil
+}
+
+// String formats the parameters as ParseClientParams expects them, sorted by
+// key.
+func (p ClientParams) String() string {
+	pairs := make([]string, 0, len(p))
+	for key, value := range p {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// ParamsClientFactory is implemented by client factories that take
+// ClientParams.
+type ParamsClientFactory interface {
+	// ValidateParams checks the configuration along with the parameters.
+	ValidateParams(cfg loadtest.Config, params ClientParams) error
+	// NewClientWithParams creates a client that applies the parameters.
+	NewClientWithParams(cfg loadtest.Config, params ClientParams) (loadtest.Client, error)
+}
+
+// ValidateClientConfig validates the configuration and the parameters with the
+// load test's client factory. Parameters are an error for factories that
+// don't take any.
+func ValidateClientConfig(cfg loadtest.Config, params ClientParams) error {
+	factory, ok := GetClientFactory(cfg.ClientFactory)
+	if !ok {
+		return fmt.Errorf("client factory %q is not registered", cfg.ClientFactory)
+	}
+	if paramsFactory, ok := factory.(ParamsClientFactory); ok {
+		return paramsFactory.ValidateParams(cfg, params)
+	}
+	if len(params) > 0 {
+		return fmt.Errorf("client factory %q does not take parameters", cfg.ClientFactory)
+	}
+	return factory.ValidateConfig(cfg)
+}
+
+// newClient creates a client with the factory, applying the parameters if
+// there are any.
+func newClient(factory loadtest.ClientFactory, cfg loadtest.Config, params ClientParams) (loadtest.Client, error) {
+	if len(params) == 0 {
+		return factory.NewClient(cfg)
+	}
+	paramsFactory, ok := factory.(ParamsClientFactory)
+	if !ok {
+		return nil, fmt.Errorf("client factory %q does not take parameters", cfg.ClientFactory)
+	}
+	return paramsFactory.NewClientWithParams(cfg, params)
+}
@@ -0,0 +1,64 @@
+package loadtest
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+func TestParseClientParams(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    ClientParams
+		wantErr bool
+	}{
+		{"", ClientParams{}, false},
+		{"chain_id=test-chain", ClientParams{"chain_id": "test-chain"}, false},
+		{" denom = uaiw , gas_price=0.025,", ClientParams{"denom": "uaiw", "gas_price": "0.025"}, false},
+		{"memo=", ClientParams{"memo": ""}, false},
+		{"chain_id", nil, true},
+		{"=uaiw", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseClientParams(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseClientParams(%q) error = %v, wantErr %t", tt.s, err, tt.wantErr)
+			continue
+		}
+		if !maps.Equal(got, tt.want) {
+			t.Errorf("ParseClientParams(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestClientParamsString(t *testing.T) {
+	params := ClientParams{"gas_price": "0.025", "chain_id": "test-chain"}
+	want := "chain_id=test-chain,gas_price=0.025"
+	if got := params.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	parsed, err := ParseClientParams(params.String())
+	if err != nil || !maps.Equal(parsed, params) {
+		t.Errorf("ParseClientParams(String()) = %v, %v, want %v", parsed, err, params)
+	}
+}
+
+func TestValidateClientConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		factory string
+		params  ClientParams
+		wantErr bool
+	}{
+		{"no parameters", "test-http-transactor", nil, false},
+		{"parameters for a factory without any", "test-http-transactor", ClientParams{"denom": "uaiw"}, true},
+		{"unknown factory", "not-registered", nil, true},
+	}
+	for _, tt := range tests {
+		err := ValidateClientConfig(loadtest.Config{ClientFactory: tt.factory}, tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateClientConfig() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
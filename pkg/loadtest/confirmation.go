@@ -0,0 +1,406 @@
+package loadtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+	"github.com/orijtech/cosmosloadtester/pkg/wsrpc"
+)
+
+const (
+	// DefaultConfirmationTimeout is how long to wait for a transaction to be
+	// included in a block before counting it as dropped.
+	DefaultConfirmationTimeout = 30 * time.Second
+	// DefaultPollInterval is how often new blocks are looked up in ConfirmPoll
+	// mode.
+	DefaultPollInterval = 500 * time.Millisecond
+)
+
+// ConfirmationMode selects how transactors learn that the transactions the
+// node accepted were included in a block.
+type ConfirmationMode string
+
+const (
+	// ConfirmNone only measures the broadcast round trip.
+	ConfirmNone ConfirmationMode = ""
+	// ConfirmSubscribe subscribes to Tx events over the endpoint's WebSocket.
+	ConfirmSubscribe ConfirmationMode = "subscribe"
+	// ConfirmPoll fetches every new block with the endpoint's block RPC and
+	// matches the hashes of its transactions. Transactions count as included
+	// at the time of their block, so inclusion latencies are only as precise
+	// as the clocks of the load tester and the chain are in sync.
+	ConfirmPoll ConfirmationMode = "poll"
+)
+
+// ParseConfirmationMode parses none, subscribe or poll.
+func ParseConfirmationMode(s string) (ConfirmationMode, error) {
+	switch s {
+	case "", "none":
+		return ConfirmNone, nil
+	case string(ConfirmSubscribe), string(ConfirmPoll):
+		return ConfirmationMode(s), nil
+	}
+	return ConfirmNone, fmt.Errorf("unsupported confirmation mode: %s (supported: none, subscribe, poll)", s)
+}
+
+// ConfirmationConfig configures the tracking of transaction inclusion.
+type ConfirmationConfig struct {
+	Mode ConfirmationMode
+	// How long to wait for a transaction to be included before counting it
+	// as dropped. Defaults to DefaultConfirmationTimeout.
+	Timeout time.Duration
+	// How often to look up new blocks in ConfirmPoll mode.
+	// Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// TxInclusion tells whether a transaction made it into a block.
+type TxInclusion int
+
+const (
+	// TxUntracked transactions were not tracked, either because tracking is
+	// off or because the node did not accept them.
+	TxUntracked TxInclusion = iota
+	// TxPending transactions have not been seen in a block yet.
+	TxPending
+	// TxIncluded transactions were seen in a block.
+	TxIncluded
+	// TxDropped transactions were accepted into the mempool but not seen in
+	// a block before the confirmation timeout.
+	TxDropped
+)
+
+func (i TxInclusion) String() string {
+	switch i {
+	case TxPending:
+		return "pending"
+	case TxIncluded:
+		return "included"
+	case TxDropped:
+		return "dropped"
+	}
+	return "untracked"
+}
+
+// pendingTx is an accepted transaction waiting to be seen in a block.
+type pendingTx struct {
+	// The index of the transaction's sample in the recorder.
+	sample int
+	sentAt time.Time
+}
+
+// blockInclusion records when a transaction was seen in a block: when the
+// event arrived in ConfirmSubscribe mode, the block time in ConfirmPoll mode.
+type blockInclusion struct {
+	height int64
+	seenAt time.Time
+}
+
+// confirmationTracker matches the hashes of accepted transactions against
+// the transactions included in blocks, and records the inclusion of each
+// transaction in its sample.
+type confirmationTracker struct {
+	config   ConfirmationConfig
+	recorder *statsRecorder
+	logger   *logrus.Logger
+
+	wsClient   *wsrpc.WSRPCClient
+	httpClient *httprpc.HTTPRPCClient
+	// The last height whose block was matched in ConfirmPoll mode
+	lastHeight int64
+
+	mtx     sync.Mutex
+	pending map[string]pendingTx
+	// Transactions seen in a block before they were tracked, which happens
+	// when a block is committed before the broadcast response arrives.
+	early map[string]blockInclusion
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newConfirmationTracker(endpoint string, config ConfirmationConfig, recorder *statsRecorder, logger *logrus.Logger) (*confirmationTracker, error) {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultConfirmationTimeout
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+
+	t := &confirmationTracker{
+		config:   config,
+		recorder: recorder,
+		logger:   logger,
+		pending:  make(map[string]pendingTx),
+		early:    make(map[string]blockInclusion),
+		stopCh:   make(chan struct{}),
+	}
+
+	switch config.Mode {
+	case ConfirmSubscribe:
+		wsURL, err := websocketURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		// Events get their own connection so that they don't hold up
+		// broadcast responses
+		wsClient, err := wsrpc.NewWSRPCClient(wsURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect for Tx events: %w", err)
+		}
+		events := make(chan json.RawMessage, 1024)
+		if err := wsClient.Subscribe("tm.event='Tx'", events); err != nil {
+			wsClient.Close()
+			return nil, err
+		}
+		t.wsClient = wsClient
+		t.wg.Add(1)
+		go t.consumeEvents(events)
+	case ConfirmPoll:
+		httpURL, err := rpcURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		httpClient, err := httprpc.NewHTTPRPCClient(httpURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP RPC client for block lookups: %w", err)
+		}
+		// Nothing sent from now on is in the blocks committed so far
+		latest, err := latestHeight(httpClient)
+		if err != nil {
+			httpClient.Close()
+			return nil, err
+		}
+		t.httpClient = httpClient
+		t.lastHeight = latest
+		t.wg.Add(1)
+		go t.poll()
+	default:
+		return nil, fmt.Errorf("unsupported confirmation mode: %q", config.Mode)
+	}
+	return t, nil
+}
+
+// track starts waiting for the transaction with the given hash, whose sample
+// was recorded at the given index.
+func (t *confirmationTracker) track(sample int, hash string, sentAt time.Time) {
+	hash = strings.ToUpper(hash)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if inclusion, ok := t.early[hash]; ok {
+		delete(t.early, hash)
+		t.recorder.setInclusion(sample, TxIncluded, inclusion.height, inclusion.seenAt.Sub(sentAt))
+		return
+	}
+	t.pending[hash] = pendingTx{sample: sample, sentAt: sentAt}
+}
+
+// included records that the transaction with the given hash was seen in the
+// block at the given height.
+func (t *confirmationTracker) included(hash string, height int64, seenAt time.Time) {
+	hash = strings.ToUpper(hash)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	tx, ok := t.pending[hash]
+	if !ok {
+		t.early[hash] = blockInclusion{height: height, seenAt: seenAt}
+		return
+	}
+	delete(t.pending, hash)
+	t.recorder.setInclusion(tx.sample, TxIncluded, height, seenAt.Sub(tx.sentAt))
+}
+
+// expire counts the transactions that have been pending for longer than the
+// timeout as dropped, and returns the number still pending.
+func (t *confirmationTracker) expire(now time.Time) int {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for hash, tx := range t.pending {
+		if now.Sub(tx.sentAt) >= t.config.Timeout {
+			delete(t.pending, hash)
+			t.recorder.setInclusion(tx.sample, TxDropped, 0, 0)
+		}
+	}
+	// Transactions of other senders are never tracked
+	for hash, inclusion := range t.early {
+		if now.Sub(inclusion.seenAt) >= t.config.Timeout {
+			delete(t.early, hash)
+		}
+	}
+	return len(t.pending)
+}
+
+// consumeEvents matches the hashes of Tx events until the tracker is stopped.
+func (t *confirmationTracker) consumeEvents(events <-chan json.RawMessage) {
+	defer t.wg.Done()
+
+	expireTicker := time.NewTicker(time.Second)
+	defer expireTicker.Stop()
+
+	for {
+		select {
+		case result := <-events:
+			hash, height, err := parseTxEvent(result)
+			if err != nil {
+				t.logger.Debugf("Ignoring Tx event: %v", err)
+				continue
+			}
+			t.included(hash, height, time.Now())
+		case now := <-expireTicker.C:
+			t.expire(now)
+		case <-t.stopCh:
+			return
+		}
+	}
+}
+
+// poll matches the transactions of every new block every poll interval
+// until the tracker is stopped.
+func (t *confirmationTracker) poll() {
+	defer t.wg.Done()
+
+	pollTicker := time.NewTicker(t.config.PollInterval)
+	defer pollTicker.Stop()
+
+	for {
+		select {
+		case <-pollTicker.C:
+		case <-t.stopCh:
+			return
+		}
+
+		if err := t.matchNewBlocks(); err != nil {
+			t.logger.Debugf("Failed to look up new blocks: %v", err)
+		}
+		t.expire(time.Now())
+	}
+}
+
+// matchNewBlocks matches the transactions of the blocks committed since the
+// last height matched, at the time of their block.
+func (t *confirmationTracker) matchNewBlocks() error {
+	latest, err := latestHeight(t.httpClient)
+	if err != nil {
+		return err
+	}
+	for ; t.lastHeight < latest; t.lastHeight++ {
+		height := t.lastHeight + 1
+		res, err := t.httpClient.Block(height)
+		if err != nil {
+			return fmt.Errorf("failed to get block %d: %w", height, err)
+		}
+		for _, tx := range res.Block.Data.Txs {
+			t.included(txHash(tx), height, res.Block.Header.Time)
+		}
+	}
+	return nil
+}
+
+// latestHeight returns the height of the latest block of the node.
+func latestHeight(client *httprpc.HTTPRPCClient) (int64, error) {
+	res, err := client.Block(0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	height, err := strconv.ParseInt(res.Block.Header.Height, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid block height %q: %w", res.Block.Header.Height, err)
+	}
+	return height, nil
+}
+
+// txHash returns the hash Tendermint identifies a transaction by.
+func txHash(tx []byte) string {
+	sum := sha256.Sum256(tx)
+	return hex.EncodeToString(sum[:])
+}
+
+// finish waits until every tracked transaction has been included or has
+// timed out, and then disconnects.
+func (t *confirmationTracker) finish() error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for t.expire(time.Now()) > 0 {
+		<-ticker.C
+	}
+
+	close(t.stopCh)
+	t.wg.Wait()
+	if t.wsClient != nil {
+		return t.wsClient.Close()
+	}
+	return t.httpClient.Close()
+}
+
+// txEvent holds the parts of a Tx event that identify the transaction.
+type txEvent struct {
+	Events map[string][]string `json:"events"`
+}
+
+func parseTxEvent(result json.RawMessage) (string, int64, error) {
+	var event txEvent
+	if err := json.Unmarshal(result, &event); err != nil {
+		return "", 0, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	hashes, heights := event.Events["tx.hash"], event.Events["tx.height"]
+	if len(hashes) == 0 || len(heights) == 0 {
+		return "", 0, fmt.Errorf("event has no tx.hash or tx.height")
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid tx.height %q: %w", heights[0], err)
+	}
+	return hashes[0], height, nil
+}
+
+// websocketURL returns the WebSocket RPC URL of the node behind endpoint.
+func websocketURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+	switch u.Scheme {
+	case "ws", "wss":
+		return u.String(), nil
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported protocol: %s", u.Scheme)
+	}
+	u.Path = "/websocket"
+	return u.String(), nil
+}
+
+// rpcURL returns the HTTP RPC URL of the node behind endpoint.
+func rpcURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return u.String(), nil
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	default:
+		return "", fmt.Errorf("unsupported protocol: %s", u.Scheme)
+	}
+	u.Path = ""
+	return u.String(), nil
+}
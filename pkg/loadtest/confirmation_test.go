@@ -0,0 +1,215 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseConfirmationMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ConfirmationMode
+		wantErr bool
+	}{
+		{"", ConfirmNone, false},
+		{"none", ConfirmNone, false},
+		{"subscribe", ConfirmSubscribe, false},
+		{"poll", ConfirmPoll, false},
+		{"events", ConfirmNone, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseConfirmationMode(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseConfirmationMode(%q) = %q, %v, want %q, error %t", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestConfirmationURLs(t *testing.T) {
+	tests := []struct {
+		endpoint         string
+		wantWS, wantHTTP string
+	}{
+		{"http://localhost:26657", "ws://localhost:26657/websocket", "http://localhost:26657"},
+		{"https://rpc.example.com", "wss://rpc.example.com/websocket", "https://rpc.example.com"},
+		{"ws://localhost:26657/websocket", "ws://localhost:26657/websocket", "http://localhost:26657"},
+		{"wss://rpc.example.com/websocket", "wss://rpc.example.com/websocket", "https://rpc.example.com"},
+		{"tcp://localhost:26657", "", ""},
+	}
+	for _, tt := range tests {
+		gotWS, err := websocketURL(tt.endpoint)
+		if gotWS != tt.wantWS || (err != nil) != (tt.wantWS == "") {
+			t.Errorf("websocketURL(%q) = %q, %v, want %q", tt.endpoint, gotWS, err, tt.wantWS)
+		}
+		gotHTTP, err := rpcURL(tt.endpoint)
+		if gotHTTP != tt.wantHTTP || (err != nil) != (tt.wantHTTP == "") {
+			t.Errorf("rpcURL(%q) = %q, %v, want %q", tt.endpoint, gotHTTP, err, tt.wantHTTP)
+		}
+	}
+}
+
+func TestParseTxEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      string
+		wantHash   string
+		wantHeight int64
+		wantErr    bool
+	}{
+		{
+			name:       "tx event",
+			event:      `{"query":"tm.event='Tx'","events":{"tx.hash":["ABCD"],"tx.height":["42"],"tm.event":["Tx"]}}`,
+			wantHash:   "ABCD",
+			wantHeight: 42,
+		},
+		{name: "no hash", event: `{"events":{"tx.height":["42"]}}`, wantErr: true},
+		{name: "invalid height", event: `{"events":{"tx.hash":["ABCD"],"tx.height":["x"]}}`, wantErr: true},
+		{name: "not JSON", event: `[`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, height, err := parseTxEvent(json.RawMessage(tt.event))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTxEvent() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if hash != tt.wantHash || height != tt.wantHeight {
+				t.Errorf("parseTxEvent() = %q, %d, want %q, %d", hash, height, tt.wantHash, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestConfirmationTrackerMatching(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	recorder := &statsRecorder{}
+	tracker := &confirmationTracker{
+		config:   ConfirmationConfig{Timeout: 10 * time.Second},
+		recorder: recorder,
+		pending:  make(map[string]pendingTx),
+		early:    make(map[string]blockInclusion),
+	}
+	for i := 0; i < 3; i++ {
+		recorder.record(TxSample{SentAt: at(time.Duration(i) * time.Second), Inclusion: TxPending})
+	}
+
+	// Included after it was tracked, with hashes in any case
+	tracker.track(0, "aa01", at(0))
+	tracker.included("AA01", 5, at(2*time.Second))
+	// Included before the broadcast response arrived
+	tracker.included("AA02", 6, at(1500*time.Millisecond))
+	tracker.track(1, "AA02", at(time.Second))
+	// Never included
+	tracker.track(2, "AA03", at(2*time.Second))
+
+	if pending := tracker.expire(at(11 * time.Second)); pending != 1 {
+		t.Errorf("%d pending before the timeout, want 1", pending)
+	}
+	if pending := tracker.expire(at(12 * time.Second)); pending != 0 {
+		t.Errorf("%d pending after the timeout, want 0", pending)
+	}
+
+	want := []struct {
+		inclusion TxInclusion
+		height    int64
+		latency   time.Duration
+	}{
+		{TxIncluded, 5, 2 * time.Second},
+		{TxIncluded, 6, 500 * time.Millisecond},
+		{TxDropped, 0, 0},
+	}
+	for i, sample := range recorder.snapshot() {
+		if sample.Inclusion != want[i].inclusion || sample.Height != want[i].height || sample.InclusionLatency != want[i].latency {
+			t.Errorf("sample %d = %s at height %d after %s, want %s at height %d after %s", i,
+				sample.Inclusion, sample.Height, sample.InclusionLatency,
+				want[i].inclusion, want[i].height, want[i].latency)
+		}
+	}
+}
+
+func TestConfirmationTrackerPoll(t *testing.T) {
+	sentAt := time.Now()
+	tx := []byte("included tx")
+	// The chain is at height 5 when the tracker starts, and commits the
+	// transaction in block 6 once it was tracked
+	var height atomic.Int64
+	height.Store(5)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Height string `json:"height"`
+			} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "block" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		h := height.Load()
+		if req.Params.Height != "" {
+			h, _ = strconv.ParseInt(req.Params.Height, 10, 64)
+		}
+		var txs [][]byte
+		if h == 6 {
+			txs = [][]byte{tx}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": map[string]any{
+			"block": map[string]any{
+				"header": map[string]any{"height": strconv.FormatInt(h, 10), "time": sentAt.Add(time.Duration(h-4) * time.Second)},
+				"data":   map[string]any{"txs": txs},
+			},
+		}})
+	}))
+	defer srv.Close()
+
+	recorder := &statsRecorder{}
+	config := ConfirmationConfig{Mode: ConfirmPoll, Timeout: 300 * time.Millisecond, PollInterval: 10 * time.Millisecond}
+	tracker, err := newConfirmationTracker(srv.URL, config, recorder, logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, hash := range []string{strings.ToUpper(txHash(tx)), "AA02"} {
+		idx := recorder.record(TxSample{SentAt: sentAt, Inclusion: TxPending})
+		tracker.track(idx, hash, sentAt)
+		if idx != i {
+			t.Fatalf("sample recorded at %d, want %d", idx, i)
+		}
+	}
+	height.Store(7)
+	if err := tracker.finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	samples := recorder.snapshot()
+	// Included at the time of block 6, 2s after it was sent
+	if samples[0].Inclusion != TxIncluded || samples[0].Height != 6 || samples[0].InclusionLatency != 2*time.Second {
+		t.Errorf("found tx = %s at height %d after %s, want included at height 6 after 2s",
+			samples[0].Inclusion, samples[0].Height, samples[0].InclusionLatency)
+	}
+	if samples[1].Inclusion != TxDropped {
+		t.Errorf("missing tx = %s, want %s", samples[1].Inclusion, TxDropped)
+	}
+}
+
+func TestTxHash(t *testing.T) {
+	// The hash of an empty transaction is that of empty input
+	if got, want := txHash(nil), "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"; got != want {
+		t.Errorf("txHash(nil) = %s, want %s", got, want)
+	}
+}
+
+func TestNewConfirmationTrackerMode(t *testing.T) {
+	_, err := newConfirmationTracker("http://localhost:26657", ConfirmationConfig{Mode: "events"}, &statsRecorder{}, logrus.New())
+	if err == nil || !strings.Contains(err.Error(), "unsupported confirmation mode") {
+		t.Errorf("newConfirmationTracker with an unknown mode = %v, want an unsupported mode error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package loadtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/corpus"
+)
+
+// ChainIDFactory is implemented by client factories whose transactions are
+// signed for a chain.
+type ChainIDFactory interface {
+	// ChainID returns the chain ID the factory's clients sign for with the
+	// parameters.
+	ChainID(params ClientParams) (string, error)
+}
+
+// AccountPoolFactory is implemented by client factories whose clients sign
+// with the accounts of a pool, which clients share when there are fewer
+// accounts than connections.
+type AccountPoolFactory interface {
+	// PoolSize returns the number of accounts the factory's clients sign
+	// with for the parameters, 0 if they don't sign.
+	PoolSize(params ClientParams) (int, error)
+}
+
+// GenerateCorpus signs txsPerSender transactions with each client the load
+// test would create, one per connection, and writes them to the corpus file
+// at path to be replayed later. Each sender needs an account of its own,
+// whose sequences its transactions use up in order: with funding enabled, one
+// sender account per connection is funded first, and is not swept so that
+// the corpus can be replayed. Otherwise the factory must sign with at least
+// as many accounts as there are senders. Nothing else is broadcast, but the
+// clients may query the endpoints for the state of their accounts.
+func GenerateCorpus(cfg loadtest.Config, params ClientParams, funding FundingConfig, path string, txsPerSender int) (corpus.Header, error) {
+	header := corpus.Header{
+		Version:      corpus.Version,
+		Factory:      cfg.ClientFactory,
+		ClientParams: params,
+		Senders:      len(cfg.Endpoints) * cfg.Connections,
+		TxsPerSender: txsPerSender,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := ValidateClientConfig(cfg, params); err != nil {
+		return header, err
+	}
+	// Validated above
+	factory, _ := GetClientFactory(cfg.ClientFactory)
+	if chainIDFactory, ok := factory.(ChainIDFactory); ok {
+		chainID, err := chainIDFactory.ChainID(params)
+		if err != nil {
+			return header, err
+		}
+		header.ChainID = chainID
+	}
+
+	if funding.Enabled() {
+		funding.Sweep = false
+		teardown, err := FundAccounts(cfg, params, funding)
+		if err != nil {
+			return header, err
+		}
+		// Only releases the funded accounts
+		defer teardown()
+	} else if poolFactory, ok := factory.(AccountPoolFactory); ok {
+		accounts, err := poolFactory.PoolSize(params)
+		if err != nil {
+			return header, err
+		}
+		if accounts > 0 && accounts < header.Senders {
+			return header, fmt.Errorf("the %d senders of the corpus need an account each, but %s signs with %d: configure more accounts, or fund one per sender", header.Senders, cfg.ClientFactory, accounts)
+		}
+	}
+
+	release, err := Prepare(cfg, params)
+	if err != nil {
+		return header, err
+	}
+	defer release()
+
+	w, err := corpus.Create(path, header)
+	if err != nil {
+		return header, err
+	}
+	for sender := 0; sender < header.Senders; sender++ {
+		client, err := newClient(factory, cfg, params)
+		if err != nil {
+			w.Close()
+			return header, fmt.Errorf("failed to create client of sender %d: %w", sender, err)
+		}
+		for i := 0; i < txsPerSender; i++ {
+			tx, err := client.GenerateTx()
+			if err == nil {
+				err = w.Write(tx)
+			}
+			if err != nil {
+				w.Close()
+				return header, fmt.Errorf("failed to generate transaction %d of sender %d: %w", i, sender, err)
+			}
+		}
+	}
+	return header, w.Close()
+}
@@ -0,0 +1,122 @@
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	"github.com/orijtech/cosmosloadtester/pkg/corpus"
+)
+
+// corpusClientFactory creates clients whose transactions are
+// "c<client>-t<index>", and signs for the chain corpus-chain.
+type corpusClientFactory struct {
+	clients atomic.Int64
+}
+
+func (*corpusClientFactory) ValidateConfig(loadtest.Config) error { return nil }
+
+func (f *corpusClientFactory) NewClient(loadtest.Config) (loadtest.Client, error) {
+	return &corpusClient{id: f.clients.Add(1) - 1}, nil
+}
+
+func (*corpusClientFactory) ChainID(ClientParams) (string, error) { return "corpus-chain", nil }
+
+type corpusClient struct {
+	id, txs int64
+}
+
+func (c *corpusClient) GenerateTx() ([]byte, error) {
+	c.txs++
+	return []byte(fmt.Sprintf("c%d-t%d", c.id, c.txs-1)), nil
+}
+
+// pooledCorpusFactory signs with a pool of two accounts.
+type pooledCorpusFactory struct {
+	corpusClientFactory
+}
+
+func (*pooledCorpusFactory) PoolSize(ClientParams) (int, error) { return 2, nil }
+
+func init() {
+	if err := RegisterClientFactory("test-corpus", &corpusClientFactory{}); err != nil {
+		panic(err)
+	}
+	if err := RegisterClientFactory("test-corpus-pool", &pooledCorpusFactory{}); err != nil {
+		panic(err)
+	}
+}
+
+func TestGenerateCorpus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	cfg := loadtest.Config{ClientFactory: "test-corpus", Endpoints: []string{"ws://a", "ws://b"}, Connections: 2}
+	header, err := GenerateCorpus(cfg, nil, FundingConfig{}, path, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.ChainID != "corpus-chain" || header.Factory != "test-corpus" || header.Senders != 4 || header.TxsPerSender != 3 {
+		t.Errorf("GenerateCorpus() header = %+v", header)
+	}
+
+	read, err := corpus.ReadHeader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ChainID != header.ChainID || read.Senders != header.Senders {
+		t.Errorf("corpus header = %+v, want %+v", read, header)
+	}
+	// Each sender holds the transactions of one client
+	for sender := 0; sender < 4; sender++ {
+		r, err := corpus.OpenSender(path, sender)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var client int
+		for i := 0; i < 3; i++ {
+			tx, err := r.Next()
+			if err != nil {
+				t.Fatalf("sender %d, transaction %d: %v", sender, i, err)
+			}
+			var gotClient, gotIndex int
+			if _, err := fmt.Sscanf(string(tx), "c%d-t%d", &gotClient, &gotIndex); err != nil {
+				t.Fatal(err)
+			}
+			if i == 0 {
+				client = gotClient
+			}
+			if gotClient != client || gotIndex != i {
+				t.Errorf("sender %d, transaction %d = %s, want transaction %d of client %d", sender, i, tx, i, client)
+			}
+		}
+		if _, err := r.Next(); err != io.EOF {
+			t.Errorf("sender %d: Next() after the last transaction = %v, want io.EOF", sender, err)
+		}
+		r.Close()
+	}
+}
+
+func TestGenerateCorpusChecks(t *testing.T) {
+	tests := []struct {
+		name        string
+		factory     string
+		params      ClientParams
+		connections int
+		wantErr     bool
+	}{
+		{"unknown factory", "not-registered", nil, 1, true},
+		{"parameters for a factory without any", "test-corpus", ClientParams{"denom": "stake"}, 1, true},
+		{"an account per sender", "test-corpus-pool", nil, 2, false},
+		// Senders sharing an account would use up the same sequences
+		{"fewer accounts than senders", "test-corpus-pool", nil, 3, true},
+	}
+	for _, tt := range tests {
+		cfg := loadtest.Config{ClientFactory: tt.factory, Endpoints: []string{"ws://a"}, Connections: tt.connections}
+		if _, err := GenerateCorpus(cfg, tt.params, FundingConfig{}, filepath.Join(t.TempDir(), "corpus.bin"), 1); (err != nil) != tt.wantErr {
+			t.Errorf("%s: GenerateCorpus() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package loadtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+// FundingConfig configures the funding of sender accounts before a load test.
+type FundingConfig struct {
+	// The coins to send to each sender account, e.g. 1000000uaiw. Funding is
+	// off if empty.
+	Amount string
+	// Whether to send the balances of the sender accounts back to the faucet
+	// account after the load test.
+	Sweep bool
+	// How long to wait for the funding and sweep transactions to be included
+	// in a block. Defaults to DefaultConfirmationTimeout.
+	Timeout time.Duration
+}
+
+// Enabled reports whether sender accounts are to be funded.
+func (c FundingConfig) Enabled() bool {
+	return c.Amount != ""
+}
+
+// AccountFunder is implemented by client factories whose clients sign with
+// accounts that can be funded from a faucet account before a load test.
+type AccountFunder interface {
+	// FundAccounts funds one sender account per connection of the load test
+	// and waits until the funds are included in a block. The clients the
+	// factory creates afterwards sign with the sender accounts, until the
+	// returned teardown is called. The parameters are those of the load test.
+	FundAccounts(cfg loadtest.Config, params ClientParams, funding FundingConfig) (Teardown, error)
+}
+
+// Teardown undoes what was set up before a load test. It is called once the
+// load test is over.
+type Teardown func() error
+
+// FundAccounts funds the sender accounts of the load test's client factory,
+// if funding is enabled. The returned teardown, which sweeps the balances back
+// if configured, is never nil.
+func FundAccounts(cfg loadtest.Config, params ClientParams, funding FundingConfig) (Teardown, error) {
+	if !funding.Enabled() {
+		return func() error { return nil }, nil
+	}
+	if funding.Timeout <= 0 {
+		funding.Timeout = DefaultConfirmationTimeout
+	}
+
+	factory, ok := GetClientFactory(cfg.ClientFactory)
+	if !ok {
+		return nil, fmt.Errorf("client factory %q is not registered", cfg.ClientFactory)
+	}
+	funder, ok := factory.(AccountFunder)
+	if !ok {
+		return nil, fmt.Errorf("client factory %q does not support account funding", cfg.ClientFactory)
+	}
+	return funder.FundAccounts(cfg, params, funding)
+}
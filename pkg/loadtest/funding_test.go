@@ -0,0 +1,32 @@
+package loadtest
+
+import (
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+func TestFundAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		factory string
+		funding FundingConfig
+		wantErr bool
+	}{
+		{"funding off", "not-registered", FundingConfig{}, false},
+		{"unknown factory", "not-registered", FundingConfig{Amount: "1000uaiw"}, true},
+		{"factory without funding", "test-http-transactor", FundingConfig{Amount: "1000uaiw"}, true},
+	}
+	for _, tt := range tests {
+		teardown, err := FundAccounts(loadtest.Config{ClientFactory: tt.factory}, nil, tt.funding)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: FundAccounts() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil {
+			if err := teardown(); err != nil {
+				t.Errorf("%s: teardown() = %v, want nil", tt.name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+// TxOutcome classifies the result of broadcasting a transaction.
+type TxOutcome int
+
+const (
+	// The node accepted the transaction.
+	TxAccepted TxOutcome = iota
+	// The node rejected the transaction with a non-zero code, in CheckTx or,
+	// for broadcast_tx_commit, in DeliverTx.
+	TxRejected
+	// The node answered with a JSON-RPC error.
+	TxRPCError
+	// The request failed with an HTTP error status or a connection error.
+	TxHTTPError
+	// No response arrived in time.
+	TxTimeout
+)
+
+func (o TxOutcome) String() string {
+	switch o {
+	case TxAccepted:
+		return "accepted"
+	case TxRejected:
+		return "rejected"
+	case TxRPCError:
+		return "rpc_error"
+	case TxHTTPError:
+		return "http_error"
+	case TxTimeout:
+		return "timeout"
+	}
+	return "unknown"
+}
+
+// classifyBroadcast returns the outcome of a broadcast and, if the node
+// rejected the transaction, the code and codespace it was rejected with.
+func classifyBroadcast(res *httprpc.BroadcastTxResponse, err error) (TxOutcome, int, string) {
+	if err != nil {
+		var rpcErr *httprpc.JSONRPCError
+		var netErr net.Error
+		switch {
+		case errors.As(err, &rpcErr):
+			return TxRPCError, 0, ""
+		case errors.Is(err, httprpc.ErrTimeout), errors.Is(err, context.DeadlineExceeded),
+			errors.As(err, &netErr) && netErr.Timeout():
+			return TxTimeout, 0, ""
+		default:
+			return TxHTTPError, 0, ""
+		}
+	}
+	if code, codespace := res.RejectionCode(); code != 0 {
+		return TxRejected, code, codespace
+	}
+	return TxAccepted, 0, ""
+}
+
+// RejectionHandler is implemented by clients that need to know when the node
+// rejects one of their transactions, e.g. to bring account sequences back in
+// sync. Transactors call it from the goroutine that calls GenerateTx. Only
+// synchronous broadcast methods report rejections.
+type RejectionHandler interface {
+	HandleRejection(code int, codespace, log string)
+}
+
+// OutcomeCount is the number of transactions with the same outcome and, for
+// rejected transactions, the same code and codespace.
+type OutcomeCount struct {
+	Outcome   TxOutcome
+	Code      int
+	Codespace string
+	Count     int
+}
+
+// countOutcomes tallies the outcomes of the given samples, most frequent first.
+func countOutcomes(samples []TxSample) []*OutcomeCount {
+	type key struct {
+		outcome   TxOutcome
+		code      int
+		codespace string
+	}
+	counts := make(map[key]*OutcomeCount)
+	var ret []*OutcomeCount
+	for _, sample := range samples {
+		k := key{sample.Outcome, sample.Code, sample.Codespace}
+		count, ok := counts[k]
+		if !ok {
+			count = &OutcomeCount{Outcome: sample.Outcome, Code: sample.Code, Codespace: sample.Codespace}
+			counts[k] = count
+			ret = append(ret, count)
+		}
+		count.Count++
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		if ret[i].Outcome != ret[j].Outcome {
+			return ret[i].Outcome < ret[j].Outcome
+		}
+		if ret[i].Codespace != ret[j].Codespace {
+			return ret[i].Codespace < ret[j].Codespace
+		}
+		return ret[i].Code < ret[j].Code
+	})
+	return ret
+}
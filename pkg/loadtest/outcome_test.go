@@ -0,0 +1,142 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyBroadcast(t *testing.T) {
+	tests := []struct {
+		name          string
+		res           *httprpc.BroadcastTxResponse
+		err           error
+		wantOutcome   TxOutcome
+		wantCode      int
+		wantCodespace string
+	}{
+		{
+			name:        "accepted",
+			res:         &httprpc.BroadcastTxResponse{Hash: "ABCD"},
+			wantOutcome: TxAccepted,
+		},
+		{
+			name:          "rejected in CheckTx",
+			res:           &httprpc.BroadcastTxResponse{Code: 32, Codespace: "sdk"},
+			wantOutcome:   TxRejected,
+			wantCode:      32,
+			wantCodespace: "sdk",
+		},
+		{
+			name: "commit rejected in CheckTx",
+			res: &httprpc.BroadcastTxResponse{
+				CheckTx: &httprpc.TxResult{Code: 13, Codespace: "sdk"},
+			},
+			wantOutcome:   TxRejected,
+			wantCode:      13,
+			wantCodespace: "sdk",
+		},
+		{
+			name: "commit rejected in DeliverTx",
+			res: &httprpc.BroadcastTxResponse{
+				CheckTx:   &httprpc.TxResult{},
+				DeliverTx: &httprpc.TxResult{Code: 5, Codespace: "bank"},
+			},
+			wantOutcome:   TxRejected,
+			wantCode:      5,
+			wantCodespace: "bank",
+		},
+		{
+			name: "commit accepted",
+			res: &httprpc.BroadcastTxResponse{
+				CheckTx:   &httprpc.TxResult{},
+				DeliverTx: &httprpc.TxResult{},
+			},
+			wantOutcome: TxAccepted,
+		},
+		{
+			name:        "JSON-RPC error",
+			err:         fmt.Errorf("broadcast: %w", &httprpc.JSONRPCError{Code: -32603, Message: "mempool is full"}),
+			wantOutcome: TxRPCError,
+		},
+		{
+			name:        "HTTP error",
+			err:         &httprpc.HTTPError{StatusCode: 503, Status: "503 Service Unavailable"},
+			wantOutcome: TxHTTPError,
+		},
+		{
+			name:        "connection error",
+			err:         errors.New("connection refused"),
+			wantOutcome: TxHTTPError,
+		},
+		{
+			name:        "no response in time",
+			err:         fmt.Errorf("broadcast: %w", httprpc.ErrTimeout),
+			wantOutcome: TxTimeout,
+		},
+		{
+			name:        "deadline exceeded",
+			err:         context.DeadlineExceeded,
+			wantOutcome: TxTimeout,
+		},
+		{
+			name:        "network timeout",
+			err:         fmt.Errorf("Post: %w", timeoutError{}),
+			wantOutcome: TxTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome, code, codespace := classifyBroadcast(tt.res, tt.err)
+			if outcome != tt.wantOutcome || code != tt.wantCode || codespace != tt.wantCodespace {
+				t.Errorf("classifyBroadcast() = %s %d %q, want %s %d %q",
+					outcome, code, codespace, tt.wantOutcome, tt.wantCode, tt.wantCodespace)
+			}
+		})
+	}
+}
+
+func TestCountOutcomes(t *testing.T) {
+	samples := []TxSample{
+		{Outcome: TxRejected, Code: 5, Codespace: "sdk"},
+		{Outcome: TxAccepted},
+		{Outcome: TxTimeout},
+		{Outcome: TxRejected, Code: 32, Codespace: "sdk"},
+		{Outcome: TxAccepted},
+		{Outcome: TxRejected, Code: 32, Codespace: "sdk"},
+		{Outcome: TxAccepted},
+		{Outcome: TxRejected, Code: 5, Codespace: "bank"},
+	}
+	want := []OutcomeCount{
+		{Outcome: TxAccepted, Count: 3},
+		// Ties are ordered by outcome, codespace and code
+		{Outcome: TxRejected, Code: 32, Codespace: "sdk", Count: 2},
+		{Outcome: TxRejected, Code: 5, Codespace: "bank", Count: 1},
+		{Outcome: TxRejected, Code: 5, Codespace: "sdk", Count: 1},
+		{Outcome: TxTimeout, Count: 1},
+	}
+
+	got := countOutcomes(samples)
+	if len(got) != len(want) {
+		t.Fatalf("countOutcomes() returned %d counts, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if *got[i] != want[i] {
+			t.Errorf("count %d = %+v, want %+v", i, *got[i], want[i])
+		}
+	}
+
+	if got := countOutcomes(nil); len(got) != 0 {
+		t.Errorf("countOutcomes(nil) = %v, want none", got)
+	}
+}
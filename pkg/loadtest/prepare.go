@@ -0,0 +1,32 @@
+package loadtest
+
+import (
+	"fmt"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+// Preparer is implemented by client factories that keep state for the clients
+// of a load test, such as how far they got through their transactions or the
+// resources they hold.
+type Preparer interface {
+	// Prepare is called before the clients of a load test are created, and
+	// starts the factory's state over. The returned teardown releases what
+	// the clients created afterwards hold. The parameters are those of the
+	// load test.
+	Prepare(cfg loadtest.Config, params ClientParams) (Teardown, error)
+}
+
+// Prepare prepares the load test's client factory for a new load test, if it
+// keeps state for its clients. The returned teardown is never nil.
+func Prepare(cfg loadtest.Config, params ClientParams) (Teardown, error) {
+	factory, ok := GetClientFactory(cfg.ClientFactory)
+	if !ok {
+		return nil, fmt.Errorf("client factory %q is not registered", cfg.ClientFactory)
+	}
+	preparer, ok := factory.(Preparer)
+	if !ok {
+		return func() error { return nil }, nil
+	}
+	return preparer.Prepare(cfg, params)
+}
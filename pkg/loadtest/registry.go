@@ -0,0 +1,54 @@
+package loadtest
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+// tm-load-test keeps its client factory registry private, so we keep our own
+// copy of every registered factory for transactors that generate transactions
+// themselves (e.g. the HTTP transactor).
+var (
+	clientFactoriesMtx sync.RWMutex
+	clientFactories    = make(map[string]loadtest.ClientFactory)
+)
+
+// RegisterClientFactory registers the given client factory with tm-load-test
+// and makes it available through GetClientFactory.
+func RegisterClientFactory(name string, factory loadtest.ClientFactory) error {
+	clientFactoriesMtx.Lock()
+	defer clientFactoriesMtx.Unlock()
+
+	if _, exists := clientFactories[name]; exists {
+		return fmt.Errorf("client factory with the specified name already exists: %s", name)
+	}
+	if err := loadtest.RegisterClientFactory(name, factory); err != nil {
+		return err
+	}
+	clientFactories[name] = factory
+	return nil
+}
+
+// GetClientFactory returns the client factory registered under the given name.
+func GetClientFactory(name string) (loadtest.ClientFactory, bool) {
+	clientFactoriesMtx.RLock()
+	defer clientFactoriesMtx.RUnlock()
+	factory, ok := clientFactories[name]
+	return factory, ok
+}
+
+// ClientFactoryNames returns the names of all registered client factories in
+// alphabetical order.
+func ClientFactoryNames() []string {
+	clientFactoriesMtx.RLock()
+	defer clientFactoriesMtx.RUnlock()
+	names := make([]string, 0, len(clientFactories))
+	for name := range clientFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,221 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SearchMode selects how a saturation search picks the rates it tries.
+type SearchMode string
+
+const (
+	// SearchStep tries Start, Start+Step, Start+2*Step and so on, until a
+	// rate breaks the SLO or Max is reached.
+	SearchStep SearchMode = "step"
+	// SearchBinary tries Start and Max, then halves the range between the
+	// highest rate that met the SLO and the lowest that broke it, until it is
+	// no wider than Step.
+	SearchBinary SearchMode = "binary"
+)
+
+// ParseSearchMode parses step or binary.
+func ParseSearchMode(s string) (SearchMode, error) {
+	switch s {
+	case "", string(SearchStep):
+		return SearchStep, nil
+	case string(SearchBinary):
+		return SearchBinary, nil
+	}
+	return SearchStep, fmt.Errorf("unsupported search mode: %s (supported: step, binary)", s)
+}
+
+// SLO is what a rate must achieve for the node not to count as saturated.
+// Zero fields are not checked.
+type SLO struct {
+	// The highest acceptable P99 broadcast latency
+	MaxP99Latency time.Duration
+	// The highest acceptable fraction of failed transactions, from 0 to 1
+	MaxErrorRate float64
+	// The lowest acceptable ratio of committed TPS to sent TPS, from 0 to 1
+	MinCommitRatio float64
+}
+
+// Validate checks the SLO.
+func (s SLO) Validate() error {
+	if s.MaxP99Latency < 0 {
+		return fmt.Errorf("max p99 latency must be >= 0")
+	}
+	if s.MaxErrorRate < 0 || s.MaxErrorRate > 1 {
+		return fmt.Errorf("max error rate must be between 0 and 1")
+	}
+	if s.MinCommitRatio < 0 || s.MinCommitRatio > 1 {
+		return fmt.Errorf("min commit ratio must be between 0 and 1")
+	}
+	if s.MaxP99Latency == 0 && s.MaxErrorRate == 0 && s.MinCommitRatio == 0 {
+		return fmt.Errorf("at least one of max p99 latency, max error rate and min commit ratio is required")
+	}
+	return nil
+}
+
+// SaturationStep is what one rate of a saturation search achieved.
+type SaturationStep struct {
+	// The rate tried, in transactions per second per connection
+	Rate       int           `json:"rate"`
+	SentTPS    float64       `json:"sent_tps"`
+	P99Latency time.Duration `json:"p99_latency"`
+	// The fraction of transactions that failed, from 0 to 1
+	ErrorRate float64 `json:"error_rate"`
+	// The TPS the chain committed, -1 if the chain could not be polled
+	CommittedTPS float64 `json:"committed_tps"`
+	// How the step broke the SLO, empty if it met it
+	Violations []string `json:"violations,omitempty"`
+}
+
+// NewSaturationStep sums up the stats of a load test at the given rate.
+// chain may be nil.
+func NewSaturationStep(rate int, stats *Stats, chain *ChainStats) *SaturationStep {
+	step := &SaturationStep{
+		Rate:         rate,
+		SentTPS:      stats.AvgTxsPerSecond,
+		CommittedTPS: -1,
+	}
+	if stats.LatencyRankings != nil {
+		step.P99Latency = stats.LatencyRankings.P99.Latency
+	}
+	if stats.TotalTxs > 0 {
+		step.ErrorRate = float64(stats.FailedTxs) / float64(stats.TotalTxs)
+	}
+	if chain != nil {
+		step.CommittedTPS = chain.CommittedTxsPerSecond
+	}
+	return step
+}
+
+// OK reports whether the step met the SLO.
+func (s *SaturationStep) OK() bool {
+	return len(s.Violations) == 0
+}
+
+// check records how the step broke the SLO.
+func (s *SaturationStep) check(slo SLO) {
+	s.Violations = nil
+	if s.SentTPS == 0 {
+		s.Violations = append(s.Violations, "no transactions were sent")
+		return
+	}
+	if slo.MaxP99Latency > 0 && s.P99Latency > slo.MaxP99Latency {
+		s.Violations = append(s.Violations, fmt.Sprintf("p99 latency %s > %s", s.P99Latency.Round(time.Millisecond), slo.MaxP99Latency))
+	}
+	if slo.MaxErrorRate > 0 && s.ErrorRate > slo.MaxErrorRate {
+		s.Violations = append(s.Violations, fmt.Sprintf("error rate %.2f%% > %.2f%%", s.ErrorRate*100, slo.MaxErrorRate*100))
+	}
+	if slo.MinCommitRatio > 0 {
+		if s.CommittedTPS < 0 {
+			s.Violations = append(s.Violations, "committed TPS unknown, the chain could not be polled")
+		} else if ratio := s.CommittedTPS / s.SentTPS; ratio < slo.MinCommitRatio {
+			s.Violations = append(s.Violations, fmt.Sprintf("committed/sent TPS %.2f < %.2f", ratio, slo.MinCommitRatio))
+		}
+	}
+}
+
+// SaturationSearch looks for the highest rate at which the node meets an
+// SLO, its knee.
+type SaturationSearch struct {
+	Mode SearchMode
+	// The first rate tried, the step between rates, or the resolution of a
+	// binary search, and the highest rate tried, in transactions per second
+	// per connection
+	Start int
+	Step  int
+	Max   int
+	SLO   SLO
+}
+
+// Validate checks the search.
+func (s SaturationSearch) Validate() error {
+	if _, err := ParseSearchMode(string(s.Mode)); err != nil {
+		return err
+	}
+	if s.Start <= 0 {
+		return fmt.Errorf("start rate must be > 0")
+	}
+	if s.Step <= 0 {
+		return fmt.Errorf("rate step must be > 0")
+	}
+	if s.Max < s.Start {
+		return fmt.Errorf("max rate must be >= the start rate")
+	}
+	return s.SLO.Validate()
+}
+
+// SaturationResult is the outcome of a saturation search.
+type SaturationResult struct {
+	// The highest rate that met the SLO, 0 if even the start rate broke it
+	Knee int `json:"knee"`
+	// The lowest rate that broke the SLO, 0 if none did up to the max rate
+	Breaking int `json:"breaking"`
+	// Every rate tried, in the order it was tried
+	Steps []*SaturationStep `json:"steps"`
+}
+
+// Run runs the search, calling run for a load test at every rate it tries.
+// It stops early, with the steps so far, if ctx is cancelled or run fails.
+func (s SaturationSearch) Run(ctx context.Context, run func(ctx context.Context, rate int) (*SaturationStep, error)) (*SaturationResult, error) {
+	result := &SaturationResult{}
+	try := func(rate int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		step, err := run(ctx, rate)
+		if err != nil {
+			return false, fmt.Errorf("rate %d: %w", rate, err)
+		}
+		// A step cut short is not a verdict on its rate
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		step.Rate = rate
+		step.check(s.SLO)
+		result.Steps = append(result.Steps, step)
+		if step.OK() {
+			result.Knee = rate
+		} else {
+			result.Breaking = rate
+		}
+		return step.OK(), nil
+	}
+
+	if s.Mode == SearchBinary {
+		ok, err := try(s.Start)
+		if err != nil || !ok || s.Max == s.Start {
+			return result, err
+		}
+		if ok, err = try(s.Max); err != nil || ok {
+			return result, err
+		}
+		lo, hi := s.Start, s.Max
+		for hi-lo > s.Step {
+			mid := lo + (hi-lo)/2
+			ok, err := try(mid)
+			if err != nil {
+				return result, err
+			}
+			if ok {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		result.Knee, result.Breaking = lo, hi
+		return result, nil
+	}
+
+	for rate := s.Start; rate <= s.Max; rate += s.Step {
+		ok, err := try(rate)
+		if err != nil || !ok {
+			return result, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,226 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// saturatingNode answers load tests like a node whose latency shoots up
+// above capacity transactions per second.
+func saturatingNode(capacity int, tried *[]int) func(ctx context.Context, rate int) (*SaturationStep, error) {
+	return func(ctx context.Context, rate int) (*SaturationStep, error) {
+		*tried = append(*tried, rate)
+		step := &SaturationStep{SentTPS: float64(rate), P99Latency: 10 * time.Millisecond, CommittedTPS: float64(rate)}
+		if rate > capacity {
+			step.P99Latency = time.Second
+		}
+		return step, nil
+	}
+}
+
+func TestSaturationSearchRun(t *testing.T) {
+	slo := SLO{MaxP99Latency: 100 * time.Millisecond}
+	tests := []struct {
+		name         string
+		search       SaturationSearch
+		capacity     int
+		wantTried    []int
+		wantKnee     int
+		wantBreaking int
+	}{
+		{
+			name:         "step",
+			search:       SaturationSearch{Mode: SearchStep, Start: 10, Step: 10, Max: 100, SLO: slo},
+			capacity:     45,
+			wantTried:    []int{10, 20, 30, 40, 50},
+			wantKnee:     40,
+			wantBreaking: 50,
+		},
+		{
+			name:         "step breaking at the capacity's next step",
+			search:       SaturationSearch{Mode: SearchStep, Start: 10, Step: 10, Max: 100, SLO: slo},
+			capacity:     40,
+			wantTried:    []int{10, 20, 30, 40, 50},
+			wantKnee:     40,
+			wantBreaking: 50,
+		},
+		{
+			name:      "step without saturation",
+			search:    SaturationSearch{Mode: SearchStep, Start: 10, Step: 30, Max: 100, SLO: slo},
+			capacity:  1000,
+			wantTried: []int{10, 40, 70, 100},
+			wantKnee:  100,
+		},
+		{
+			name:         "step saturated from the start",
+			search:       SaturationSearch{Mode: SearchStep, Start: 10, Step: 10, Max: 100, SLO: slo},
+			capacity:     5,
+			wantTried:    []int{10},
+			wantBreaking: 10,
+		},
+		{
+			name:         "binary",
+			search:       SaturationSearch{Mode: SearchBinary, Start: 10, Step: 5, Max: 100, SLO: slo},
+			capacity:     45,
+			wantTried:    []int{10, 100, 55, 32, 43, 49, 46},
+			wantKnee:     43,
+			wantBreaking: 46,
+		},
+		{
+			name:         "binary down to a resolution of 1",
+			search:       SaturationSearch{Mode: SearchBinary, Start: 1, Step: 1, Max: 64, SLO: slo},
+			capacity:     20,
+			wantTried:    []int{1, 64, 32, 16, 24, 20, 22, 21},
+			wantKnee:     20,
+			wantBreaking: 21,
+		},
+		{
+			name:      "binary without saturation",
+			search:    SaturationSearch{Mode: SearchBinary, Start: 10, Step: 5, Max: 100, SLO: slo},
+			capacity:  1000,
+			wantTried: []int{10, 100},
+			wantKnee:  100,
+		},
+		{
+			name:         "binary saturated from the start",
+			search:       SaturationSearch{Mode: SearchBinary, Start: 10, Step: 5, Max: 100, SLO: slo},
+			capacity:     5,
+			wantTried:    []int{10},
+			wantBreaking: 10,
+		},
+		{
+			name:      "binary with a single rate",
+			search:    SaturationSearch{Mode: SearchBinary, Start: 10, Step: 5, Max: 10, SLO: slo},
+			capacity:  1000,
+			wantTried: []int{10},
+			wantKnee:  10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.search.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			var tried []int
+			result, err := tt.search.Run(context.Background(), saturatingNode(tt.capacity, &tried))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tried, tt.wantTried) {
+				t.Errorf("tried %v, want %v", tried, tt.wantTried)
+			}
+			if result.Knee != tt.wantKnee || result.Breaking != tt.wantBreaking {
+				t.Errorf("knee, breaking point = %d, %d, want %d, %d", result.Knee, result.Breaking, tt.wantKnee, tt.wantBreaking)
+			}
+			if len(result.Steps) != len(tried) {
+				t.Fatalf("%d steps for %d rates tried", len(result.Steps), len(tried))
+			}
+			for i, step := range result.Steps {
+				if step.Rate != tried[i] {
+					t.Errorf("step %d has rate %d, want %d", i, step.Rate, tried[i])
+				}
+				if ok := step.Rate <= tt.capacity; step.OK() != ok {
+					t.Errorf("step at rate %d met the SLO: %t, want %t (%v)", step.Rate, step.OK(), ok, step.Violations)
+				}
+			}
+		})
+	}
+}
+
+func TestSaturationSearchRunStops(t *testing.T) {
+	search := SaturationSearch{Mode: SearchStep, Start: 10, Step: 10, Max: 100, SLO: SLO{MaxErrorRate: 0.01}}
+	failure := errors.New("node unreachable")
+
+	t.Run("run fails", func(t *testing.T) {
+		var tried []int
+		node := saturatingNode(1000, &tried)
+		result, err := search.Run(context.Background(), func(ctx context.Context, rate int) (*SaturationStep, error) {
+			if rate == 30 {
+				return nil, failure
+			}
+			return node(ctx, rate)
+		})
+		if !errors.Is(err, failure) {
+			t.Errorf("Run() = %v, want %v", err, failure)
+		}
+		if len(result.Steps) != 2 || result.Knee != 20 {
+			t.Errorf("%d steps with knee %d, want the 2 steps before the failure, with knee 20", len(result.Steps), result.Knee)
+		}
+	})
+
+	t.Run("cancelled during a step", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var tried []int
+		node := saturatingNode(1000, &tried)
+		result, err := search.Run(ctx, func(ctx context.Context, rate int) (*SaturationStep, error) {
+			if rate == 20 {
+				cancel()
+			}
+			return node(ctx, rate)
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run() = %v, want context.Canceled", err)
+		}
+		// The step cut short is left out
+		if len(result.Steps) != 1 || result.Knee != 10 {
+			t.Errorf("%d steps with knee %d, want 1 step with knee 10", len(result.Steps), result.Knee)
+		}
+	})
+}
+
+func TestSaturationStepCheck(t *testing.T) {
+	slo := SLO{MaxP99Latency: 100 * time.Millisecond, MaxErrorRate: 0.05, MinCommitRatio: 0.9}
+	tests := []struct {
+		name           string
+		step           SaturationStep
+		wantViolations int
+	}{
+		{"meets the SLO", SaturationStep{SentTPS: 100, P99Latency: 50 * time.Millisecond, ErrorRate: 0.01, CommittedTPS: 95}, 0},
+		{"at every threshold", SaturationStep{SentTPS: 100, P99Latency: 100 * time.Millisecond, ErrorRate: 0.05, CommittedTPS: 90}, 0},
+		{"slow", SaturationStep{SentTPS: 100, P99Latency: 200 * time.Millisecond, CommittedTPS: 100}, 1},
+		{"failing", SaturationStep{SentTPS: 100, ErrorRate: 0.2, CommittedTPS: 100}, 1},
+		{"not committing", SaturationStep{SentTPS: 100, CommittedTPS: 50}, 1},
+		{"chain not polled", SaturationStep{SentTPS: 100, CommittedTPS: -1}, 1},
+		{"breaking everything", SaturationStep{SentTPS: 100, P99Latency: time.Second, ErrorRate: 0.5, CommittedTPS: 10}, 3},
+		{"nothing sent", SaturationStep{}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step := tt.step
+			step.check(slo)
+			if len(step.Violations) != tt.wantViolations {
+				t.Errorf("violations = %q, want %d", step.Violations, tt.wantViolations)
+			}
+		})
+	}
+}
+
+func TestSaturationSearchValidate(t *testing.T) {
+	slo := SLO{MaxP99Latency: 100 * time.Millisecond}
+	tests := []struct {
+		name    string
+		search  SaturationSearch
+		wantErr bool
+	}{
+		{"step", SaturationSearch{Mode: SearchStep, Start: 10, Step: 10, Max: 100, SLO: slo}, false},
+		{"default mode", SaturationSearch{Start: 10, Step: 10, Max: 100, SLO: slo}, false},
+		{"binary at a single rate", SaturationSearch{Mode: SearchBinary, Start: 10, Step: 1, Max: 10, SLO: slo}, false},
+		{"unknown mode", SaturationSearch{Mode: "linear", Start: 10, Step: 10, Max: 100, SLO: slo}, true},
+		{"no start rate", SaturationSearch{Step: 10, Max: 100, SLO: slo}, true},
+		{"no step", SaturationSearch{Start: 10, Max: 100, SLO: slo}, true},
+		{"max below the start rate", SaturationSearch{Start: 10, Step: 10, Max: 5, SLO: slo}, true},
+		{"no SLO", SaturationSearch{Start: 10, Step: 10, Max: 100}, true},
+		{"negative latency", SaturationSearch{Start: 10, Step: 10, Max: 100, SLO: SLO{MaxP99Latency: -time.Second}}, true},
+		{"error rate above 1", SaturationSearch{Start: 10, Step: 10, Max: 100, SLO: SLO{MaxErrorRate: 1.5}}, true},
+		{"commit ratio above 1", SaturationSearch{Start: 10, Step: 10, Max: 100, SLO: SLO{MinCommitRatio: 2}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.search.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
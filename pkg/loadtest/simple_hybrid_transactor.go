@@ -2,22 +2,37 @@ package loadtest
 
 import (
 	"fmt"
+	"math"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
 	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+	"github.com/orijtech/cosmosloadtester/pkg/wsrpc"
 )
 
-// SimpleHybridTransactor is a simple wrapper that delegates to WebSocket or HTTP
+// broadcaster submits transactions to a Tendermint RPC endpoint and waits for
+// the node's response.
+type broadcaster interface {
+	BroadcastTx(method string, txBytes []byte) (*httprpc.BroadcastTxResponse, error)
+	Close() error
+}
+
+var (
+	_ broadcaster = (*httprpc.HTTPRPCClient)(nil)
+	_ broadcaster = (*wsrpc.WSRPCClient)(nil)
+)
+
+// SimpleHybridTransactor sends transactions over WebSocket or HTTP, recording
+// the latency of every broadcast.
 type SimpleHybridTransactor struct {
 	remoteAddr        string
 	protocol          string
 	config            *loadtest.Config
-	wsTransactor      *loadtest.Transactor
-	httpClient        *httprpc.HTTPRPCClient
+	rpcClient         broadcaster
 	logger            *logrus.Logger
 	broadcastTxMethod string
 	
@@ -27,17 +42,35 @@ type SimpleHybridTransactor struct {
 	txCount   int
 	txBytes   int64
 	txRate    float64
+	recorder  statsRecorder
 	
 	// Progress callback
 	progressCallbackMtx      sync.RWMutex
 	progressCallbackID       int
 	progressCallbackInterval time.Duration
 	progressCallback         func(id int, txCount int, txBytes int64)
+
+	// Inclusion tracking, nil unless confirmations are enabled
+	confirmation ConfirmationConfig
+	tracker      *confirmationTracker
+
+	// Parameters of the client factory
+	clientParams ClientParams
+
+	// When transactions are sent, and at which rate over time if there are
+	// stages
+	arrival ArrivalConfig
+	stages  Stages
 	
 	// Control
 	stopMtx sync.RWMutex
 	stop    bool
 	stopErr error
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+
+	// Number of transactions currently reserved against config.Count
+	txReserved int
 }
 
 // NewHybridTransactor creates a new hybrid transactor
@@ -61,22 +94,23 @@ func NewHybridTransactor(remoteAddr string, config *loadtest.Config) (*SimpleHyb
 		logger:                   logger,
 		broadcastTxMethod:        "broadcast_tx_" + config.BroadcastTxMethod,
 		progressCallbackInterval: 5 * time.Second,
+		stopCh:                   make(chan struct{}),
 	}
 
 	// Initialize based on protocol
 	switch protocol {
 	case "ws", "wss":
-		wsTransactor, err := loadtest.NewTransactor(remoteAddr, config)
+		wsClient, err := wsrpc.NewWSRPCClient(remoteAddr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create WebSocket transactor: %w", err)
+			return nil, fmt.Errorf("failed to create WebSocket RPC client: %w", err)
 		}
-		transactor.wsTransactor = wsTransactor
+		transactor.rpcClient = wsClient
 	case "http", "https":
 		httpClient, err := httprpc.NewHTTPRPCClient(remoteAddr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create HTTP RPC client: %w", err)
 		}
-		transactor.httpClient = httpClient
+		transactor.rpcClient = httpClient
 	}
 
 	logger.Infof("Created hybrid transactor for %s protocol", protocol)
@@ -90,66 +124,433 @@ func (t *SimpleHybridTransactor) SetProgressCallback(id int, interval time.Durat
 	t.progressCallbackID = id
 	t.progressCallbackInterval = interval
 	t.progressCallback = callback
-	
-	// Also set on WebSocket transactor if it exists
-	if t.wsTransactor != nil {
-		t.wsTransactor.SetProgressCallback(id, interval, callback)
-	}
+}
+
+// SetConfirmation enables tracking the inclusion of accepted transactions in
+// blocks. It must be called before Start.
+func (t *SimpleHybridTransactor) SetConfirmation(config ConfirmationConfig) {
+	t.confirmation = config
+}
+
+// SetClientParams sets the parameters the clients are created with. It must
+// be called before Start.
+func (t *SimpleHybridTransactor) SetClientParams(params ClientParams) {
+	t.clientParams = params
+}
+
+// SetArrival sets when transactions are sent. It must be called before
+// Start.
+func (t *SimpleHybridTransactor) SetArrival(config ArrivalConfig) {
+	t.arrival = config
+}
+
+// SetStages sets the stages the rate follows instead of config.Rate, over
+// config.Time. It must be called before Start.
+func (t *SimpleHybridTransactor) SetStages(stages Stages) {
+	t.stages = stages
 }
 
 // Start starts the transactor
 func (t *SimpleHybridTransactor) Start() {
 	t.logger.Info("Starting hybrid transactor")
-	
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		t.wsTransactor.Start()
+
+	if t.rpcClient == nil {
+		t.logger.Error("No RPC client available")
 		return
 	}
-	
-	// For HTTP, we need to handle this ourselves
-	if t.httpClient != nil {
-		t.statsMtx.Lock()
-		t.startTime = time.Now()
-		t.statsMtx.Unlock()
-		
-		// TODO: Implement HTTP transaction sending
-		// For now, just log that this is not fully implemented
-		t.logger.Warn("HTTP transaction sending not yet implemented - requires client factory access")
+
+	t.statsMtx.Lock()
+	t.startTime = time.Now()
+	t.statsMtx.Unlock()
+
+	factory, ok := GetClientFactory(t.config.ClientFactory)
+	if !ok {
+		t.setStop(fmt.Errorf("client factory %q is not registered", t.config.ClientFactory))
 		return
 	}
-	
-	t.logger.Error("No transactor or client available")
+
+	// One client per connection, as tm-load-test does.
+	clients := make([]loadtest.Client, 0, t.config.Connections)
+	for i := 0; i < t.config.Connections; i++ {
+		client, err := newClient(factory, *t.config, t.clientParams)
+		if err != nil {
+			t.setStop(fmt.Errorf("failed to create client for connection %d: %w", i, err))
+			return
+		}
+		clients = append(clients, client)
+	}
+
+	if t.confirmation.Mode != ConfirmNone {
+		tracker, err := newConfirmationTracker(t.remoteAddr, t.confirmation, &t.recorder, t.logger)
+		if err != nil {
+			t.setStop(fmt.Errorf("failed to track confirmations: %w", err))
+			return
+		}
+		t.tracker = tracker
+	}
+
+	t.wg.Add(1)
+	go t.run(clients)
 }
 
-// Cancel cancels the transactor
-func (t *SimpleHybridTransactor) Cancel() {
-	t.logger.Info("Cancelling hybrid transactor")
-	
+// run drives one send loop per client and reports progress until all of
+// them have finished.
+func (t *SimpleHybridTransactor) run(clients []loadtest.Client) {
+	defer t.wg.Done()
+
+	var workers sync.WaitGroup
+	for i, client := range clients {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			if t.arrival.OpenLoop() {
+				t.openLoop(i, client)
+			} else {
+				t.sendLoop(i, client)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+
+	progressTicker := time.NewTicker(t.getProgressCallbackInterval())
+	defer progressTicker.Stop()
+
+	for {
+		select {
+		case <-progressTicker.C:
+			t.reportProgress()
+		case <-done:
+			t.reportProgress()
+			return
+		}
+	}
+}
+
+// sendLoop sends a batch of up to config.Rate transactions every send period
+// until the time limit or transaction count is reached, or the transactor is
+// stopped.
+func (t *SimpleHybridTransactor) sendLoop(connID int, client loadtest.Client) {
+	sendPeriod := time.Duration(t.config.SendPeriod) * time.Second
+	if sendPeriod <= 0 {
+		sendPeriod = time.Second
+	}
+	sendTicker := time.NewTicker(sendPeriod)
+	defer sendTicker.Stop()
+
+	var timeLimit <-chan time.Time
+	if t.config.Time > 0 {
+		timer := time.NewTimer(time.Duration(t.config.Time) * time.Second)
+		defer timer.Stop()
+		timeLimit = timer.C
+	}
+
+	for {
+		if err := t.sendTransactions(connID, client, sendPeriod); err != nil {
+			t.logger.Errorf("Connection %d failed to send transactions: %v", connID, err)
+			t.setStop(err)
+			return
+		}
+		if t.countReached() {
+			t.logger.Debugf("Connection %d reached the maximum transaction count", connID)
+			return
+		}
+
+		select {
+		case <-sendTicker.C:
+		case <-timeLimit:
+			return
+		case <-t.stopCh:
+			return
+		}
+	}
+}
+
+// sendTransactions sends a single batch of transactions, as many as the rate
+// at its start, stopping early if the batch takes longer than the send period.
+func (t *SimpleHybridTransactor) sendTransactions(connID int, client loadtest.Client, sendPeriod time.Duration) error {
+	batchStart := time.Now()
+	batch := int(math.Round(t.rateAt(batchStart)))
+	for sent := 0; sent < batch; sent++ {
+		if t.mustStop() || !t.reserveTx() {
+			return nil
+		}
+
+		tx, txDue, err := generateTx(client)
+		if err != nil {
+			t.releaseTx()
+			return fmt.Errorf("failed to generate transaction: %w", err)
+		}
+		if !t.waitUntil(txDue) {
+			t.releaseTx()
+			return nil
+		}
+
+		t.broadcast(connID, client, tx, time.Time{})
+
+		// Make way for the next batch
+		if time.Since(batchStart) >= sendPeriod {
+			return nil
+		}
+	}
+	return nil
+}
+
+// openLoop sends transactions on the connection's arrival schedule until the
+// time limit or transaction count is reached, or the transactor is stopped.
+// Transactions are broadcast concurrently, up to the in-flight limit, so that
+// slow responses don't hold back the next ones. Each is only generated once
+// it has a slot, so that rejections of those before it are handled first.
+func (t *SimpleHybridTransactor) openLoop(connID int, client loadtest.Client) {
+	slots := make(chan struct{}, t.arrival.maxInFlight(client))
+	var inFlight sync.WaitGroup
+	defer inFlight.Wait()
+
+	start := time.Now()
+	var deadline time.Time
+	if t.config.Time > 0 {
+		deadline = start.Add(time.Duration(t.config.Time) * time.Second)
+	}
+	schedule := newArrivalSchedule(t.arrival, start.UnixNano()+int64(connID))
+
+	due := start
+	for {
+		rate := t.rateAt(due)
+		if rate <= 0 {
+			// Nothing is due until a stage picks the rate up
+			due = due.Add(idleStep)
+			if !deadline.IsZero() && due.After(deadline) {
+				return
+			}
+			continue
+		}
+		due = due.Add(schedule.gap(rate))
+		if !deadline.IsZero() && due.After(deadline) {
+			return
+		}
+		if !t.waitUntil(due) {
+			return
+		}
+		if t.mustStop() || !t.reserveTx() {
+			return
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-t.stopCh:
+			t.releaseTx()
+			return
+		}
+
+		tx, txDue, err := generateTx(client)
+		if err != nil {
+			<-slots
+			t.releaseTx()
+			err = fmt.Errorf("failed to generate transaction: %w", err)
+			t.logger.Errorf("Connection %d failed to send transactions: %v", connID, err)
+			t.setStop(err)
+			return
+		}
+		// Paced transactions are timed from when they are due, once the
+		// schedule lets them go, and the schedule carries on from then
+		if txDue.After(due) {
+			if (!deadline.IsZero() && txDue.After(deadline)) || !t.waitUntil(txDue) {
+				<-slots
+				t.releaseTx()
+				return
+			}
+			due = txDue
+		}
+		inFlight.Add(1)
+		go func(due time.Time) {
+			defer inFlight.Done()
+			defer func() { <-slots }()
+			t.broadcast(connID, client, tx, due)
+		}(due)
+	}
+}
+
+// broadcast sends a transaction and records its sample. Transactions that
+// were due at a time are timed from then, so that falling behind the
+// schedule counts towards their latency, and the others from when they are
+// sent. Failed broadcasts count as sent, so that their outcome is reported.
+func (t *SimpleHybridTransactor) broadcast(connID int, client loadtest.Client, tx []byte, due time.Time) {
+	sentAt := time.Now()
+	if due.IsZero() {
+		due = sentAt
+	}
+	res, err := t.rpcClient.BroadcastTx(t.broadcastTxMethod, tx)
+	latency := time.Since(due)
+	if err != nil {
+		t.logger.Debugf("Failed to broadcast transaction: %v", err)
+	}
+	outcome, code, codespace := classifyBroadcast(res, err)
+	if handler, ok := client.(RejectionHandler); ok && outcome == TxRejected {
+		handler.HandleRejection(code, codespace, res.RejectionLog())
+	}
+	sample := TxSample{
+		SentAt:     due,
+		Latency:    latency,
+		Delay:      sentAt.Sub(due),
+		Bytes:      len(tx),
+		Connection: connID,
+		Outcome:    outcome,
+		Code:       code,
+		Codespace:  codespace,
+	}
+	track := false
+	if t.tracker != nil && outcome == TxAccepted {
+		if height, err := strconv.ParseInt(res.Height, 10, 64); err == nil && height > 0 {
+			// broadcast_tx_commit only returns once the block is committed
+			sample.Inclusion = TxIncluded
+			sample.InclusionLatency = latency
+			sample.Height = height
+		} else if res.Hash != "" {
+			sample.Inclusion = TxPending
+			track = true
+		}
+	}
+	idx := t.recorder.record(sample)
+	if track {
+		t.tracker.track(idx, res.Hash, due)
+	}
+	t.trackSentTx(len(tx))
+}
+
+// waitUntil waits until the given time, and reports whether it came before
+// the transactor was stopped.
+func (t *SimpleHybridTransactor) waitUntil(at time.Time) bool {
+	wait := time.Until(at)
+	if wait <= 0 {
+		return true
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-t.stopCh:
+		return false
+	}
+}
+
+// rateAt returns the rate at the given time, that of the stage running then
+// if there are stages.
+func (t *SimpleHybridTransactor) rateAt(at time.Time) float64 {
+	if len(t.stages) == 0 {
+		return float64(t.config.Rate)
+	}
+	t.statsMtx.RLock()
+	startTime := t.startTime
+	t.statsMtx.RUnlock()
+	_, rate := t.stages.At(at.Sub(startTime))
+	return rate
+}
+
+// reserveTx reserves a slot for one more transaction against config.Count.
+// It returns false once the count has been used up.
+func (t *SimpleHybridTransactor) reserveTx() bool {
+	t.statsMtx.Lock()
+	defer t.statsMtx.Unlock()
+	if t.config.Count > 0 && t.txReserved >= t.config.Count {
+		return false
+	}
+	t.txReserved++
+	return true
+}
+
+// releaseTx gives back a reservation for a transaction that was not sent.
+func (t *SimpleHybridTransactor) releaseTx() {
+	t.statsMtx.Lock()
+	defer t.statsMtx.Unlock()
+	t.txReserved--
+}
+
+func (t *SimpleHybridTransactor) countReached() bool {
+	if t.config.Count <= 0 {
+		return false
+	}
+	return t.GetTxCount() >= t.config.Count
+}
+
+func (t *SimpleHybridTransactor) trackSentTx(txBytes int) {
+	t.statsMtx.Lock()
+	defer t.statsMtx.Unlock()
+	t.txCount++
+	t.txBytes += int64(txBytes)
+	if elapsed := time.Since(t.startTime).Seconds(); elapsed > 0 {
+		t.txRate = float64(t.txCount) / elapsed
+	}
+}
+
+func (t *SimpleHybridTransactor) getProgressCallbackInterval() time.Duration {
+	t.progressCallbackMtx.RLock()
+	defer t.progressCallbackMtx.RUnlock()
+	return t.progressCallbackInterval
+}
+
+func (t *SimpleHybridTransactor) reportProgress() {
+	t.progressCallbackMtx.RLock()
+	defer t.progressCallbackMtx.RUnlock()
+	if t.progressCallback != nil {
+		t.progressCallback(t.progressCallbackID, t.GetTxCount(), t.GetTxBytes())
+	}
+}
+
+func (t *SimpleHybridTransactor) setStop(err error) {
 	t.stopMtx.Lock()
-	t.stop = true
-	t.stopMtx.Unlock()
-	
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		t.wsTransactor.Cancel()
+	defer t.stopMtx.Unlock()
+	if t.stop {
 		return
 	}
-	
-	// For HTTP, we handle the cancellation ourselves
-	t.logger.Info("HTTP transactor cancelled")
+	t.stop = true
+	t.stopErr = err
+	close(t.stopCh)
+}
+
+func (t *SimpleHybridTransactor) mustStop() bool {
+	t.stopMtx.RLock()
+	defer t.stopMtx.RUnlock()
+	return t.stop
 }
 
-// Wait waits for the transactor to finish
+// Cancel cancels the transactor
+func (t *SimpleHybridTransactor) Cancel() {
+	t.logger.Info("Cancelling hybrid transactor")
+	t.setStop(nil)
+}
+
+// WaitSent waits for the transactor to stop sending, without waiting for the
+// transactions in flight to be included. Callers timing a load test call it
+// before Wait, which drains the confirmations.
+func (t *SimpleHybridTransactor) WaitSent() {
+	t.wg.Wait()
+}
+
+// Wait waits for the transactor to finish, including the confirmation of the
+// transactions in flight
 func (t *SimpleHybridTransactor) Wait() error {
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		return t.wsTransactor.Wait()
-	}
-	
-	// For HTTP, close the client
-	if t.httpClient != nil {
-		return t.httpClient.Close()
+	// Wait for the send loops and then close the client
+	if t.rpcClient != nil {
+		t.wg.Wait()
+		closeErr := t.rpcClient.Close()
+
+		// Give the transactions in flight a chance to be included
+		if t.tracker != nil {
+			if err := t.tracker.finish(); err != nil && closeErr == nil {
+				closeErr = err
+			}
+		}
+
+		t.stopMtx.RLock()
+		defer t.stopMtx.RUnlock()
+		if t.stopErr != nil {
+			return t.stopErr
+		}
+		return closeErr
 	}
 	
 	return nil
@@ -157,12 +558,6 @@ func (t *SimpleHybridTransactor) Wait() error {
 
 // GetTxCount returns the transaction count
 func (t *SimpleHybridTransactor) GetTxCount() int {
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		return t.wsTransactor.GetTxCount()
-	}
-	
-	// For HTTP, return our own stats
 	t.statsMtx.RLock()
 	defer t.statsMtx.RUnlock()
 	return t.txCount
@@ -170,12 +565,6 @@ func (t *SimpleHybridTransactor) GetTxCount() int {
 
 // GetTxBytes returns the transaction bytes
 func (t *SimpleHybridTransactor) GetTxBytes() int64 {
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		return t.wsTransactor.GetTxBytes()
-	}
-	
-	// For HTTP, return our own stats
 	t.statsMtx.RLock()
 	defer t.statsMtx.RUnlock()
 	return t.txBytes
@@ -183,13 +572,17 @@ func (t *SimpleHybridTransactor) GetTxBytes() int64 {
 
 // GetTxRate returns the transaction rate
 func (t *SimpleHybridTransactor) GetTxRate() float64 {
-	// For WebSocket, delegate to the original transactor
-	if t.wsTransactor != nil {
-		return t.wsTransactor.GetTxRate()
-	}
-	
-	// For HTTP, return our own stats
 	t.statsMtx.RLock()
 	defer t.statsMtx.RUnlock()
 	return t.txRate
-}
\ No newline at end of file
+}
+
+// GetTxSamples returns the timing of every transaction sent so far
+func (t *SimpleHybridTransactor) GetTxSamples() []TxSample {
+	return t.recorder.snapshot()
+}
+
+// GetTxSamplesSince returns the samples recorded after the first offset ones
+func (t *SimpleHybridTransactor) GetTxSamplesSince(offset int) []TxSample {
+	return t.recorder.snapshotSince(offset)
+}
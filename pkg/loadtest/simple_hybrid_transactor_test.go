@@ -0,0 +1,262 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+)
+
+type testClientFactory struct{}
+
+func (testClientFactory) ValidateConfig(loadtest.Config) error { return nil }
+
+func (testClientFactory) NewClient(loadtest.Config) (loadtest.Client, error) {
+	return testClient{}, nil
+}
+
+type testClient struct{}
+
+func (testClient) GenerateTx() ([]byte, error) { return []byte("test-tx"), nil }
+
+func init() {
+	if err := RegisterClientFactory("test-http-transactor", testClientFactory{}); err != nil {
+		panic(err)
+	}
+}
+
+// newTestNode starts a JSON-RPC server that accepts every broadcast and
+// counts the requests it receives per method.
+func newTestNode(t *testing.T, status int) (*httptest.Server, map[string]*atomic.Int64) {
+	t.Helper()
+	calls := map[string]*atomic.Int64{
+		"broadcast_tx_sync":  new(atomic.Int64),
+		"broadcast_tx_async": new(atomic.Int64),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if c, ok := calls[req.Method]; ok {
+			c.Add(1)
+		}
+		if status != http.StatusOK {
+			http.Error(w, "unavailable", status)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"code": 0, "hash": "ABCD"},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, calls
+}
+
+func TestHybridTransactorHTTPCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		connections int
+		rate        int
+		count       int
+		wantSent    int
+		wantCalls   int64
+	}{
+		{"single connection", "sync", 1, 10, 10, 10, 10},
+		{"count shared across connections", "sync", 4, 10, 25, 25, 25},
+		{"count smaller than rate", "async", 2, 100, 3, 3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, calls := newTestNode(t, http.StatusOK)
+			cfg := &loadtest.Config{
+				ClientFactory:     "test-http-transactor",
+				Connections:       tt.connections,
+				Time:              10,
+				SendPeriod:        1,
+				Rate:              tt.rate,
+				Count:             tt.count,
+				BroadcastTxMethod: tt.method,
+			}
+			tx, err := NewHybridTransactor(srv.URL, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tx.Start()
+			if err := tx.Wait(); err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if got := tx.GetTxCount(); got != tt.wantSent {
+				t.Errorf("GetTxCount() = %d, want %d", got, tt.wantSent)
+			}
+			if got := tx.GetTxBytes(); got != int64(tt.wantSent*len("test-tx")) {
+				t.Errorf("GetTxBytes() = %d, want %d", got, tt.wantSent*len("test-tx"))
+			}
+			if got := calls["broadcast_tx_"+tt.method].Load(); got != tt.wantCalls {
+				t.Errorf("%s calls = %d, want %d", tt.method, got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHybridTransactorHTTPFailures(t *testing.T) {
+	srv, calls := newTestNode(t, http.StatusServiceUnavailable)
+	cfg := &loadtest.Config{
+		ClientFactory:     "test-http-transactor",
+		Connections:       1,
+		Time:              10,
+		SendPeriod:        1,
+		Rate:              10,
+		Count:             4,
+		BroadcastTxMethod: "sync",
+	}
+	tx, err := NewHybridTransactor(srv.URL, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Start()
+	if err := tx.Wait(); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// Failed broadcasts count as sent, so that their outcome is reported
+	if got := calls["broadcast_tx_sync"].Load(); got != 4 {
+		t.Errorf("broadcast_tx_sync calls = %d, want 4", got)
+	}
+	samples := tx.GetTxSamples()
+	if len(samples) != 4 {
+		t.Fatalf("%d samples, want 4", len(samples))
+	}
+	for _, sample := range samples {
+		if sample.Outcome != TxHTTPError {
+			t.Errorf("sample outcome = %s, want %s", sample.Outcome, TxHTTPError)
+		}
+	}
+}
+
+func TestHybridTransactorHTTPCancel(t *testing.T) {
+	srv, _ := newTestNode(t, http.StatusOK)
+	cfg := &loadtest.Config{
+		ClientFactory:     "test-http-transactor",
+		Connections:       2,
+		Time:              60,
+		SendPeriod:        1,
+		Rate:              5,
+		BroadcastTxMethod: "sync",
+	}
+	tx, err := NewHybridTransactor(srv.URL, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Start()
+	tx.Cancel()
+
+	waited := make(chan error, 1)
+	go func() { waited <- tx.Wait() }()
+	select {
+	case err := <-waited:
+		if err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the transactor kept sending after it was cancelled")
+	}
+}
+
+func TestNewHybridTransactorProtocol(t *testing.T) {
+	tests := []struct {
+		addr    string
+		wantErr bool
+	}{
+		{"http://localhost:26657", false},
+		{"https://rpc.example.com", false},
+		{"tcp://localhost:26657", true},
+		{"localhost:26657", true},
+	}
+	for _, tt := range tests {
+		cfg := &loadtest.Config{BroadcastTxMethod: "sync"}
+		_, err := NewHybridTransactor(tt.addr, cfg)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewHybridTransactor(%q) error = %v, wantErr %v", tt.addr, err, tt.wantErr)
+		}
+	}
+}
+
+type rejectionClientFactory struct {
+	rejections chan string
+}
+
+func (rejectionClientFactory) ValidateConfig(loadtest.Config) error { return nil }
+
+func (f rejectionClientFactory) NewClient(loadtest.Config) (loadtest.Client, error) {
+	return rejectionClient{f.rejections}, nil
+}
+
+type rejectionClient struct {
+	rejections chan string
+}
+
+func (rejectionClient) GenerateTx() ([]byte, error) { return []byte("test-tx"), nil }
+
+func (c rejectionClient) HandleRejection(code int, codespace, log string) {
+	c.rejections <- fmt.Sprintf("%s/%d: %s", codespace, code, log)
+}
+
+func TestHybridTransactorHandleRejection(t *testing.T) {
+	factory := rejectionClientFactory{rejections: make(chan string, 10)}
+	if err := RegisterClientFactory("test-rejection-transactor", factory); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID int64 `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"code": 32, "codespace": "sdk", "log": "account sequence mismatch", "hash": "ABCD"},
+		})
+	}))
+	defer srv.Close()
+
+	cfg := &loadtest.Config{
+		ClientFactory:     "test-rejection-transactor",
+		Connections:       1,
+		Time:              10,
+		SendPeriod:        1,
+		Rate:              10,
+		Count:             2,
+		BroadcastTxMethod: "sync",
+	}
+	tx, err := NewHybridTransactor(srv.URL, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Start()
+	if err := tx.Wait(); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	close(factory.rejections)
+	var got []string
+	for rejection := range factory.rejections {
+		got = append(got, rejection)
+	}
+	want := []string{"sdk/32: account sequence mismatch", "sdk/32: account sequence mismatch"}
+	if !slices.Equal(got, want) {
+		t.Errorf("rejections = %q, want %q", got, want)
+	}
+}
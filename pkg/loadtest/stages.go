@@ -0,0 +1,168 @@
+package loadtest
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// StageShape is how the rate changes over a stage.
+type StageShape string
+
+const (
+	// StageHold keeps the rate at Rate.
+	StageHold StageShape = "hold"
+	// StageRamp changes the rate linearly from From to To.
+	StageRamp StageShape = "ramp"
+	// StageSpike steps the rate up to Rate, and back to the next stage's
+	// once it is over. It is a hold, marked as a spike.
+	StageSpike StageShape = "spike"
+	// StageSine swings the rate by Amplitude around Rate, once every Period.
+	StageSine StageShape = "sine"
+)
+
+// Stage is a stretch of a load test with a rate of its own, in transactions
+// per second per connection.
+type Stage struct {
+	// The name the stage is marked with in the per-second stats. Defaults
+	// to its number and shape, e.g. "2:ramp".
+	Name     string
+	Shape    StageShape
+	Duration time.Duration
+	// The rate of StageHold and StageSpike, and the mean rate of StageSine.
+	Rate int
+	// The rates StageRamp starts and ends at.
+	From int
+	To   int
+	// How far StageSine swings the rate either way, and how long a swing
+	// takes.
+	Amplitude int
+	Period    time.Duration
+}
+
+// Validate checks the stage.
+func (s Stage) Validate() error {
+	if s.Duration <= 0 {
+		return fmt.Errorf("duration must be > 0")
+	}
+	switch s.Shape {
+	case StageHold, StageSpike:
+		if s.Rate <= 0 {
+			return fmt.Errorf("rate must be > 0")
+		}
+	case StageRamp:
+		if s.From < 0 || s.To < 0 || s.From == 0 && s.To == 0 {
+			return fmt.Errorf("from and to must be >= 0, and not both 0")
+		}
+	case StageSine:
+		if s.Rate <= 0 {
+			return fmt.Errorf("rate must be > 0")
+		}
+		if s.Amplitude < 0 || s.Amplitude > s.Rate {
+			return fmt.Errorf("amplitude must be between 0 and the rate")
+		}
+		if s.Period <= 0 {
+			return fmt.Errorf("period must be > 0")
+		}
+	default:
+		return fmt.Errorf("unsupported shape: %s (supported: hold, ramp, spike, sine)", s.Shape)
+	}
+	return nil
+}
+
+// rateAt returns the rate elapsed into the stage.
+func (s Stage) rateAt(elapsed time.Duration) float64 {
+	switch s.Shape {
+	case StageRamp:
+		progress := float64(elapsed) / float64(s.Duration)
+		return float64(s.From) + progress*float64(s.To-s.From)
+	case StageSine:
+		phase := 2 * math.Pi * float64(elapsed) / float64(s.Period)
+		return float64(s.Rate) + float64(s.Amplitude)*math.Sin(phase)
+	default:
+		return float64(s.Rate)
+	}
+}
+
+// peakRate returns the highest rate of the stage.
+func (s Stage) peakRate() int {
+	switch s.Shape {
+	case StageRamp:
+		if s.From > s.To {
+			return s.From
+		}
+		return s.To
+	case StageSine:
+		return s.Rate + s.Amplitude
+	default:
+		return s.Rate
+	}
+}
+
+// Stages are the stages of a load test, run one after the other as one
+// continuous run.
+type Stages []Stage
+
+// Validate checks every stage.
+func (s Stages) Validate() error {
+	for i, stage := range s {
+		if err := stage.Validate(); err != nil {
+			return fmt.Errorf("stage %s: %w", s.Name(i), err)
+		}
+	}
+	return nil
+}
+
+// Duration returns the total duration of the stages.
+func (s Stages) Duration() time.Duration {
+	var total time.Duration
+	for _, stage := range s {
+		total += stage.Duration
+	}
+	return total
+}
+
+// PeakRate returns the highest rate of any stage, which client factories
+// validate the load test's rate against.
+func (s Stages) PeakRate() int {
+	peak := 0
+	for _, stage := range s {
+		if rate := stage.peakRate(); rate > peak {
+			peak = rate
+		}
+	}
+	return peak
+}
+
+// Name returns the name of the i-th stage.
+func (s Stages) Name(i int) string {
+	if s[i].Name != "" {
+		return s[i].Name
+	}
+	return fmt.Sprintf("%d:%s", i+1, s[i].Shape)
+}
+
+// At returns the index of the stage running elapsed into the load test, and
+// its rate then. Past the last stage, the index is -1 and the rate 0.
+func (s Stages) At(elapsed time.Duration) (int, float64) {
+	var start time.Duration
+	for i, stage := range s {
+		if elapsed < start+stage.Duration {
+			return i, math.Max(0, stage.rateAt(elapsed-start))
+		}
+		start += stage.Duration
+	}
+	return -1, 0
+}
+
+// Mark sets the stage of each second, the one running at its start.
+func (s Stages) Mark(perSecond []*PerSecondStats) {
+	if len(s) == 0 {
+		return
+	}
+	for _, ps := range perSecond {
+		if i, _ := s.At(time.Duration(ps.Sec) * time.Second); i >= 0 {
+			ps.Stage = s.Name(i)
+		}
+	}
+}
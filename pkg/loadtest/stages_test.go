@@ -0,0 +1,158 @@
+package loadtest
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var testStages = Stages{
+	{Shape: StageRamp, Duration: 10 * time.Second, From: 0, To: 100},
+	{Name: "plateau", Shape: StageHold, Duration: 5 * time.Second, Rate: 100},
+	{Shape: StageSpike, Duration: time.Second, Rate: 500},
+	{Shape: StageSine, Duration: 8 * time.Second, Rate: 100, Amplitude: 50, Period: 4 * time.Second},
+	{Shape: StageRamp, Duration: 2 * time.Second, From: 20, To: 0},
+}
+
+func TestStagesAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		wantStage int
+		wantRate  float64
+	}{
+		{"start of the ramp", 0, 0, 0},
+		{"middle of the ramp", 5 * time.Second, 0, 50},
+		{"just before the end of the ramp", 10*time.Second - time.Millisecond, 0, 99.99},
+		{"start of the hold", 10 * time.Second, 1, 100},
+		{"end of the hold", 15*time.Second - time.Nanosecond, 1, 100},
+		{"spike", 15 * time.Second, 2, 500},
+		{"start of the sine", 16 * time.Second, 3, 100},
+		{"sine peak", 17 * time.Second, 3, 150},
+		{"sine mean", 18 * time.Second, 3, 100},
+		{"sine trough", 19 * time.Second, 3, 50},
+		{"second sine period", 21 * time.Second, 3, 150},
+		{"ramp down", 25 * time.Second, 4, 10},
+		{"end of the stages", 26 * time.Second, -1, 0},
+		{"long past the stages", time.Hour, -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stage, rate := testStages.At(tt.elapsed)
+			if stage != tt.wantStage || math.Abs(rate-tt.wantRate) > 1e-6 {
+				t.Errorf("At(%s) = %d, %g, want %d, %g", tt.elapsed, stage, rate, tt.wantStage, tt.wantRate)
+			}
+		})
+	}
+}
+
+func TestStagesAtClampsNegativeRates(t *testing.T) {
+	// A sine of the full rate touches 0, which rounding can take below
+	stages := Stages{{Shape: StageSine, Duration: time.Minute, Rate: 10, Amplitude: 10, Period: 4 * time.Second}}
+	for elapsed := time.Duration(0); elapsed < time.Minute; elapsed += 100 * time.Millisecond {
+		if _, rate := stages.At(elapsed); rate < 0 {
+			t.Fatalf("At(%s) = %g, want >= 0", elapsed, rate)
+		}
+	}
+}
+
+func TestStagesMark(t *testing.T) {
+	tests := []struct {
+		name   string
+		stages Stages
+		secs   []int
+		want   []string
+	}{
+		{
+			name:   "every second within a stage",
+			stages: testStages,
+			secs:   []int{0, 9, 10, 14, 15, 16, 23, 24, 25},
+			want:   []string{"1:ramp", "1:ramp", "plateau", "plateau", "3:spike", "4:sine", "4:sine", "5:ramp", "5:ramp"},
+		},
+		{
+			name:   "seconds past the last stage",
+			stages: testStages,
+			secs:   []int{25, 26, 30},
+			want:   []string{"5:ramp", "", ""},
+		},
+		{
+			name:   "no stages",
+			stages: nil,
+			secs:   []int{0, 1},
+			want:   []string{"", ""},
+		},
+		{
+			name: "stages shorter than a second",
+			stages: Stages{
+				{Shape: StageHold, Duration: 500 * time.Millisecond, Rate: 1},
+				{Shape: StageHold, Duration: 700 * time.Millisecond, Rate: 2},
+				{Shape: StageHold, Duration: time.Second, Rate: 3},
+			},
+			secs: []int{0, 1, 2},
+			// Each second is marked with the stage running at its start
+			want: []string{"1:hold", "2:hold", "3:hold"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perSecond := make([]*PerSecondStats, len(tt.secs))
+			for i, sec := range tt.secs {
+				perSecond[i] = &PerSecondStats{Sec: sec}
+			}
+			tt.stages.Mark(perSecond)
+			for i, ps := range perSecond {
+				if ps.Stage != tt.want[i] {
+					t.Errorf("second %d is marked %q, want %q", ps.Sec, ps.Stage, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStagesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		stage   Stage
+		wantErr bool
+	}{
+		{"hold", Stage{Shape: StageHold, Duration: time.Second, Rate: 1}, false},
+		{"spike", Stage{Shape: StageSpike, Duration: time.Second, Rate: 1}, false},
+		{"ramp up from 0", Stage{Shape: StageRamp, Duration: time.Second, To: 10}, false},
+		{"ramp down to 0", Stage{Shape: StageRamp, Duration: time.Second, From: 10}, false},
+		{"sine", Stage{Shape: StageSine, Duration: time.Second, Rate: 10, Amplitude: 10, Period: time.Second}, false},
+		{"no duration", Stage{Shape: StageHold, Rate: 1}, true},
+		{"hold without a rate", Stage{Shape: StageHold, Duration: time.Second}, true},
+		{"ramp from 0 to 0", Stage{Shape: StageRamp, Duration: time.Second}, true},
+		{"negative ramp", Stage{Shape: StageRamp, Duration: time.Second, From: -1, To: 10}, true},
+		{"sine amplitude above the rate", Stage{Shape: StageSine, Duration: time.Second, Rate: 10, Amplitude: 11, Period: time.Second}, true},
+		{"sine without a period", Stage{Shape: StageSine, Duration: time.Second, Rate: 10}, true},
+		{"unknown shape", Stage{Shape: "square", Duration: time.Second, Rate: 1}, true},
+	}
+	for _, tt := range tests {
+		if err := (Stages{tt.stage}).Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestStagesDurationAndPeakRate(t *testing.T) {
+	tests := []struct {
+		name         string
+		stages       Stages
+		wantDuration time.Duration
+		wantPeak     int
+	}{
+		{"all shapes", testStages, 26 * time.Second, 500},
+		{"ramp down", Stages{{Shape: StageRamp, Duration: time.Second, From: 30, To: 10}}, time.Second, 30},
+		{"sine", Stages{{Shape: StageSine, Duration: time.Minute, Rate: 100, Amplitude: 40, Period: time.Second}}, time.Minute, 140},
+		{"no stages", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.stages.Duration(); got != tt.wantDuration {
+			t.Errorf("%s: Duration() = %s, want %s", tt.name, got, tt.wantDuration)
+		}
+		if got := tt.stages.PeakRate(); got != tt.wantPeak {
+			t.Errorf("%s: PeakRate() = %d, want %d", tt.name, got, tt.wantPeak)
+		}
+	}
+}
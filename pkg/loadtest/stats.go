@@ -0,0 +1,318 @@
+package loadtest
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TxSample records a single transaction broadcast.
+type TxSample struct {
+	// When the transaction was sent, or was due to be sent on an open-loop
+	// schedule.
+	SentAt time.Time
+	// The time between sending the transaction, or when it was due, and
+	// receiving the response.
+	Latency time.Duration
+	// How late the transaction was sent on an open-loop schedule, included
+	// in its latency.
+	Delay time.Duration
+	// The size of the transaction in bytes.
+	Bytes int
+	// The index of the connection the transaction was sent over.
+	Connection int
+	// How the broadcast went.
+	Outcome TxOutcome
+	// The code and codespace the transaction was rejected with, if it was.
+	Code      int
+	Codespace string
+	// Whether the transaction was seen in a block, when confirmations are
+	// tracked.
+	Inclusion TxInclusion
+	// The time between sending the transaction and seeing it in a block, and
+	// the height of the block, for included transactions.
+	InclusionLatency time.Duration
+	Height           int64
+}
+
+// Stats summarizes a set of transaction samples in the same shape as
+// tm-load-test's ExecuteStandaloneWithStats, along with the outcome of the
+// transactions and, when confirmations are tracked, their inclusion in blocks.
+type Stats struct {
+	TotalTxs          int
+	TotalTime         time.Duration
+	TotalBytes        int64
+	AvgTxsPerSecond   float64
+	AvgBytesPerSecond float64
+	PerSecond         []*PerSecondStats
+	AcceptedTxs       int
+	FailedTxs         int
+	Outcomes          []*OutcomeCount
+	IncludedTxs       int
+	DroppedTxs        int
+	// The fraction of tracked transactions that were included, from 0 to 1.
+	InclusionRate float64
+	// The latency percentiles over every broadcast, including failed ones.
+	LatencyRankings *Ranking
+	// The inclusion latency percentiles over all included transactions.
+	InclusionLatencyRankings *Ranking
+}
+
+// PerSecondStats holds the samples sent within one second of the run.
+type PerSecondStats struct {
+	// The ordinal number of the second, starting at 0.
+	Sec int
+	// The name of the stage running at the start of the second, when the
+	// load test has stages.
+	Stage string
+	QPS   int
+	Bytes int64
+	// The latency rankings include every broadcast, failed ones at the time
+	// until they failed.
+	LatencyRankings *Ranking
+	BytesRankings   *Ranking
+	AcceptedTxs     int
+	FailedTxs       int
+	Outcomes        []*OutcomeCount
+	IncludedTxs     int
+	DroppedTxs      int
+	// The inclusion latency rankings only include transactions seen in a block.
+	InclusionLatencyRankings *Ranking
+}
+
+// Ranking holds the percentiles of a per-second bucket.
+type Ranking struct {
+	P50 *Percentile
+	P75 *Percentile
+	P90 *Percentile
+	P95 *Percentile
+	P99 *Percentile
+}
+
+// Percentile is the sample found at a given percentile.
+type Percentile struct {
+	Latency time.Duration
+	Bytes   int
+	// When the sample was sent, for easy debugging.
+	AtStr string
+}
+
+// statsRecorder collects transaction samples from concurrent senders.
+type statsRecorder struct {
+	mtx     sync.Mutex
+	samples []TxSample
+}
+
+// record adds a sample and returns its index.
+func (r *statsRecorder) record(sample TxSample) int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.samples = append(r.samples, sample)
+	return len(r.samples) - 1
+}
+
+// setInclusion updates the inclusion of the sample at the given index.
+func (r *statsRecorder) setInclusion(idx int, inclusion TxInclusion, height int64, latency time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	sample := &r.samples[idx]
+	sample.Inclusion = inclusion
+	sample.Height = height
+	sample.InclusionLatency = latency
+}
+
+func (r *statsRecorder) snapshot() []TxSample {
+	return r.snapshotSince(0)
+}
+
+// snapshotSince returns the samples recorded after the first offset samples.
+func (r *statsRecorder) snapshotSince(offset int) []TxSample {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if offset >= len(r.samples) {
+		return nil
+	}
+	samples := make([]TxSample, len(r.samples)-offset)
+	copy(samples, r.samples[offset:])
+	return samples
+}
+
+// ComputeStats bucketizes the given samples by the second in which they were
+// sent, relative to startTime, and computes totals over totalTime.
+func ComputeStats(startTime time.Time, totalTime time.Duration, samples []TxSample) *Stats {
+	stats := &Stats{
+		TotalTxs:  len(samples),
+		TotalTime: totalTime,
+	}
+
+	buckets := make(map[int][]TxSample)
+	maxSec := -1
+	var included []TxSample
+	for _, sample := range samples {
+		stats.TotalBytes += int64(sample.Bytes)
+		if sample.Outcome == TxAccepted {
+			stats.AcceptedTxs++
+		} else {
+			stats.FailedTxs++
+		}
+		switch sample.Inclusion {
+		case TxIncluded:
+			stats.IncludedTxs++
+			included = append(included, sample)
+		case TxDropped:
+			stats.DroppedTxs++
+		}
+		sec := int(sample.SentAt.Sub(startTime) / time.Second)
+		if sec < 0 {
+			sec = 0
+		}
+		buckets[sec] = append(buckets[sec], sample)
+		if sec > maxSec {
+			maxSec = sec
+		}
+	}
+
+	if tracked := stats.IncludedTxs + stats.DroppedTxs; tracked > 0 {
+		stats.InclusionRate = float64(stats.IncludedTxs) / float64(tracked)
+	}
+	if len(samples) > 0 {
+		stats.LatencyRankings = rankLatencies(samples)
+	}
+	if len(included) > 0 {
+		sort.Slice(included, func(i, j int) bool { return included[i].InclusionLatency < included[j].InclusionLatency })
+		stats.InclusionLatencyRankings = rank(included, inclusionLatency)
+	}
+	if secs := totalTime.Seconds(); secs > 0 {
+		stats.AvgTxsPerSecond = float64(stats.TotalTxs) / secs
+		stats.AvgBytesPerSecond = float64(stats.TotalBytes) / secs
+	}
+
+	for sec := 0; sec <= maxSec; sec++ {
+		stats.PerSecond = append(stats.PerSecond, computePerSecond(sec, buckets[sec]))
+	}
+	stats.Outcomes = countOutcomes(samples)
+	return stats
+}
+
+// computePerSecond summarizes the samples sent within the given second.
+func computePerSecond(sec int, bucket []TxSample) *PerSecondStats {
+	perSec := &PerSecondStats{
+		Sec: sec,
+		QPS: len(bucket),
+	}
+	var included []TxSample
+	for _, sample := range bucket {
+		perSec.Bytes += int64(sample.Bytes)
+		if sample.Outcome == TxAccepted {
+			perSec.AcceptedTxs++
+		} else {
+			perSec.FailedTxs++
+		}
+		switch sample.Inclusion {
+		case TxIncluded:
+			perSec.IncludedTxs++
+			included = append(included, sample)
+		case TxDropped:
+			perSec.DroppedTxs++
+		}
+	}
+	perSec.Outcomes = countOutcomes(bucket)
+	if len(bucket) > 0 {
+		perSec.LatencyRankings = rankLatencies(bucket)
+	}
+	if len(included) > 0 {
+		sort.Slice(included, func(i, j int) bool { return included[i].InclusionLatency < included[j].InclusionLatency })
+		perSec.InclusionLatencyRankings = rank(included, inclusionLatency)
+	}
+	if len(bucket) > 0 {
+		sort.Slice(bucket, func(i, j int) bool { return bucket[i].Bytes < bucket[j].Bytes })
+		perSec.BytesRankings = rank(bucket, broadcastLatency)
+	}
+	return perSec
+}
+
+// LiveStats incrementally collects the samples of running transactors so that
+// per-second stats can be reported while a load test is still in progress.
+type LiveStats struct {
+	startTime   time.Time
+	transactors []TransactorInterface
+	cursors     []int
+	buckets     map[int][]TxSample
+	nextSec     int
+}
+
+// NewLiveStats creates a LiveStats for transactors started at startTime.
+func NewLiveStats(startTime time.Time, transactors []TransactorInterface) *LiveStats {
+	return &LiveStats{
+		startTime:   startTime,
+		transactors: transactors,
+		cursors:     make([]int, len(transactors)),
+		buckets:     make(map[int][]TxSample),
+	}
+}
+
+// Collect gathers the samples recorded since the previous call and returns the
+// stats of every second that has fully elapsed by now and was not returned
+// before. Responses that arrive after their second was returned are left out,
+// so these stats are provisional; ComputeStats gives the final figures.
+func (l *LiveStats) Collect(now time.Time) []*PerSecondStats {
+	for i, transactor := range l.transactors {
+		samples := transactor.GetTxSamplesSince(l.cursors[i])
+		l.cursors[i] += len(samples)
+		for _, sample := range samples {
+			sec := int(sample.SentAt.Sub(l.startTime) / time.Second)
+			if sec < l.nextSec {
+				continue
+			}
+			l.buckets[sec] = append(l.buckets[sec], sample)
+		}
+	}
+
+	var completed []*PerSecondStats
+	elapsedSecs := int(now.Sub(l.startTime) / time.Second)
+	for ; l.nextSec < elapsedSecs; l.nextSec++ {
+		completed = append(completed, computePerSecond(l.nextSec, l.buckets[l.nextSec]))
+		delete(l.buckets, l.nextSec)
+	}
+	return completed
+}
+
+// rankLatencies ranks the broadcast latencies of every sample. A broadcast
+// that failed without an answer counts with the time until it failed, so
+// timeouts show in the tail at the request timeout rather than being left
+// out of it.
+func rankLatencies(samples []TxSample) *Ranking {
+	sorted := append([]TxSample(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Latency < sorted[j].Latency })
+	return rank(sorted, broadcastLatency)
+}
+
+func broadcastLatency(sample TxSample) time.Duration { return sample.Latency }
+func inclusionLatency(sample TxSample) time.Duration { return sample.InclusionLatency }
+
+// rank picks the percentiles from samples that are already sorted, reporting
+// the latency returned by latencyOf.
+func rank(sorted []TxSample, latencyOf func(TxSample) time.Duration) *Ranking {
+	return &Ranking{
+		P50: percentile(sorted, 0.50, latencyOf),
+		P75: percentile(sorted, 0.75, latencyOf),
+		P90: percentile(sorted, 0.90, latencyOf),
+		P95: percentile(sorted, 0.95, latencyOf),
+		P99: percentile(sorted, 0.99, latencyOf),
+	}
+}
+
+// percentile uses the nearest-rank method.
+func percentile(sorted []TxSample, p float64, latencyOf func(TxSample) time.Duration) *Percentile {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	sample := sorted[idx]
+	return &Percentile{
+		Latency: latencyOf(sample),
+		Bytes:   sample.Bytes,
+		AtStr:   sample.SentAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,207 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	// Latencies of 1ms to 10ms
+	var sorted []TxSample
+	for i := 1; i <= 10; i++ {
+		sorted = append(sorted, TxSample{Latency: time.Duration(i) * time.Millisecond, Bytes: i})
+	}
+	tests := []struct {
+		name   string
+		sorted []TxSample
+		p      float64
+		want   time.Duration
+	}{
+		{"p0", sorted, 0, time.Millisecond},
+		{"p10", sorted, 0.10, time.Millisecond},
+		{"p50", sorted, 0.50, 5 * time.Millisecond},
+		{"p75", sorted, 0.75, 8 * time.Millisecond},
+		{"p90", sorted, 0.90, 9 * time.Millisecond},
+		{"p95", sorted, 0.95, 10 * time.Millisecond},
+		{"p99", sorted, 0.99, 10 * time.Millisecond},
+		{"p100", sorted, 1, 10 * time.Millisecond},
+		{"single sample", sorted[3:4], 0.99, 4 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := percentile(tt.sorted, tt.p, broadcastLatency)
+			if got.Latency != tt.want {
+				t.Errorf("percentile(%g) = %s, want %s", tt.p, got.Latency, tt.want)
+			}
+			if want := int(tt.want / time.Millisecond); got.Bytes != want {
+				t.Errorf("percentile(%g) has %d bytes, want those of its sample, %d", tt.p, got.Bytes, want)
+			}
+		})
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	samples := []TxSample{
+		// Sent before the start, counted in the first second
+		{SentAt: at(-100 * time.Millisecond), Latency: 4 * time.Millisecond, Bytes: 100, Outcome: TxAccepted, Inclusion: TxIncluded, InclusionLatency: time.Second},
+		{SentAt: at(100 * time.Millisecond), Latency: 2 * time.Millisecond, Bytes: 100, Outcome: TxAccepted, Inclusion: TxIncluded, InclusionLatency: 2 * time.Second},
+		{SentAt: at(900 * time.Millisecond), Latency: 30 * time.Millisecond, Bytes: 200, Outcome: TxRejected, Code: 32, Codespace: "sdk"},
+		{SentAt: at(1500 * time.Millisecond), Latency: 10 * time.Millisecond, Bytes: 300, Outcome: TxAccepted, Inclusion: TxDropped},
+		// Nothing is sent in the third second
+		{SentAt: at(3200 * time.Millisecond), Latency: time.Second, Bytes: 400, Outcome: TxTimeout},
+	}
+
+	stats := ComputeStats(start, 4*time.Second, samples)
+
+	if stats.TotalTxs != 5 || stats.TotalBytes != 1100 {
+		t.Errorf("totals = %d txs, %d bytes, want 5 txs, 1100 bytes", stats.TotalTxs, stats.TotalBytes)
+	}
+	if stats.AvgTxsPerSecond != 1.25 || stats.AvgBytesPerSecond != 275 {
+		t.Errorf("averages = %g tx/s, %g B/s, want 1.25 tx/s, 275 B/s", stats.AvgTxsPerSecond, stats.AvgBytesPerSecond)
+	}
+	if stats.AcceptedTxs != 3 || stats.FailedTxs != 2 || len(stats.Outcomes) != 3 {
+		t.Errorf("outcomes = %d accepted, %d failed in %d groups, want 3 accepted, 2 failed in 3 groups",
+			stats.AcceptedTxs, stats.FailedTxs, len(stats.Outcomes))
+	}
+	if stats.IncludedTxs != 2 || stats.DroppedTxs != 1 {
+		t.Errorf("inclusion = %d included, %d dropped, want 2 included, 1 dropped", stats.IncludedTxs, stats.DroppedTxs)
+	}
+	if want := 2.0 / 3; stats.InclusionRate != want {
+		t.Errorf("inclusion rate = %g, want %g", stats.InclusionRate, want)
+	}
+	if got := stats.LatencyRankings.P50.Latency; got != 10*time.Millisecond {
+		t.Errorf("p50 latency = %s, want 10ms", got)
+	}
+	if got := stats.LatencyRankings.P99.Latency; got != time.Second {
+		t.Errorf("p99 latency = %s, want the timeout's 1s", got)
+	}
+	if got := stats.InclusionLatencyRankings.P99.Latency; got != 2*time.Second {
+		t.Errorf("p99 inclusion latency = %s, want 2s", got)
+	}
+
+	wantSeconds := []struct {
+		qps, accepted, failed, included, dropped int
+		bytes                                    int64
+		// Zero if no transaction was sent
+		p50, p99 time.Duration
+	}{
+		{qps: 3, accepted: 2, failed: 1, included: 2, bytes: 400, p50: 4 * time.Millisecond, p99: 30 * time.Millisecond},
+		{qps: 1, accepted: 1, dropped: 1, bytes: 300, p50: 10 * time.Millisecond, p99: 10 * time.Millisecond},
+		{},
+		// Timeouts are ranked at the time until they failed
+		{qps: 1, failed: 1, bytes: 400, p50: time.Second, p99: time.Second},
+	}
+	if len(stats.PerSecond) != len(wantSeconds) {
+		t.Fatalf("%d seconds, want %d", len(stats.PerSecond), len(wantSeconds))
+	}
+	for i, want := range wantSeconds {
+		got := stats.PerSecond[i]
+		if got.Sec != i {
+			t.Errorf("second %d is numbered %d", i, got.Sec)
+		}
+		if got.QPS != want.qps || got.Bytes != want.bytes {
+			t.Errorf("second %d = %d txs, %d bytes, want %d txs, %d bytes", i, got.QPS, got.Bytes, want.qps, want.bytes)
+		}
+		if got.AcceptedTxs != want.accepted || got.FailedTxs != want.failed ||
+			got.IncludedTxs != want.included || got.DroppedTxs != want.dropped {
+			t.Errorf("second %d = %d accepted, %d failed, %d included, %d dropped, want %d, %d, %d, %d", i,
+				got.AcceptedTxs, got.FailedTxs, got.IncludedTxs, got.DroppedTxs,
+				want.accepted, want.failed, want.included, want.dropped)
+		}
+		if (got.BytesRankings != nil) != (want.qps > 0) {
+			t.Errorf("second %d has bytes rankings %v with %d transactions", i, got.BytesRankings, want.qps)
+		}
+		if want.p50 == 0 {
+			if got.LatencyRankings != nil {
+				t.Errorf("second %d has latency rankings without transactions", i)
+			}
+			continue
+		}
+		if got.LatencyRankings.P50.Latency != want.p50 || got.LatencyRankings.P99.Latency != want.p99 {
+			t.Errorf("second %d latency p50, p99 = %s, %s, want %s, %s", i,
+				got.LatencyRankings.P50.Latency, got.LatencyRankings.P99.Latency, want.p50, want.p99)
+		}
+	}
+	if got := stats.PerSecond[0].BytesRankings.P99.Bytes; got != 200 {
+		t.Errorf("second 0 p99 bytes = %d, want 200", got)
+	}
+}
+
+func TestComputeStatsEmpty(t *testing.T) {
+	stats := ComputeStats(time.Now(), 0, nil)
+	if stats.TotalTxs != 0 || len(stats.PerSecond) != 0 {
+		t.Errorf("stats of no samples = %+v, want empty", stats)
+	}
+	if stats.AvgTxsPerSecond != 0 || stats.InclusionRate != 0 || stats.InclusionLatencyRankings != nil {
+		t.Errorf("averages of no samples = %g tx/s, %g inclusion rate, want 0", stats.AvgTxsPerSecond, stats.InclusionRate)
+	}
+}
+
+// sampleSource serves recorded samples to LiveStats in place of a transactor.
+type sampleSource struct {
+	TransactorInterface
+	samples []TxSample
+}
+
+func (s *sampleSource) GetTxSamplesSince(offset int) []TxSample {
+	if offset >= len(s.samples) {
+		return nil
+	}
+	return s.samples[offset:]
+}
+
+func TestLiveStatsCollect(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	a, b := &sampleSource{}, &sampleSource{}
+	live := NewLiveStats(start, []TransactorInterface{a, b})
+
+	steps := []struct {
+		name    string
+		a, b    []TxSample
+		now     time.Duration
+		wantQPS []int
+	}{
+		{
+			name:    "second in progress",
+			a:       []TxSample{{SentAt: at(100 * time.Millisecond), Latency: time.Millisecond}},
+			now:     500 * time.Millisecond,
+			wantQPS: nil,
+		},
+		{
+			name:    "first second elapsed",
+			b:       []TxSample{{SentAt: at(200 * time.Millisecond), Latency: time.Millisecond}},
+			now:     1100 * time.Millisecond,
+			wantQPS: []int{2},
+		},
+		{
+			name: "late response for a returned second is left out",
+			a: []TxSample{
+				{SentAt: at(900 * time.Millisecond), Latency: 300 * time.Millisecond},
+				{SentAt: at(2500 * time.Millisecond), Latency: time.Millisecond},
+			},
+			now:     3 * time.Second,
+			wantQPS: []int{0, 1},
+		},
+	}
+	for _, step := range steps {
+		a.samples = append(a.samples, step.a...)
+		b.samples = append(b.samples, step.b...)
+		got := live.Collect(start.Add(step.now))
+		var gotQPS []int
+		for _, perSec := range got {
+			gotQPS = append(gotQPS, perSec.QPS)
+		}
+		if len(gotQPS) != len(step.wantQPS) {
+			t.Fatalf("%s: QPS = %v, want %v", step.name, gotQPS, step.wantQPS)
+		}
+		for i := range gotQPS {
+			if gotQPS[i] != step.wantQPS[i] {
+				t.Errorf("%s: QPS = %v, want %v", step.name, gotQPS, step.wantQPS)
+				break
+			}
+		}
+	}
+}
@@ -16,7 +16,8 @@ func NewTransactorFactory() *TransactorFactory {
 	return &TransactorFactory{}
 }
 
-// CreateTransactor creates either a WebSocket or HTTP transactor based on the endpoint URL
+// CreateTransactor creates a hybrid transactor that speaks WebSocket or HTTP
+// depending on the endpoint URL
 func (tf *TransactorFactory) CreateTransactor(remoteAddr string, config *loadtest.Config) (TransactorInterface, error) {
 	u, err := url.Parse(remoteAddr)
 	if err != nil {
@@ -24,11 +25,9 @@ func (tf *TransactorFactory) CreateTransactor(remoteAddr string, config *loadtes
 	}
 
 	switch u.Scheme {
-	case "ws", "wss":
-		// Use original WebSocket transactor for WebSocket endpoints
-		return loadtest.NewTransactor(remoteAddr, config)
-	case "http", "https":
-		// Use simple hybrid transactor for HTTP(S) endpoints
+	case "ws", "wss", "http", "https":
+		// The hybrid transactor reads every response so that it can
+		// record latencies for both protocols
 		return NewHybridTransactor(remoteAddr, config)
 	default:
 		return nil, fmt.Errorf("unsupported protocol: %s (supported: ws://, wss://, http://, https://)", u.Scheme)
@@ -38,16 +37,20 @@ func (tf *TransactorFactory) CreateTransactor(remoteAddr string, config *loadtes
 // TransactorInterface defines the common interface for all transactor types
 type TransactorInterface interface {
 	SetProgressCallback(id int, interval time.Duration, callback func(int, int, int64))
+	SetConfirmation(config ConfirmationConfig)
+	SetClientParams(params ClientParams)
+	SetArrival(config ArrivalConfig)
+	SetStages(stages Stages)
 	Start()
 	Cancel()
+	WaitSent()
 	Wait() error
 	GetTxCount() int
 	GetTxBytes() int64
 	GetTxRate() float64
+	GetTxSamples() []TxSample
+	GetTxSamplesSince(offset int) []TxSample
 }
 
-// Ensure original Transactor implements the interface
-var _ TransactorInterface = (*loadtest.Transactor)(nil)
-
 // Ensure SimpleHybridTransactor implements the interface  
 var _ TransactorInterface = (*SimpleHybridTransactor)(nil)
\ No newline at end of file
@@ -0,0 +1,305 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	pluginpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/plugin/v1"
+)
+
+// How long a plugin has to write its handshake and accept the connection
+const StartTimeout = 10 * time.Second
+
+// How long validating a configuration, creating a client or generating a
+// transaction can take, as the plugin may query the chain
+const callTimeout = time.Minute
+
+// How long a plugin has to exit once its stdin is closed, before it is killed
+const killTimeout = 2 * time.Second
+
+// Plugin is a running plugin binary.
+type Plugin struct {
+	path   string
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	exited chan struct{}
+	conn   *grpc.ClientConn
+	client pluginpb.ClientFactoryPluginClient
+	names  []string
+}
+
+// Discover returns the paths of the executable files in dir, in
+// alphabetical order.
+func Discover(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if info.Mode().IsRegular() && info.Mode().Perm()&0o111 != 0 {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Load starts every plugin binary in dir. If one fails to start, those
+// already started are stopped.
+func Load(dir string) ([]*Plugin, error) {
+	paths, err := Discover(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugins directory: %w", err)
+	}
+	plugins := make([]*Plugin, 0, len(paths))
+	for _, path := range paths {
+		p, err := Start(path)
+		if err != nil {
+			for _, started := range plugins {
+				started.Kill()
+			}
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+// Start starts the plugin binary at path, connects to it and lists its
+// client factories. Its stderr, and its stdout after the handshake, go to
+// the load tester's stderr.
+func Start(path string) (*Plugin, error) {
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), MagicCookieKey+"="+MagicCookieValue)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", path, err)
+	}
+	p := &Plugin{path: path, cmd: cmd, stdin: stdin, exited: make(chan struct{})}
+
+	lines := make(chan string, 1)
+	go func() {
+		reader := bufio.NewReader(stdout)
+		line, _ := reader.ReadString('\n')
+		lines <- line
+		io.Copy(os.Stderr, reader)
+	}()
+	go func() {
+		cmd.Wait()
+		close(p.exited)
+	}()
+
+	var line string
+	select {
+	case line = <-lines:
+	case <-p.exited:
+		stdin.Close()
+		return nil, fmt.Errorf("plugin %s exited before the handshake", path)
+	case <-time.After(StartTimeout):
+		p.Kill()
+		return nil, fmt.Errorf("plugin %s did not write its handshake within %s", path, StartTimeout)
+	}
+	h, err := parseHandshake(line)
+	if err != nil {
+		p.Kill()
+		return nil, fmt.Errorf("plugin %s: %w", path, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), StartTimeout)
+	defer cancel()
+	p.conn, err = grpc.DialContext(ctx, "unix://"+h.address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		p.Kill()
+		return nil, fmt.Errorf("failed to connect to plugin %s: %w", path, err)
+	}
+	p.client = pluginpb.NewClientFactoryPluginClient(p.conn)
+
+	res, err := p.client.ListClientFactories(ctx, &pluginpb.ListClientFactoriesRequest{})
+	if err != nil {
+		p.Kill()
+		return nil, fmt.Errorf("failed to list the client factories of plugin %s: %w", path, err)
+	}
+	p.names = res.GetNames()
+	return p, nil
+}
+
+// Names returns the names of the plugin's client factories.
+func (p *Plugin) Names() []string {
+	return p.names
+}
+
+// ClientFactory returns the plugin's client factory with the given name.
+func (p *Plugin) ClientFactory(name string) *ClientFactory {
+	return &ClientFactory{plugin: p, name: name, run: new(pluginRun)}
+}
+
+// Kill stops the plugin, closing its stdin first for it to exit on its own.
+func (p *Plugin) Kill() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.stdin.Close()
+	select {
+	case <-p.exited:
+	case <-time.After(killTimeout):
+		p.cmd.Process.Kill()
+		<-p.exited
+	}
+}
+
+// ClientFactory is a client factory served by a plugin
+type ClientFactory struct {
+	plugin *Plugin
+	name   string
+
+	mtx sync.Mutex
+	// The clients created for the current load test
+	run *pluginRun
+}
+
+// pluginRun holds the IDs of the clients the plugin created for a load test,
+// which are released once it is over.
+type pluginRun struct {
+	clientIDs []string
+}
+
+var (
+	_ loadtest.ClientFactory             = (*ClientFactory)(nil)
+	_ hybridloadtest.ParamsClientFactory = (*ClientFactory)(nil)
+	_ hybridloadtest.Preparer            = (*ClientFactory)(nil)
+)
+
+// Client is a client created by a plugin's client factory
+type Client struct {
+	plugin *Plugin
+	id     string
+}
+
+var _ loadtest.Client = (*Client)(nil)
+
+func (f *ClientFactory) ValidateConfig(cfg loadtest.Config) error {
+	return f.ValidateParams(cfg, nil)
+}
+
+// ValidateParams has the plugin validate the configuration along with the
+// client parameters
+func (f *ClientFactory) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	_, err := f.plugin.client.ValidateConfig(ctx, &pluginpb.ValidateConfigRequest{
+		Config: f.config(cfg, params),
+	})
+	return f.plugin.error(err)
+}
+
+func (f *ClientFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
+	return f.NewClientWithParams(cfg, nil)
+}
+
+// NewClientWithParams has the plugin create a client that applies the client
+// parameters
+func (f *ClientFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	res, err := f.plugin.client.NewClient(ctx, &pluginpb.NewClientRequest{
+		Config: f.config(cfg, params),
+	})
+	if err != nil {
+		return nil, f.plugin.error(err)
+	}
+
+	f.mtx.Lock()
+	f.run.clientIDs = append(f.run.clientIDs, res.GetClientId())
+	f.mtx.Unlock()
+	return &Client{plugin: f.plugin, id: res.GetClientId()}, nil
+}
+
+// Prepare starts tracking the clients created for a new load test. The
+// returned teardown has the plugin release them, so that it doesn't keep
+// the clients of every load test until it exits.
+func (f *ClientFactory) Prepare(cfg loadtest.Config, params hybridloadtest.ClientParams) (hybridloadtest.Teardown, error) {
+	run := new(pluginRun)
+	f.mtx.Lock()
+	f.run = run
+	f.mtx.Unlock()
+
+	return func() error {
+		f.mtx.Lock()
+		clientIDs := run.clientIDs
+		run.clientIDs = nil
+		f.mtx.Unlock()
+
+		var errs []error
+		for _, id := range clientIDs {
+			ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+			_, err := f.plugin.client.ReleaseClient(ctx, &pluginpb.ReleaseClientRequest{
+				ClientId: id,
+			})
+			cancel()
+			if err != nil {
+				errs = append(errs, f.plugin.error(err))
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// config converts the configuration for the plugin, under the name the
+// plugin knows the factory by.
+func (f *ClientFactory) config(cfg loadtest.Config, params hybridloadtest.ClientParams) *pluginpb.Config {
+	cfg.ClientFactory = f.name
+	return configToProto(cfg, params)
+}
+
+// GenerateTx has the plugin generate the client's next transaction
+func (c *Client) GenerateTx() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	res, err := c.plugin.client.GenerateTx(ctx, &pluginpb.GenerateTxRequest{
+		ClientId: c.id,
+	})
+	if err != nil {
+		return nil, c.plugin.error(err)
+	}
+	return res.GetTx(), nil
+}
+
+// error reports an error of the plugin with its path, and the message of its
+// status alone.
+func (p *Plugin) error(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("plugin %s: %s", filepath.Base(p.path), status.Convert(err).Message())
+}
@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	files := []struct {
+		name string
+		mode os.FileMode
+	}{
+		{"b-plugin", 0o755},
+		{"a-plugin", 0o700},
+		{"README.md", 0o644},
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(dir, f.name), nil, f.mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := Discover(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(dir, "a-plugin"), filepath.Join(dir, "b-plugin")}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("Discover() = %v, want %v", paths, want)
+	}
+	if _, err := Discover(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("Discover() of a missing directory succeeded")
+	}
+}
+
+// TestPlugin starts the test binary as a plugin serving the test factories.
+func TestPlugin(t *testing.T) {
+	path, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := Start(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Kill()
+
+	if names := p.Names(); strings.Join(names, ",") != "counter,plain" {
+		t.Errorf("Names() = %v, want [counter plain]", names)
+	}
+
+	f := p.ClientFactory("counter")
+	cfg := loadtest.Config{ClientFactory: "plugin-counter", Connections: 1}
+	teardown, err := f.Prepare(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ValidateConfig(cfg); err != nil {
+		t.Errorf("ValidateConfig() = %v", err)
+	}
+	err = f.ValidateParams(cfg, hybridloadtest.ClientParams{"size": "1"})
+	if err == nil || err.Error() != `plugin `+filepath.Base(path)+`: unknown parameter "size"` {
+		t.Errorf("ValidateParams() with an unknown parameter = %v", err)
+	}
+
+	client, err := f.NewClientWithParams(cfg, hybridloadtest.ClientParams{"prefix": "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"p-1", "p-2"} {
+		tx, err := client.GenerateTx()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(tx) != want {
+			t.Errorf("GenerateTx() = %s, want %s", tx, want)
+		}
+	}
+	// Once the load test is over, the plugin releases its clients
+	if err := teardown(); err != nil {
+		t.Errorf("teardown() = %v", err)
+	}
+	if _, err := client.GenerateTx(); err == nil {
+		t.Errorf("GenerateTx() of a released client succeeded")
+	}
+
+	client, err = p.ClientFactory("plain").NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GenerateTx(); err == nil {
+		t.Errorf("GenerateTx() of a failing client succeeded")
+	}
+}
+
+func TestStartFailures(t *testing.T) {
+	dir := t.TempDir()
+	scripts := []struct {
+		name   string
+		script string
+	}{
+		{"exits", "#!/bin/sh\nexit 1\n"},
+		{"wrong-handshake", "#!/bin/sh\necho '1|tcp|127.0.0.1:1|grpc'\ncat\n"},
+	}
+	for _, s := range scripts {
+		path := filepath.Join(dir, s.name)
+		if err := os.WriteFile(path, []byte(s.script), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if p, err := Start(path); err == nil {
+			p.Kill()
+			t.Errorf("Start(%s) succeeded, want an error", s.name)
+		}
+	}
+	// Load stops at the first plugin that fails
+	if _, err := Load(dir); err == nil {
+		t.Errorf("Load() of failing plugins succeeded")
+	}
+}
@@ -0,0 +1,101 @@
+// Package plugin runs client factories out of process, in plugin binaries
+// that serve them over gRPC on a unix socket, so that workloads can be added
+// without rebuilding the load tester.
+//
+// The load tester starts each plugin binary with MagicCookieKey set to
+// MagicCookieValue in its environment, and a pipe as its stdin. The plugin
+// listens on a unix socket and writes the handshake line
+//
+//	CORE-PROTOCOL-VERSION|NETWORK|ADDRESS|PROTOCOL
+//
+// e.g. "1|unix|/tmp/plugin/plugin.sock|grpc", to its stdout. The load tester
+// then connects to it and calls the ClientFactoryPlugin service. The plugin
+// exits when its stdin is closed, which happens when the load tester exits.
+// Serve implements the plugin side in Go; plugins in other languages follow
+// the same protocol.
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	pluginpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/plugin/v1"
+)
+
+// The environment variable, and its value, that plugins are started with, so
+// that they refuse to run when started by hand
+const (
+	MagicCookieKey   = "COSMOSLOADTESTER_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "9b1fd5bb2a7c4e0e8c1f6d3a5e7b2c40"
+)
+
+// ProtocolVersion is the version of the handshake and the service
+const ProtocolVersion = 1
+
+// handshake is the line a plugin writes to its stdout once it is listening.
+type handshake struct {
+	version  int
+	network  string
+	address  string
+	protocol string
+}
+
+func (h handshake) String() string {
+	return fmt.Sprintf("%d|%s|%s|%s", h.version, h.network, h.address, h.protocol)
+}
+
+func parseHandshake(line string) (handshake, error) {
+	var h handshake
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 4 {
+		return h, fmt.Errorf("invalid handshake %q, expected VERSION|NETWORK|ADDRESS|PROTOCOL", line)
+	}
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return h, fmt.Errorf("invalid handshake version %q", parts[0])
+	}
+	h = handshake{version: version, network: parts[1], address: parts[2], protocol: parts[3]}
+	if h.version != ProtocolVersion {
+		return h, fmt.Errorf("plugin speaks protocol version %d, expected %d", h.version, ProtocolVersion)
+	}
+	if h.network != "unix" || h.protocol != "grpc" {
+		return h, fmt.Errorf("plugin serves %s over %s, expected grpc over unix", h.protocol, h.network)
+	}
+	return h, nil
+}
+
+// configToProto converts the configuration of a load test, along with the
+// client parameters, for a plugin.
+func configToProto(cfg loadtest.Config, params hybridloadtest.ClientParams) *pluginpb.Config {
+	return &pluginpb.Config{
+		ClientFactory:     cfg.ClientFactory,
+		Connections:       int32(cfg.Connections),
+		Time:              int32(cfg.Time),
+		SendPeriod:        int32(cfg.SendPeriod),
+		Rate:              int32(cfg.Rate),
+		Size:              int32(cfg.Size),
+		Count:             int32(cfg.Count),
+		BroadcastTxMethod: cfg.BroadcastTxMethod,
+		Endpoints:         cfg.Endpoints,
+		ClientParams:      params,
+	}
+}
+
+// configFromProto converts the configuration a plugin receives back.
+func configFromProto(cfg *pluginpb.Config) (loadtest.Config, hybridloadtest.ClientParams) {
+	return loadtest.Config{
+		ClientFactory:     cfg.GetClientFactory(),
+		Connections:       int(cfg.GetConnections()),
+		Time:              int(cfg.GetTime()),
+		SendPeriod:        int(cfg.GetSendPeriod()),
+		Rate:              int(cfg.GetRate()),
+		Size:              int(cfg.GetSize()),
+		Count:             int(cfg.GetCount()),
+		BroadcastTxMethod: cfg.GetBroadcastTxMethod(),
+		Endpoints:         cfg.GetEndpoints(),
+	}, cfg.GetClientParams()
+}
@@ -0,0 +1,63 @@
+package plugin
+
+import (
+	"maps"
+	"reflect"
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+)
+
+func TestParseHandshake(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    handshake
+		wantErr bool
+	}{
+		{"1|unix|/tmp/plugin/plugin.sock|grpc\n", handshake{1, "unix", "/tmp/plugin/plugin.sock", "grpc"}, false},
+		{"1|unix|/tmp/plugin.sock", handshake{}, true},
+		{"one|unix|/tmp/plugin.sock|grpc", handshake{}, true},
+		{"2|unix|/tmp/plugin.sock|grpc", handshake{}, true},
+		{"1|tcp|127.0.0.1:1234|grpc", handshake{}, true},
+		{"1|unix|/tmp/plugin.sock|netrpc", handshake{}, true},
+		{"", handshake{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseHandshake(tt.line)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseHandshake(%q) error = %v, wantErr %t", tt.line, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("parseHandshake(%q) = %+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+	h := handshake{ProtocolVersion, "unix", "/tmp/plugin.sock", "grpc"}
+	if got, err := parseHandshake(h.String()); err != nil || got != h {
+		t.Errorf("parseHandshake(%q) = %+v, %v, want %+v", h, got, err, h)
+	}
+}
+
+func TestConfigRoundTrip(t *testing.T) {
+	cfg := loadtest.Config{
+		ClientFactory:     "kvstore",
+		Connections:       2,
+		Time:              60,
+		SendPeriod:        1,
+		Rate:              100,
+		Size:              250,
+		Count:             -1,
+		BroadcastTxMethod: "sync",
+		Endpoints:         []string{"ws://localhost:26657/websocket"},
+	}
+	params := hybridloadtest.ClientParams{"key_prefix": "lt"}
+	gotCfg, gotParams := configFromProto(configToProto(cfg, params))
+	if !reflect.DeepEqual(gotCfg, cfg) {
+		t.Errorf("configFromProto(configToProto()) = %+v, want %+v", gotCfg, cfg)
+	}
+	if !maps.Equal(gotParams, params) {
+		t.Errorf("configFromProto(configToProto()) parameters = %v, want %v", gotParams, params)
+	}
+}
@@ -0,0 +1,183 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	pluginpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/plugin/v1"
+)
+
+// Serve serves the client factories, by the names they are registered under
+// in the load tester, from a plugin binary. It returns once the load tester
+// closes the plugin's stdin or the plugin is interrupted, and fails if the
+// binary wasn't started by the load tester. Factories that implement
+// hybridloadtest.ParamsClientFactory take client parameters.
+func Serve(factories map[string]loadtest.ClientFactory) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return fmt.Errorf("this binary is a cosmosloadtester plugin, to be run from its --plugins-dir rather than directly")
+	}
+	if len(factories) == 0 {
+		return fmt.Errorf("at least one client factory is required")
+	}
+
+	dir, err := os.MkdirTemp("", "cosmosloadtester-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	address := filepath.Join(dir, "plugin.sock")
+	lis, err := net.Listen("unix", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	srv := grpc.NewServer()
+	pluginpb.RegisterClientFactoryPluginServer(srv, &server{
+		factories: factories,
+		clients:   make(map[string]loadtest.Client),
+	})
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(lis)
+	}()
+
+	fmt.Fprintln(os.Stdout, handshake{
+		version:  ProtocolVersion,
+		network:  "unix",
+		address:  address,
+		protocol: "grpc",
+	})
+
+	// Stop with the load tester, whose exit closes our stdin
+	stdinClosed := make(chan struct{})
+	go func() {
+		io.Copy(io.Discard, os.Stdin)
+		close(stdinClosed)
+	}()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-stdinClosed:
+	case <-signals:
+	}
+	srv.Stop()
+	return nil
+}
+
+// server implements the ClientFactoryPlugin service with the plugin's client
+// factories.
+type server struct {
+	pluginpb.UnimplementedClientFactoryPluginServer
+
+	factories map[string]loadtest.ClientFactory
+
+	mtx     sync.RWMutex
+	clients map[string]loadtest.Client
+	nextID  int
+}
+
+func (s *server) ListClientFactories(ctx context.Context, req *pluginpb.ListClientFactoriesRequest) (*pluginpb.ListClientFactoriesResponse, error) {
+	names := make([]string, 0, len(s.factories))
+	for name := range s.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &pluginpb.ListClientFactoriesResponse{Names: names}, nil
+}
+
+func (s *server) ValidateConfig(ctx context.Context, req *pluginpb.ValidateConfigRequest) (*pluginpb.ValidateConfigResponse, error) {
+	factory, cfg, params, err := s.factory(req.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	if paramsFactory, ok := factory.(hybridloadtest.ParamsClientFactory); ok {
+		err = paramsFactory.ValidateParams(cfg, params)
+	} else if len(params) > 0 {
+		err = fmt.Errorf("client factory %q does not take parameters", cfg.ClientFactory)
+	} else {
+		err = factory.ValidateConfig(cfg)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pluginpb.ValidateConfigResponse{}, nil
+}
+
+func (s *server) NewClient(ctx context.Context, req *pluginpb.NewClientRequest) (*pluginpb.NewClientResponse, error) {
+	factory, cfg, params, err := s.factory(req.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	var client loadtest.Client
+	if paramsFactory, ok := factory.(hybridloadtest.ParamsClientFactory); ok && len(params) > 0 {
+		client, err = paramsFactory.NewClientWithParams(cfg, params)
+	} else if len(params) > 0 {
+		err = fmt.Errorf("client factory %q does not take parameters", cfg.ClientFactory)
+	} else {
+		client, err = factory.NewClient(cfg)
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	id := strconv.Itoa(s.nextID)
+	s.nextID++
+	s.clients[id] = client
+	return &pluginpb.NewClientResponse{ClientId: id}, nil
+}
+
+func (s *server) GenerateTx(ctx context.Context, req *pluginpb.GenerateTxRequest) (*pluginpb.GenerateTxResponse, error) {
+	s.mtx.RLock()
+	client, ok := s.clients[req.GetClientId()]
+	s.mtx.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no client %q", req.GetClientId())
+	}
+	tx, err := client.GenerateTx()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pluginpb.GenerateTxResponse{Tx: tx}, nil
+}
+
+func (s *server) ReleaseClient(ctx context.Context, req *pluginpb.ReleaseClientRequest) (*pluginpb.ReleaseClientResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.clients[req.GetClientId()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "no client %q", req.GetClientId())
+	}
+	delete(s.clients, req.GetClientId())
+	return &pluginpb.ReleaseClientResponse{}, nil
+}
+
+// factory returns the client factory the configuration names, and the
+// configuration converted back.
+func (s *server) factory(config *pluginpb.Config) (loadtest.ClientFactory, loadtest.Config, hybridloadtest.ClientParams, error) {
+	cfg, params := configFromProto(config)
+	factory, ok := s.factories[cfg.ClientFactory]
+	if !ok {
+		return nil, cfg, params, status.Errorf(codes.NotFound, "client factory %q is not served by this plugin", cfg.ClientFactory)
+	}
+	return factory, cfg, params, nil
+}
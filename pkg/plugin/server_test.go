@@ -0,0 +1,168 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	pluginpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/plugin/v1"
+)
+
+// counterFactory creates clients whose transactions are their prefix, "tx"
+// unless the prefix parameter sets another, followed by a counter.
+type counterFactory struct{}
+
+func (counterFactory) ValidateConfig(cfg loadtest.Config) error {
+	return counterFactory{}.ValidateParams(cfg, nil)
+}
+
+func (counterFactory) ValidateParams(cfg loadtest.Config, params hybridloadtest.ClientParams) error {
+	if cfg.Connections <= 0 {
+		return fmt.Errorf("connections must be > 0")
+	}
+	for name := range params {
+		if name != "prefix" {
+			return fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+	return nil
+}
+
+func (counterFactory) NewClient(cfg loadtest.Config) (loadtest.Client, error) {
+	return &counterClient{prefix: "tx"}, nil
+}
+
+func (counterFactory) NewClientWithParams(cfg loadtest.Config, params hybridloadtest.ClientParams) (loadtest.Client, error) {
+	return &counterClient{prefix: params["prefix"]}, nil
+}
+
+type counterClient struct {
+	prefix string
+	n      int
+}
+
+func (c *counterClient) GenerateTx() ([]byte, error) {
+	c.n++
+	return []byte(fmt.Sprintf("%s-%d", c.prefix, c.n)), nil
+}
+
+// plainFactory takes no client parameters, and its clients fail.
+type plainFactory struct{}
+
+func (plainFactory) ValidateConfig(loadtest.Config) error { return nil }
+
+func (plainFactory) NewClient(loadtest.Config) (loadtest.Client, error) { return failingClient{}, nil }
+
+type failingClient struct{}
+
+func (failingClient) GenerateTx() ([]byte, error) { return nil, fmt.Errorf("out of transactions") }
+
+var testFactories = map[string]loadtest.ClientFactory{
+	"counter": counterFactory{},
+	"plain":   plainFactory{},
+}
+
+// TestMain serves the test factories when the test binary is started as a
+// plugin.
+func TestMain(m *testing.M) {
+	if os.Getenv(MagicCookieKey) == MagicCookieValue {
+		if err := Serve(testFactories); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	s := &server{factories: testFactories, clients: make(map[string]loadtest.Client)}
+
+	list, err := s.ListClientFactories(ctx, &pluginpb.ListClientFactoriesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := list.GetNames(); len(names) != 2 || names[0] != "counter" || names[1] != "plain" {
+		t.Errorf("ListClientFactories() = %v, want [counter plain]", names)
+	}
+
+	validateTests := []struct {
+		name     string
+		cfg      loadtest.Config
+		params   hybridloadtest.ClientParams
+		wantCode codes.Code
+	}{
+		{"valid", loadtest.Config{ClientFactory: "counter", Connections: 1}, nil, codes.OK},
+		{"valid parameters", loadtest.Config{ClientFactory: "counter", Connections: 1}, hybridloadtest.ClientParams{"prefix": "p"}, codes.OK},
+		{"invalid configuration", loadtest.Config{ClientFactory: "counter"}, nil, codes.InvalidArgument},
+		{"unknown parameter", loadtest.Config{ClientFactory: "counter", Connections: 1}, hybridloadtest.ClientParams{"size": "1"}, codes.InvalidArgument},
+		{"parameters for a factory without any", loadtest.Config{ClientFactory: "plain"}, hybridloadtest.ClientParams{"prefix": "p"}, codes.InvalidArgument},
+		{"unknown factory", loadtest.Config{ClientFactory: "kvstore"}, nil, codes.NotFound},
+	}
+	for _, tt := range validateTests {
+		_, err := s.ValidateConfig(ctx, &pluginpb.ValidateConfigRequest{Config: configToProto(tt.cfg, tt.params)})
+		if code := status.Code(err); code != tt.wantCode {
+			t.Errorf("%s: ValidateConfig() = %v, want code %s", tt.name, err, tt.wantCode)
+		}
+	}
+
+	clientTests := []struct {
+		params hybridloadtest.ClientParams
+		wantID string
+		wantTx string
+	}{
+		{nil, "0", "tx-1"},
+		{hybridloadtest.ClientParams{"prefix": "p"}, "1", "p-1"},
+	}
+	for _, tt := range clientTests {
+		res, err := s.NewClient(ctx, &pluginpb.NewClientRequest{Config: configToProto(loadtest.Config{ClientFactory: "counter", Connections: 1}, tt.params)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetClientId() != tt.wantID {
+			t.Errorf("NewClient() = client %q, want %q", res.GetClientId(), tt.wantID)
+		}
+		tx, err := s.GenerateTx(ctx, &pluginpb.GenerateTxRequest{ClientId: res.GetClientId()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(tx.GetTx()) != tt.wantTx {
+			t.Errorf("GenerateTx() = %s, want %s", tx.GetTx(), tt.wantTx)
+		}
+	}
+
+	if _, err := s.GenerateTx(ctx, &pluginpb.GenerateTxRequest{ClientId: "7"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GenerateTx() of an unknown client = %v, want code NotFound", err)
+	}
+
+	// Released clients are forgotten
+	if _, err := s.ReleaseClient(ctx, &pluginpb.ReleaseClientRequest{ClientId: "0"}); err != nil {
+		t.Errorf("ReleaseClient() = %v", err)
+	}
+	if _, err := s.GenerateTx(ctx, &pluginpb.GenerateTxRequest{ClientId: "0"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GenerateTx() of a released client = %v, want code NotFound", err)
+	}
+	if _, err := s.ReleaseClient(ctx, &pluginpb.ReleaseClientRequest{ClientId: "0"}); status.Code(err) != codes.NotFound {
+		t.Errorf("ReleaseClient() of a released client = %v, want code NotFound", err)
+	}
+	res, err := s.NewClient(ctx, &pluginpb.NewClientRequest{Config: configToProto(loadtest.Config{ClientFactory: "plain"}, nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GenerateTx(ctx, &pluginpb.GenerateTxRequest{ClientId: res.GetClientId()}); status.Code(err) != codes.Internal {
+		t.Errorf("GenerateTx() of a failing client = %v, want code Internal", err)
+	}
+}
+
+func TestServeWithoutCookie(t *testing.T) {
+	if err := Serve(testFactories); err == nil {
+		t.Errorf("Serve() outside of the load tester succeeded")
+	}
+}
@@ -0,0 +1,159 @@
+package results
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// ExportStats writes the statistics of a load test to the file at path in the
+// given format.
+func ExportStats(path string, format loadtestpb.RunLoadtestRequest_StatsOutputFormat, res *loadtestpb.RunLoadtestResponse) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create stats output file: %w", err)
+	}
+	if err := WriteStats(f, format, res); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteStats writes the statistics of a load test to w in the given format.
+func WriteStats(w io.Writer, format loadtestpb.RunLoadtestRequest_StatsOutputFormat, res *loadtestpb.RunLoadtestResponse) error {
+	switch format {
+	case loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_UNSPECIFIED, loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_CSV:
+		return writeCSV(w, res)
+	case loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_JSON:
+		blob, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("failed to encode stats: %w", err)
+		}
+		_, err = w.Write(append(blob, '\n'))
+		return err
+	case loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_COLUMNAR:
+		return writeColumnar(w, res)
+	}
+	return fmt.Errorf("unsupported stats_output_format: %v", format)
+}
+
+// writeCSV writes the aggregate statistics the way tm-load-test does, followed
+// by the outcome of the transactions and what the chain committed.
+func writeCSV(w io.Writer, res *loadtestpb.RunLoadtestResponse) error {
+	csvW := csv.NewWriter(w)
+	records := [][]string{
+		{"Parameter", "Value", "Units"},
+		{"total_time", strconv.FormatFloat(res.TotalTime.AsDuration().Seconds(), 'f', 3, 64), "seconds"},
+		{"total_txs", strconv.FormatInt(res.TotalTxs, 10), "count"},
+		{"total_bytes", strconv.FormatInt(res.TotalBytes, 10), "bytes"},
+		{"avg_tx_rate", strconv.FormatFloat(res.AvgTxsPerSecond, 'f', 6, 64), "transactions per second"},
+		{"avg_data_rate", strconv.FormatFloat(res.AvgBytesPerSecond, 'f', 6, 64), "bytes per second"},
+		{"accepted_txs", strconv.FormatInt(res.AcceptedTxs, 10), "count"},
+		{"failed_txs", strconv.FormatInt(res.FailedTxs, 10), "count"},
+		{"included_txs", strconv.FormatInt(res.IncludedTxs, 10), "count"},
+		{"dropped_txs", strconv.FormatInt(res.DroppedTxs, 10), "count"},
+		{"inclusion_rate", strconv.FormatFloat(res.InclusionRate, 'f', 6, 64), "fraction"},
+	}
+	if chain := res.ChainMetrics; chain != nil {
+		records = append(records,
+			[]string{"blocks", strconv.FormatInt(chain.Blocks, 10), "count"},
+			[]string{"committed_txs", strconv.FormatInt(chain.CommittedTxs, 10), "count"},
+			[]string{"committed_tx_rate", strconv.FormatFloat(chain.CommittedTxsPerSecond, 'f', 6, 64), "transactions per second"},
+			[]string{"avg_block_time", strconv.FormatFloat(chain.AvgBlockTime.AsDuration().Seconds(), 'f', 3, 64), "seconds"},
+			[]string{"avg_block_size", strconv.FormatFloat(chain.AvgBlockBytes, 'f', 1, 64), "bytes"},
+			[]string{"avg_gas_used", strconv.FormatFloat(chain.AvgGasUsed, 'f', 1, 64), "gas per block"},
+			[]string{"max_mempool_txs", strconv.FormatInt(chain.MaxMempoolTxs, 10), "count"},
+		)
+	}
+	if err := csvW.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write stats: %w", err)
+	}
+	return nil
+}
+
+// statsColumns holds one row per second of the aggregate and of every
+// endpoint connection, stored column by column. Rows of the aggregate have an
+// empty endpoint and a connection_index of -1.
+type statsColumns struct {
+	Rows            int       `json:"rows"`
+	Endpoint        []string  `json:"endpoint"`
+	ConnectionIndex []int32   `json:"connection_index"`
+	Sec             []int64   `json:"sec"`
+	QPS             []float64 `json:"qps"`
+	BytesSent       []float64 `json:"bytes_sent"`
+	LatencyP50Ms    []float64 `json:"latency_p50_ms"`
+	LatencyP75Ms    []float64 `json:"latency_p75_ms"`
+	LatencyP90Ms    []float64 `json:"latency_p90_ms"`
+	LatencyP95Ms    []float64 `json:"latency_p95_ms"`
+	LatencyP99Ms    []float64 `json:"latency_p99_ms"`
+	AcceptedTxs     []int64   `json:"accepted_txs"`
+	FailedTxs       []int64   `json:"failed_txs"`
+	IncludedTxs     []int64   `json:"included_txs"`
+	DroppedTxs      []int64   `json:"dropped_txs"`
+	InclusionP50Ms  []float64 `json:"inclusion_latency_p50_ms"`
+	InclusionP99Ms  []float64 `json:"inclusion_latency_p99_ms"`
+}
+
+func (c *statsColumns) append(endpoint string, connID int32, ps *loadtestpb.PerSecond) {
+	c.Rows++
+	c.Endpoint = append(c.Endpoint, endpoint)
+	c.ConnectionIndex = append(c.ConnectionIndex, connID)
+	c.Sec = append(c.Sec, ps.Sec)
+	c.QPS = append(c.QPS, ps.Qps)
+	c.BytesSent = append(c.BytesSent, ps.BytesSent)
+
+	rankings := ps.LatencyRankings
+	if rankings == nil {
+		rankings = &loadtestpb.Ranking{}
+	}
+	c.LatencyP50Ms = append(c.LatencyP50Ms, latencyMillis(rankings.P50))
+	c.LatencyP75Ms = append(c.LatencyP75Ms, latencyMillis(rankings.P75))
+	c.LatencyP90Ms = append(c.LatencyP90Ms, latencyMillis(rankings.P90))
+	c.LatencyP95Ms = append(c.LatencyP95Ms, latencyMillis(rankings.P95))
+	c.LatencyP99Ms = append(c.LatencyP99Ms, latencyMillis(rankings.P99))
+	c.AcceptedTxs = append(c.AcceptedTxs, ps.AcceptedTxs)
+	c.FailedTxs = append(c.FailedTxs, ps.FailedTxs)
+	c.IncludedTxs = append(c.IncludedTxs, ps.IncludedTxs)
+	c.DroppedTxs = append(c.DroppedTxs, ps.DroppedTxs)
+
+	inclusionRankings := ps.InclusionLatencyRankings
+	if inclusionRankings == nil {
+		inclusionRankings = &loadtestpb.Ranking{}
+	}
+	c.InclusionP50Ms = append(c.InclusionP50Ms, latencyMillis(inclusionRankings.P50))
+	c.InclusionP99Ms = append(c.InclusionP99Ms, latencyMillis(inclusionRankings.P99))
+}
+
+func latencyMillis(p *loadtestpb.Percentile) float64 {
+	if p == nil {
+		return 0
+	}
+	return p.Latency.AsDuration().Seconds() * 1000
+}
+
+func writeColumnar(w io.Writer, res *loadtestpb.RunLoadtestResponse) error {
+	columns := &statsColumns{}
+	for _, ps := range res.PerSec {
+		columns.append("", -1, ps)
+	}
+	for _, endpointResult := range res.EndpointResults {
+		for _, ps := range endpointResult.PerSec {
+			columns.append(endpointResult.Endpoint, endpointResult.ConnectionIndex, ps)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(columns); err != nil {
+		return fmt.Errorf("failed to write stats: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+func testResponse() *loadtestpb.RunLoadtestResponse {
+	perSec := func(sec int64, qps float64, p50 time.Duration) *loadtestpb.PerSecond {
+		return &loadtestpb.PerSecond{
+			Sec:       sec,
+			Qps:       qps,
+			BytesSent: qps * 100,
+			LatencyRankings: &loadtestpb.Ranking{
+				P50: &loadtestpb.Percentile{Latency: durationpb.New(p50)},
+			},
+		}
+	}
+	return &loadtestpb.RunLoadtestResponse{
+		TotalTime:         durationpb.New(2500 * time.Millisecond),
+		TotalTxs:          30,
+		TotalBytes:        3000,
+		AvgTxsPerSecond:   12,
+		AvgBytesPerSecond: 1200,
+		AcceptedTxs:       28,
+		FailedTxs:         2,
+		IncludedTxs:       27,
+		DroppedTxs:        1,
+		InclusionRate:     0.964286,
+		PerSec:            []*loadtestpb.PerSecond{perSec(0, 20, 2*time.Millisecond), {Sec: 1, Qps: 10, FailedTxs: 10}},
+		EndpointResults: []*loadtestpb.EndpointResult{{
+			Endpoint:        "http://localhost:26657",
+			ConnectionIndex: 1,
+			PerSec:          []*loadtestpb.PerSecond{perSec(0, 20, 1500*time.Microsecond)},
+		}},
+	}
+}
+
+func TestWriteStats(t *testing.T) {
+	res := testResponse()
+	tests := []struct {
+		name   string
+		format loadtestpb.RunLoadtestRequest_StatsOutputFormat
+		check  func(t *testing.T, out []byte)
+	}{
+		{
+			name:   "csv by default",
+			format: loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_UNSPECIFIED,
+			check: func(t *testing.T, out []byte) {
+				want := "Parameter,Value,Units\n" +
+					"total_time,2.500,seconds\n" +
+					"total_txs,30,count\n" +
+					"total_bytes,3000,bytes\n" +
+					"avg_tx_rate,12.000000,transactions per second\n" +
+					"avg_data_rate,1200.000000,bytes per second\n" +
+					"accepted_txs,28,count\n" +
+					"failed_txs,2,count\n" +
+					"included_txs,27,count\n" +
+					"dropped_txs,1,count\n" +
+					"inclusion_rate,0.964286,fraction\n"
+				if string(out) != want {
+					t.Errorf("CSV =\n%s\nwant\n%s", out, want)
+				}
+			},
+		},
+		{
+			name:   "json",
+			format: loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_JSON,
+			check: func(t *testing.T, out []byte) {
+				var got loadtestpb.RunLoadtestResponse
+				if err := protojson.Unmarshal(out, &got); err != nil {
+					t.Fatal(err)
+				}
+				if !proto.Equal(&got, res) {
+					t.Errorf("JSON decodes to %v, want %v", &got, res)
+				}
+			},
+		},
+		{
+			name:   "columnar",
+			format: loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_COLUMNAR,
+			check: func(t *testing.T, out []byte) {
+				var got statsColumns
+				if err := json.Unmarshal(out, &got); err != nil {
+					t.Fatal(err)
+				}
+				if got.Rows != 3 || len(got.Sec) != 3 || len(got.LatencyP99Ms) != 3 || len(got.FailedTxs) != 3 || len(got.InclusionP99Ms) != 3 {
+					t.Fatalf("columnar stats have %d rows, %d seconds, %d p99s and %d failure counts, want 3 of each",
+						got.Rows, len(got.Sec), len(got.LatencyP99Ms), len(got.FailedTxs))
+				}
+				if got.FailedTxs[1] != 10 {
+					t.Errorf("row 1 has %d failed txs, want 10", got.FailedTxs[1])
+				}
+				wantEndpoints := []string{"", "", "http://localhost:26657"}
+				wantConnections := []int32{-1, -1, 1}
+				wantP50s := []float64{2, 0, 1.5}
+				for i := 0; i < got.Rows; i++ {
+					if got.Endpoint[i] != wantEndpoints[i] || got.ConnectionIndex[i] != wantConnections[i] || got.LatencyP50Ms[i] != wantP50s[i] {
+						t.Errorf("row %d = %q connection %d p50 %gms, want %q connection %d p50 %gms", i,
+							got.Endpoint[i], got.ConnectionIndex[i], got.LatencyP50Ms[i],
+							wantEndpoints[i], wantConnections[i], wantP50s[i])
+					}
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteStats(&buf, tt.format, res); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, buf.Bytes())
+		})
+	}
+
+	if err := WriteStats(&bytes.Buffer{}, loadtestpb.RunLoadtestRequest_StatsOutputFormat(99), res); err == nil {
+		t.Error("WriteStats accepted an unknown format")
+	}
+}
+
+func TestExportStats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.csv")
+	if err := ExportStats(path, loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_CSV, testResponse()); err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(out), "Parameter,Value,Units\n") {
+		t.Errorf("exported stats = %q, want CSV", out)
+	}
+
+	if err := ExportStats(filepath.Join(t.TempDir(), "missing", "stats.csv"), 0, testResponse()); err == nil {
+		t.Error("ExportStats into a missing directory succeeded")
+	}
+}
+
+func TestWriteStatsChainMetrics(t *testing.T) {
+	res := testResponse()
+	res.ChainMetrics = &loadtestpb.ChainMetrics{
+		Blocks:                3,
+		CommittedTxs:          29,
+		CommittedTxsPerSecond: 11.6,
+		AvgBlockTime:          durationpb.New(600 * time.Millisecond),
+		AvgBlockBytes:         1024,
+		AvgGasUsed:            90000,
+		MaxMempoolTxs:         12,
+	}
+	var buf bytes.Buffer
+	if err := WriteStats(&buf, loadtestpb.RunLoadtestRequest_STATS_OUTPUT_FORMAT_CSV, res); err != nil {
+		t.Fatal(err)
+	}
+	want := "blocks,3,count\n" +
+		"committed_txs,29,count\n" +
+		"committed_tx_rate,11.600000,transactions per second\n" +
+		"avg_block_time,0.600,seconds\n" +
+		"avg_block_size,1024.0,bytes\n" +
+		"avg_gas_used,90000.0,gas per block\n" +
+		"max_mempool_txs,12,count\n"
+	if !strings.HasSuffix(buf.String(), want) {
+		t.Errorf("CSV =\n%s\nwant it to end with\n%s", buf.String(), want)
+	}
+}
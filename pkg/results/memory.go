@@ -0,0 +1,83 @@
+package results
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// MemoryStore keeps load tests in memory. It is used when no database is
+// configured, so the history is lost when the server exits.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	loadtests map[string]*loadtestpb.Loadtest
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		loadtests: make(map[string]*loadtestpb.Loadtest),
+	}
+}
+
+// SaveLoadtest implements Store.
+func (s *MemoryStore) SaveLoadtest(ctx context.Context, lt *loadtestpb.Loadtest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadtests[lt.Id] = proto.Clone(lt).(*loadtestpb.Loadtest)
+	return nil
+}
+
+// GetLoadtest implements Store.
+func (s *MemoryStore) GetLoadtest(ctx context.Context, id string) (*loadtestpb.Loadtest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	lt, ok := s.loadtests[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(lt).(*loadtestpb.Loadtest), nil
+}
+
+// ListLoadtests implements Store.
+func (s *MemoryStore) ListLoadtests(ctx context.Context, after *Cursor, limit int) ([]*loadtestpb.Loadtest, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*loadtestpb.Loadtest, 0, len(s.loadtests))
+	for _, lt := range s.loadtests {
+		if after == nil || after.After(lt.StartTime.AsTime(), lt.Id) {
+			all = append(all, lt)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		ti, tj := all[i].StartTime.AsTime(), all[j].StartTime.AsTime()
+		if ti.Equal(tj) {
+			return all[i].Id < all[j].Id
+		}
+		return ti.After(tj)
+	})
+
+	end := limit
+	if end > len(all) {
+		end = len(all)
+	}
+	page := make([]*loadtestpb.Loadtest, 0, end)
+	for _, lt := range all[:end] {
+		lt = proto.Clone(lt).(*loadtestpb.Loadtest)
+		lt.Result = nil
+		page = append(page, lt)
+	}
+	return page, end < len(all), nil
+}
+
+// Close implements Store.
+func (s *MemoryStore) Close() error {
+	return nil
+}
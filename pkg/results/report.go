@@ -0,0 +1,447 @@
+package results
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// DefaultSignificance is the p-value below which a difference between two
+// series counts as significant.
+const DefaultSignificance = 0.05
+
+// DefaultTolerances flag TPS or bytes 5% lower, or latencies 10% higher,
+// than the baseline.
+var DefaultTolerances = Tolerances{TPS: 5, Bytes: 5, Latency: 10, Significance: DefaultSignificance}
+
+// Tolerances are how much worse than the baseline a candidate may do before
+// it counts as a regression, in percent.
+type Tolerances struct {
+	// How much lower the TPS and bytes per second may be
+	TPS   float64 `json:"tps"`
+	Bytes float64 `json:"bytes"`
+	// How much higher the latency percentiles may be
+	Latency float64 `json:"latency"`
+	// The p-value below which a difference is significant. Defaults to
+	// DefaultSignificance.
+	Significance float64 `json:"significance"`
+}
+
+// Validate checks the tolerances.
+func (t Tolerances) Validate() error {
+	if t.TPS < 0 || t.Bytes < 0 || t.Latency < 0 {
+		return fmt.Errorf("tolerances must not be negative")
+	}
+	if t.Significance < 0 || t.Significance >= 1 {
+		return fmt.Errorf("significance must be between 0 and 1")
+	}
+	return nil
+}
+
+// SeriesComparison compares one per-second series of two load tests over the
+// seconds they both have.
+type SeriesComparison struct {
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+	// Whether a higher value is better, as for TPS, rather than worse, as for
+	// latencies
+	HigherIsBetter bool `json:"higher_is_better"`
+	// The number of seconds compared, those in which both load tests have a
+	// value
+	Seconds int `json:"seconds"`
+	// The number of seconds in which only the other load test has a value,
+	// such as seconds without answered transactions for latencies, which
+	// are left out
+	BaselineMissing  int `json:"baseline_missing"`
+	CandidateMissing int `json:"candidate_missing"`
+	// The means of the series
+	Baseline     float64 `json:"baseline"`
+	Candidate    float64 `json:"candidate"`
+	Delta        float64 `json:"delta"`
+	DeltaPercent float64 `json:"delta_percent"`
+	// The two-sided p-value of a Mann-Whitney U test of the two series, -1
+	// if either has fewer than 3 seconds
+	PValue      float64 `json:"p_value"`
+	Significant bool    `json:"significant"`
+	// Whether the candidate did worse than the tolerance allows, and the
+	// difference is significant or could not be tested
+	Regression bool `json:"regression"`
+	// Whether the candidate did significantly better than the tolerance
+	Improvement bool `json:"improvement"`
+}
+
+// AlignedSecond holds the headline values of a second both load tests have.
+type AlignedSecond struct {
+	Sec          int64   `json:"sec"`
+	BaselineTPS  float64 `json:"baseline_tps"`
+	CandidateTPS float64 `json:"candidate_tps"`
+	// P99 latencies in milliseconds, 0 if no transaction was answered
+	BaselineP99  float64 `json:"baseline_p99_ms"`
+	CandidateP99 float64 `json:"candidate_p99_ms"`
+}
+
+// Report compares a candidate load test with a baseline, second by second.
+// Its series only cover the aligned seconds, so their means can differ from
+// the whole-run averages of either load test.
+type Report struct {
+	Baseline   string     `json:"baseline"`
+	Candidate  string     `json:"candidate"`
+	Tolerances Tolerances `json:"tolerances"`
+	// The per-second series, TPS and bytes first, then every latency
+	// percentile
+	Series    []*SeriesComparison `json:"series"`
+	PerSecond []*AlignedSecond    `json:"per_second"`
+}
+
+// perSecondSeries are the series compared by NewReport.
+var perSecondSeries = []struct {
+	name           string
+	unit           string
+	higherIsBetter bool
+	// value returns the value of the series in the second, false if it has
+	// none
+	value func(*loadtestpb.PerSecond) (float64, bool)
+}{
+	{"tps", "tx/s", true, func(ps *loadtestpb.PerSecond) (float64, bool) { return ps.Qps, true }},
+	{"bytes_per_second", "B/s", true, func(ps *loadtestpb.PerSecond) (float64, bool) { return ps.BytesSent, true }},
+	{"p50_latency", "ms", false, latencyOf(func(r *loadtestpb.Ranking) *loadtestpb.Percentile { return r.P50 })},
+	{"p75_latency", "ms", false, latencyOf(func(r *loadtestpb.Ranking) *loadtestpb.Percentile { return r.P75 })},
+	{"p90_latency", "ms", false, latencyOf(func(r *loadtestpb.Ranking) *loadtestpb.Percentile { return r.P90 })},
+	{"p95_latency", "ms", false, latencyOf(func(r *loadtestpb.Ranking) *loadtestpb.Percentile { return r.P95 })},
+	{"p99_latency", "ms", false, latencyOf(func(r *loadtestpb.Ranking) *loadtestpb.Percentile { return r.P99 })},
+}
+
+// latencyOf returns the picked latency percentile of a second in
+// milliseconds, which it only has if the node answered a transaction.
+func latencyOf(pick func(*loadtestpb.Ranking) *loadtestpb.Percentile) func(*loadtestpb.PerSecond) (float64, bool) {
+	return func(ps *loadtestpb.PerSecond) (float64, bool) {
+		if ps.LatencyRankings == nil {
+			return 0, false
+		}
+		p := pick(ps.LatencyRankings)
+		if p == nil {
+			return 0, false
+		}
+		return float64(p.Latency.AsDuration()) / float64(time.Millisecond), true
+	}
+}
+
+// NewReport compares each per-second series of the candidate with that of
+// the baseline over the seconds in which both have a value, and counts the
+// seconds in which only one has. The labels name the two load tests in the
+// report.
+func NewReport(baselineLabel string, baseline *loadtestpb.RunLoadtestResponse, candidateLabel string, candidate *loadtestpb.RunLoadtestResponse, tolerances Tolerances) *Report {
+	if tolerances.Significance == 0 {
+		tolerances.Significance = DefaultSignificance
+	}
+	report := &Report{
+		Baseline:   baselineLabel,
+		Candidate:  candidateLabel,
+		Tolerances: tolerances,
+	}
+
+	// Pair up the seconds of either load test by their ordinal number. Those
+	// of only one load test are paired with nil.
+	type pair struct{ baseline, candidate *loadtestpb.PerSecond }
+	secs := make(map[int64]*pair, len(baseline.PerSec))
+	for _, ps := range baseline.PerSec {
+		secs[ps.Sec] = &pair{baseline: ps}
+	}
+	for _, ps := range candidate.PerSec {
+		if p, ok := secs[ps.Sec]; ok {
+			p.candidate = ps
+		} else {
+			secs[ps.Sec] = &pair{candidate: ps}
+		}
+	}
+	var pairs, aligned []pair
+	for _, p := range secs {
+		pairs = append(pairs, *p)
+		if p.baseline != nil && p.candidate != nil {
+			aligned = append(aligned, *p)
+		}
+	}
+	sort.Slice(aligned, func(i, j int) bool { return aligned[i].baseline.Sec < aligned[j].baseline.Sec })
+
+	p99 := perSecondSeries[len(perSecondSeries)-1].value
+	for _, p := range aligned {
+		b, _ := p99(p.baseline)
+		c, _ := p99(p.candidate)
+		report.PerSecond = append(report.PerSecond, &AlignedSecond{
+			Sec:          p.baseline.Sec,
+			BaselineTPS:  p.baseline.Qps,
+			CandidateTPS: p.candidate.Qps,
+			BaselineP99:  b,
+			CandidateP99: c,
+		})
+	}
+
+	for _, series := range perSecondSeries {
+		value := func(ps *loadtestpb.PerSecond) (float64, bool) {
+			if ps == nil {
+				return 0, false
+			}
+			return series.value(ps)
+		}
+		var b, c []float64
+		var baselineMissing, candidateMissing int
+		for _, p := range pairs {
+			bv, bok := value(p.baseline)
+			cv, cok := value(p.candidate)
+			switch {
+			case bok && cok:
+				b = append(b, bv)
+				c = append(c, cv)
+			case bok:
+				candidateMissing++
+			case cok:
+				baselineMissing++
+			}
+		}
+		tolerance := tolerances.Latency
+		switch series.name {
+		case "tps":
+			tolerance = tolerances.TPS
+		case "bytes_per_second":
+			tolerance = tolerances.Bytes
+		}
+		comparison := compareSeries(series.name, series.unit, series.higherIsBetter, b, c, tolerance, tolerances.Significance)
+		comparison.BaselineMissing = baselineMissing
+		comparison.CandidateMissing = candidateMissing
+		report.Series = append(report.Series, comparison)
+	}
+	return report
+}
+
+// compareSeries compares the means of two series of the same seconds, and
+// tests whether they differ significantly.
+func compareSeries(name, unit string, higherIsBetter bool, baseline, candidate []float64, tolerance, significance float64) *SeriesComparison {
+	comparison := &SeriesComparison{
+		Name:           name,
+		Unit:           unit,
+		HigherIsBetter: higherIsBetter,
+		Seconds:        len(baseline),
+		Baseline:       mean(baseline),
+		Candidate:      mean(candidate),
+		PValue:         -1,
+	}
+	comparison.Delta = comparison.Candidate - comparison.Baseline
+	if comparison.Baseline != 0 {
+		comparison.DeltaPercent = comparison.Delta / comparison.Baseline * 100
+	}
+	if len(baseline) >= 3 && len(candidate) >= 3 {
+		comparison.PValue = mannWhitneyU(baseline, candidate)
+		comparison.Significant = comparison.PValue < significance
+	}
+
+	// How much worse the candidate did, in percent
+	worse := comparison.DeltaPercent
+	if higherIsBetter {
+		worse = -worse
+	}
+	tested := comparison.PValue >= 0
+	comparison.Regression = worse > tolerance && (comparison.Significant || !tested)
+	comparison.Improvement = -worse > tolerance && comparison.Significant
+	return comparison
+}
+
+// Regressions returns the series in which the candidate regressed.
+func (r *Report) Regressions() []*SeriesComparison {
+	var regressions []*SeriesComparison
+	for _, series := range r.Series {
+		if series.Regression {
+			regressions = append(regressions, series)
+		}
+	}
+	return regressions
+}
+
+// Verdict sums up the report in a sentence.
+func (r *Report) Verdict() string {
+	regressions := r.Regressions()
+	if len(regressions) == 0 {
+		return fmt.Sprintf("No regression beyond the tolerances over %d aligned seconds", len(r.PerSecond))
+	}
+	names := make([]string, 0, len(regressions))
+	for _, series := range regressions {
+		names = append(names, fmt.Sprintf("%s (%+.1f%%)", series.Name, series.DeltaPercent))
+	}
+	return fmt.Sprintf("%d regression(s) beyond the tolerances: %s", len(regressions), strings.Join(names, ", "))
+}
+
+// FormatPValue formats a p-value, which is -1 when it could not be computed.
+func FormatPValue(p float64) string {
+	if p < 0 {
+		return "n/a"
+	}
+	if p < 0.001 {
+		return "<0.001"
+	}
+	return fmt.Sprintf("%.3f", p)
+}
+
+// WriteMarkdown writes the report as Markdown, with regressions in bold.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Load test comparison\n\n")
+	fmt.Fprintf(&b, "Baseline: `%s`  \nCandidate: `%s`\n\n", r.Baseline, r.Candidate)
+	fmt.Fprintf(&b, "Tolerances: TPS -%g%%, bytes -%g%%, latency +%g%%, significance p < %g\n\n",
+		r.Tolerances.TPS, r.Tolerances.Bytes, r.Tolerances.Latency, r.Tolerances.Significance)
+	fmt.Fprintf(&b, "**%s**\n\n", r.Verdict())
+
+	fmt.Fprintf(&b, "## Per-second series\n\n")
+	fmt.Fprintf(&b, "| Series | Baseline | Candidate | Delta | Delta %% | p-value | Missing | Result |\n")
+	fmt.Fprintf(&b, "|--------|---------:|----------:|------:|--------:|--------:|--------:|--------|\n")
+	for _, s := range r.Series {
+		row := fmt.Sprintf("%s | %.2f %s | %.2f %s | %+.2f | %+.1f%% | %s | %s | %s",
+			s.Name, s.Baseline, s.Unit, s.Candidate, s.Unit, s.Delta, s.DeltaPercent, FormatPValue(s.PValue), FormatMissing(s), seriesResult(s))
+		if s.Regression {
+			row = "**" + strings.ReplaceAll(row, " | ", "** | **") + "**"
+		}
+		fmt.Fprintf(&b, "| %s |\n", row)
+	}
+
+	fmt.Fprintf(&b, "\n## Aligned seconds\n\n")
+	fmt.Fprintf(&b, "| Second | Baseline TPS | Candidate TPS | Baseline p99 (ms) | Candidate p99 (ms) |\n")
+	fmt.Fprintf(&b, "|-------:|-------------:|--------------:|------------------:|-------------------:|\n")
+	for _, ps := range r.PerSecond {
+		fmt.Fprintf(&b, "| %d | %.0f | %.0f | %.2f | %.2f |\n", ps.Sec, ps.BaselineTPS, ps.CandidateTPS, ps.BaselineP99, ps.CandidateP99)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// FormatMissing formats the seconds missing from the baseline and the
+// candidate in a series.
+func FormatMissing(s *SeriesComparison) string {
+	return fmt.Sprintf("%d/%d", s.BaselineMissing, s.CandidateMissing)
+}
+
+// seriesResult says how the candidate did in a series.
+func seriesResult(s *SeriesComparison) string {
+	switch {
+	case s.Regression:
+		return "regression"
+	case s.Improvement:
+		return "improvement"
+	case s.Significant:
+		return "within tolerance"
+	default:
+		return "no significant change"
+	}
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"pvalue":  FormatPValue,
+	"missing": FormatMissing,
+	"result":  seriesResult,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Load test comparison</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ddd; padding: 4px 10px; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+th { background: #f4f4f4; }
+tr.regression { background: #fde2e2; color: #a00; font-weight: bold; }
+tr.improvement { background: #e2f5e5; color: #060; }
+.verdict { font-size: 1.2em; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>Load test comparison</h1>
+<p>Baseline: <code>{{.Baseline}}</code><br>Candidate: <code>{{.Candidate}}</code></p>
+<p>Tolerances: TPS -{{.Tolerances.TPS}}%, bytes -{{.Tolerances.Bytes}}%, latency +{{.Tolerances.Latency}}%, significance p &lt; {{.Tolerances.Significance}}</p>
+<p class="verdict">{{.Verdict}}</p>
+<h2>Per-second series</h2>
+<table>
+<tr><th>Series</th><th>Baseline</th><th>Candidate</th><th>Delta</th><th>Delta %</th><th>p-value</th><th>Missing</th><th>Result</th></tr>
+{{range .Series}}<tr class="{{if .Regression}}regression{{else if .Improvement}}improvement{{end}}">
+<td>{{.Name}}</td><td>{{printf "%.2f" .Baseline}} {{.Unit}}</td><td>{{printf "%.2f" .Candidate}} {{.Unit}}</td><td>{{printf "%+.2f" .Delta}}</td><td>{{printf "%+.1f" .DeltaPercent}}%</td><td>{{pvalue .PValue}}</td><td>{{missing .}}</td><td>{{result .}}</td>
+</tr>
+{{end}}</table>
+<h2>Aligned seconds</h2>
+<table>
+<tr><th>Second</th><th>Baseline TPS</th><th>Candidate TPS</th><th>Baseline p99 (ms)</th><th>Candidate p99 (ms)</th></tr>
+{{range .PerSecond}}<tr><td>{{.Sec}}</td><td>{{printf "%.0f" .BaselineTPS}}</td><td>{{printf "%.0f" .CandidateTPS}}</td><td>{{printf "%.2f" .BaselineP99}}</td><td>{{printf "%.2f" .CandidateP99}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the report as a standalone HTML page, with regressions
+// highlighted.
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlReport.Execute(w, r)
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// mannWhitneyU returns the two-sided p-value of a Mann-Whitney U test of
+// whether the two samples come from the same distribution, by the normal
+// approximation with a correction for ties. Unlike a t-test, it doesn't
+// assume the per-second values to be normally distributed, which latencies
+// seldom are.
+func mannWhitneyU(a, b []float64) float64 {
+	type value struct {
+		v     float64
+		fromA bool
+	}
+	values := make([]value, 0, len(a)+len(b))
+	for _, v := range a {
+		values = append(values, value{v, true})
+	}
+	for _, v := range b {
+		values = append(values, value{v, false})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].v < values[j].v })
+
+	// Rank the values, giving tied values the mean of their ranks
+	n := float64(len(values))
+	var rankSumA, ties float64
+	for i := 0; i < len(values); {
+		j := i
+		for j < len(values) && values[j].v == values[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if values[k].fromA {
+				rankSumA += rank
+			}
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+
+	n1, n2 := float64(len(a)), float64(len(b))
+	u := rankSumA - n1*(n1+1)/2
+	mu := n1 * n2 / 2
+	sigma := math.Sqrt(n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1))))
+	if sigma == 0 {
+		return 1
+	}
+	// Continuity correction
+	z := math.Max(math.Abs(u-mu)-0.5, 0) / sigma
+	return math.Erfc(z / math.Sqrt2)
+}
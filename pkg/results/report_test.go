@@ -0,0 +1,237 @@
+package results
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float64
+		want float64
+	}{
+		{
+			name: "fully separated",
+			a:    []float64{1, 2, 3, 4, 5},
+			b:    []float64{6, 7, 8, 9, 10},
+			want: 0.012186,
+		},
+		{
+			name: "fully separated, swapped",
+			a:    []float64{6, 7, 8, 9, 10},
+			b:    []float64{1, 2, 3, 4, 5},
+			want: 0.012186,
+		},
+		{
+			name: "interleaved",
+			a:    []float64{1, 3, 5, 7, 9},
+			b:    []float64{2, 4, 6, 8, 10},
+			want: 0.676103,
+		},
+		{
+			name: "ties",
+			a:    []float64{1, 2, 2, 3, 3},
+			b:    []float64{3, 4, 4, 5, 5},
+			want: 0.018866,
+		},
+		{
+			name: "unequal sizes",
+			a:    []float64{10, 20, 30},
+			b:    []float64{1, 2, 3, 4, 5, 6, 7, 8},
+			want: 0.018904,
+		},
+		{
+			name: "all values tied",
+			a:    []float64{4, 4, 4},
+			b:    []float64{4, 4, 4, 4},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mannWhitneyU(tt.a, tt.b); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("mannWhitneyU() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareSeries(t *testing.T) {
+	tests := []struct {
+		name            string
+		higherIsBetter  bool
+		baseline        []float64
+		candidate       []float64
+		tolerance       float64
+		wantDelta       float64
+		wantPercent     float64
+		wantTested      bool
+		wantSignificant bool
+		wantRegression  bool
+		wantImprovement bool
+	}{
+		{
+			name:            "significant TPS drop beyond the tolerance",
+			higherIsBetter:  true,
+			baseline:        []float64{100, 101, 99, 100, 102, 98},
+			candidate:       []float64{80, 81, 79, 80, 82, 78},
+			tolerance:       5,
+			wantDelta:       -20,
+			wantPercent:     -20,
+			wantTested:      true,
+			wantSignificant: true,
+			wantRegression:  true,
+		},
+		{
+			name:            "significant TPS drop within the tolerance",
+			higherIsBetter:  true,
+			baseline:        []float64{100, 101, 99, 100, 102, 98},
+			candidate:       []float64{97, 98, 96, 97, 99, 95},
+			tolerance:       5,
+			wantDelta:       -3,
+			wantPercent:     -3,
+			wantTested:      true,
+			wantSignificant: true,
+		},
+		{
+			name:           "TPS drop that is not significant",
+			higherIsBetter: true,
+			baseline:       []float64{50, 150, 100, 60, 140},
+			candidate:      []float64{40, 160, 80, 55, 115},
+			tolerance:      5,
+			wantDelta:      -10,
+			wantPercent:    -10,
+			wantTested:     true,
+		},
+		{
+			name:           "untested TPS drop beyond the tolerance",
+			higherIsBetter: true,
+			baseline:       []float64{100, 100},
+			candidate:      []float64{50, 50},
+			tolerance:      5,
+			wantDelta:      -50,
+			wantPercent:    -50,
+			wantRegression: true,
+		},
+		{
+			name:            "significant latency rise",
+			baseline:        []float64{10, 11, 9, 10, 12, 8},
+			candidate:       []float64{20, 21, 19, 20, 22, 18},
+			tolerance:       10,
+			wantDelta:       10,
+			wantPercent:     100,
+			wantTested:      true,
+			wantSignificant: true,
+			wantRegression:  true,
+		},
+		{
+			name:            "significant latency drop",
+			baseline:        []float64{20, 21, 19, 20, 22, 18},
+			candidate:       []float64{10, 11, 9, 10, 12, 8},
+			tolerance:       10,
+			wantDelta:       -10,
+			wantPercent:     -50,
+			wantTested:      true,
+			wantSignificant: true,
+			wantImprovement: true,
+		},
+		{
+			name:           "zero baseline",
+			higherIsBetter: true,
+			baseline:       []float64{0, 0, 0},
+			candidate:      []float64{0, 0, 0},
+			tolerance:      5,
+			wantTested:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareSeries("series", "unit", tt.higherIsBetter, tt.baseline, tt.candidate, tt.tolerance, DefaultSignificance)
+			if math.Abs(got.Delta-tt.wantDelta) > 1e-9 || math.Abs(got.DeltaPercent-tt.wantPercent) > 1e-9 {
+				t.Errorf("delta = %f (%f%%), want %f (%f%%)", got.Delta, got.DeltaPercent, tt.wantDelta, tt.wantPercent)
+			}
+			if tested := got.PValue >= 0; tested != tt.wantTested {
+				t.Errorf("p-value = %f, want tested %t", got.PValue, tt.wantTested)
+			}
+			if got.Significant != tt.wantSignificant {
+				t.Errorf("significant = %t (p = %f), want %t", got.Significant, got.PValue, tt.wantSignificant)
+			}
+			if got.Regression != tt.wantRegression {
+				t.Errorf("regression = %t, want %t", got.Regression, tt.wantRegression)
+			}
+			if got.Improvement != tt.wantImprovement {
+				t.Errorf("improvement = %t, want %t", got.Improvement, tt.wantImprovement)
+			}
+		})
+	}
+}
+
+func TestNewReportAlignsSeconds(t *testing.T) {
+	second := func(sec int64, p99 time.Duration) *loadtestpb.PerSecond {
+		ps := &loadtestpb.PerSecond{Sec: sec, Qps: 10, BytesSent: 100}
+		if p99 > 0 {
+			ps.LatencyRankings = &loadtestpb.Ranking{P99: &loadtestpb.Percentile{Latency: durationpb.New(p99)}}
+		}
+		return ps
+	}
+	baseline := &loadtestpb.RunLoadtestResponse{PerSec: []*loadtestpb.PerSecond{
+		second(0, 10*time.Millisecond),
+		second(1, 10*time.Millisecond),
+		second(2, 10*time.Millisecond),
+		second(3, 10*time.Millisecond),
+	}}
+	// The candidate only had failures in second 1, and ran a second longer
+	candidate := &loadtestpb.RunLoadtestResponse{PerSec: []*loadtestpb.PerSecond{
+		second(4, 20*time.Millisecond),
+		second(0, 20*time.Millisecond),
+		second(1, 0),
+		second(2, 20*time.Millisecond),
+		second(3, 20*time.Millisecond),
+	}}
+
+	report := NewReport("baseline", baseline, "candidate", candidate, DefaultTolerances)
+	if len(report.PerSecond) != 4 {
+		t.Errorf("%d aligned seconds, want 4", len(report.PerSecond))
+	}
+	for i, ps := range report.PerSecond {
+		if ps.Sec != int64(i) {
+			t.Errorf("aligned second %d is second %d", i, ps.Sec)
+		}
+	}
+
+	tests := []struct {
+		series                            string
+		seconds                           int
+		baselineMissing, candidateMissing int
+		baseline, candidate               float64
+	}{
+		{"tps", 4, 1, 0, 10, 10},
+		{"p99_latency", 3, 1, 1, 10, 20},
+		// No second has a p50
+		{"p50_latency", 0, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		var got *SeriesComparison
+		for _, series := range report.Series {
+			if series.Name == tt.series {
+				got = series
+			}
+		}
+		if got == nil {
+			t.Fatalf("no %s series", tt.series)
+		}
+		if got.Seconds != tt.seconds || got.BaselineMissing != tt.baselineMissing || got.CandidateMissing != tt.candidateMissing {
+			t.Errorf("%s over %d seconds, %d missing from the baseline and %d from the candidate, want %d, %d and %d",
+				tt.series, got.Seconds, got.BaselineMissing, got.CandidateMissing, tt.seconds, tt.baselineMissing, tt.candidateMissing)
+		}
+		if got.Baseline != tt.baseline || got.Candidate != tt.candidate {
+			t.Errorf("%s means = %f and %f, want %f and %f", tt.series, got.Baseline, got.Candidate, tt.baseline, tt.candidate)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package results
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// ErrNotFound is returned when no load test has the requested ID.
+var ErrNotFound = errors.New("load test not found")
+
+// Store keeps the history of the load tests run by the server.
+type Store interface {
+	// SaveLoadtest creates the record of a load test, or replaces it if one
+	// with the same ID exists.
+	SaveLoadtest(ctx context.Context, lt *loadtestpb.Loadtest) error
+	// GetLoadtest returns the load test with the given ID, or ErrNotFound.
+	GetLoadtest(ctx context.Context, id string) (*loadtestpb.Loadtest, error)
+	// ListLoadtests returns up to limit load tests listed after the cursor,
+	// or from the start if it is nil, most recently started first, and
+	// whether there are more. Results are left out to keep listings small.
+	ListLoadtests(ctx context.Context, after *Cursor, limit int) ([]*loadtestpb.Loadtest, bool, error)
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// Cursor is the position of a load test in listings, which are ordered by
+// start time, most recent first, then by ID. Paging by cursor rather than by
+// offset keeps pages stable while new load tests are started.
+type Cursor struct {
+	StartTime time.Time
+	ID        string
+}
+
+// CursorOf returns the position of the load test in listings.
+func CursorOf(lt *loadtestpb.Loadtest) *Cursor {
+	return &Cursor{StartTime: lt.StartTime.AsTime(), ID: lt.Id}
+}
+
+// After reports whether a load test started at startTime with the given ID
+// is listed after the cursor.
+func (c *Cursor) After(startTime time.Time, id string) bool {
+	if startTime.Equal(c.StartTime) {
+		return id > c.ID
+	}
+	return startTime.Before(c.StartTime)
+}
+
+// Token encodes the cursor as an opaque page token.
+func (c *Cursor) Token() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.StartTime.UTC().Format(time.RFC3339Nano) + "|" + c.ID))
+}
+
+// ParseCursor decodes a page token made by Token.
+func ParseCursor(token string) (*Cursor, error) {
+	blob, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token")
+	}
+	startTime, id, ok := strings.Cut(string(blob), "|")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("malformed page token")
+	}
+	t, err := time.Parse(time.RFC3339Nano, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token")
+	}
+	return &Cursor{StartTime: t, ID: id}, nil
+}
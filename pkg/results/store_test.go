@@ -0,0 +1,159 @@
+package results
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+// testDatabaseURLEnv names the variable pointing the PostgresStore tests at
+// a scratch database. Its loadtests table is emptied by the tests.
+const testDatabaseURLEnv = "COSMOSLOADTESTER_TEST_DATABASE_URL"
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestPostgresStore(t *testing.T) {
+	databaseURL := os.Getenv(testDatabaseURLEnv)
+	if databaseURL == "" {
+		t.Skipf("%s is not set", testDatabaseURLEnv)
+	}
+	ctx := context.Background()
+	store, err := NewPostgresStore(ctx, databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if _, err := store.db.ExecContext(ctx, "DELETE FROM loadtests"); err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+}
+
+// testStore checks the behavior every Store must have.
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := store.GetLoadtest(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetLoadtest(missing) error = %v, want ErrNotFound", err)
+	}
+
+	// Load tests "0" to "4" started a minute apart, and "5" at the same time
+	// as "4"
+	for i := 0; i < 6; i++ {
+		startTime := start.Add(time.Duration(i) * time.Minute)
+		if i == 5 {
+			startTime = start.Add(4 * time.Minute)
+		}
+		lt := &loadtestpb.Loadtest{
+			Id:        strconv.Itoa(i),
+			State:     loadtestpb.Loadtest_STATE_RUNNING,
+			StartTime: timestamppb.New(startTime),
+			Request:   &loadtestpb.RunLoadtestRequest{ClientFactory: "test"},
+			Result:    &loadtestpb.RunLoadtestResponse{TotalTxs: int64(i)},
+		}
+		if err := store.SaveLoadtest(ctx, lt); err != nil {
+			t.Fatalf("SaveLoadtest(%d): %v", i, err)
+		}
+	}
+
+	// Saving again replaces the record
+	finished := &loadtestpb.Loadtest{
+		Id:        "2",
+		State:     loadtestpb.Loadtest_STATE_SUCCEEDED,
+		StartTime: timestamppb.New(start.Add(2 * time.Minute)),
+		EndTime:   timestamppb.New(start.Add(3 * time.Minute)),
+		Request:   &loadtestpb.RunLoadtestRequest{ClientFactory: "test"},
+		Result:    &loadtestpb.RunLoadtestResponse{TotalTxs: 42},
+	}
+	if err := store.SaveLoadtest(ctx, finished); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.GetLoadtest(ctx, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != loadtestpb.Loadtest_STATE_SUCCEEDED || got.Result.GetTotalTxs() != 42 ||
+		!got.EndTime.AsTime().Equal(finished.EndTime.AsTime()) || got.Request.GetClientFactory() != "test" {
+		t.Errorf("GetLoadtest(2) = %v, want %v", got, finished)
+	}
+
+	// The cursor of each load test, by ID
+	cursorOf := func(id string) *Cursor {
+		if id == "" {
+			return nil
+		}
+		i, _ := strconv.Atoi(id)
+		if i == 5 {
+			i = 4
+		}
+		return &Cursor{StartTime: start.Add(time.Duration(i) * time.Minute), ID: id}
+	}
+	tests := []struct {
+		after    string
+		limit    int
+		wantIDs  []string
+		wantMore bool
+	}{
+		{"", 2, []string{"4", "5"}, true},
+		{"5", 2, []string{"3", "2"}, true},
+		{"2", 2, []string{"1", "0"}, false},
+		{"", 10, []string{"4", "5", "3", "2", "1", "0"}, false},
+		// Load tests started at the same time are ordered by ID
+		{"4", 10, []string{"5", "3", "2", "1", "0"}, false},
+		{"0", 2, nil, false},
+	}
+	for _, tt := range tests {
+		page, more, err := store.ListLoadtests(ctx, cursorOf(tt.after), tt.limit)
+		if err != nil {
+			t.Fatalf("ListLoadtests(%q, %d): %v", tt.after, tt.limit, err)
+		}
+		var ids []string
+		for _, lt := range page {
+			ids = append(ids, lt.Id)
+			if lt.Result != nil {
+				t.Errorf("ListLoadtests(%q, %d) includes the result of %s", tt.after, tt.limit, lt.Id)
+			}
+		}
+		if !slices.Equal(ids, tt.wantIDs) || more != tt.wantMore {
+			t.Errorf("ListLoadtests(%q, %d) = %v, %t, want %v, %t", tt.after, tt.limit, ids, more, tt.wantIDs, tt.wantMore)
+		}
+	}
+}
+
+func TestCursorToken(t *testing.T) {
+	cursor := &Cursor{StartTime: time.Date(2024, 1, 1, 0, 0, 0, 123, time.UTC), ID: "a|b"}
+	got, err := ParseCursor(cursor.Token())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.StartTime.Equal(cursor.StartTime) || got.ID != cursor.ID {
+		t.Errorf("ParseCursor(Token()) = %+v, want %+v", got, cursor)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"offset", "20"},
+		{"not base64", "!!!"},
+		{"no ID", base64.RawURLEncoding.EncodeToString([]byte("2024-01-01T00:00:00Z|"))},
+		{"invalid time", base64.RawURLEncoding.EncodeToString([]byte("yesterday|1"))},
+	}
+	for _, tt := range tests {
+		if _, err := ParseCursor(tt.token); err == nil {
+			t.Errorf("%s: ParseCursor(%q) succeeded, want an error", tt.name, tt.token)
+		}
+	}
+}
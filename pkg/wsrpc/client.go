@@ -0,0 +1,257 @@
+package wsrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+
+	"github.com/orijtech/cosmosloadtester/pkg/httprpc"
+)
+
+const (
+	// How long to wait for the response to a request
+	requestTimeout = 30 * time.Second
+	// How long to wait for a write to the socket to complete
+	writeTimeout = 10 * time.Second
+)
+
+// WSRPCClient provides the Tendermint JSON-RPC API over a WebSocket
+// connection. Unlike tm-load-test's transactor it reads the response to every
+// request, so that callers can measure round-trip latency.
+type WSRPCClient struct {
+	endpoint string
+	conn     *websocket.Conn
+	logger   *logrus.Logger
+
+	writeMtx sync.Mutex
+
+	mutex         sync.Mutex
+	requestID     int64
+	pending       map[int64]chan *httprpc.JSONRPCResponse
+	subscriptions map[int64]chan<- json.RawMessage
+	closed        bool
+	closeErr      error
+	done          chan struct{}
+}
+
+// message is a response or, for subscriptions, an event. Tendermint v0.34
+// sends events with a string ID such as "1#event".
+type message struct {
+	ID     json.RawMessage       `json:"id"`
+	Result json.RawMessage       `json:"result,omitempty"`
+	Error  *httprpc.JSONRPCError `json:"error,omitempty"`
+}
+
+// NewWSRPCClient connects to the given ws:// or wss:// endpoint.
+func NewWSRPCClient(endpoint string) (*WSRPCClient, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, fmt.Errorf("unsupported protocol: %s (ws:// and wss:// required for WebSocket RPC)", u.Scheme)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", u.String(), err)
+	}
+
+	logger := logrus.WithField("component", fmt.Sprintf("ws-rpc[%s]", u.String())).Logger
+
+	c := &WSRPCClient{
+		endpoint:      u.String(),
+		conn:          conn,
+		logger:        logger,
+		requestID:     1,
+		pending:       make(map[int64]chan *httprpc.JSONRPCResponse),
+		subscriptions: make(map[int64]chan<- json.RawMessage),
+		done:          make(chan struct{}),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// BroadcastTx sends a transaction and waits for the node's response.
+func (c *WSRPCClient) BroadcastTx(method string, txBytes []byte) (*httprpc.BroadcastTxResponse, error) {
+	rpcResponse, err := c.call(method, map[string]interface{}{
+		"tx": txBytes,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result httprpc.BroadcastTxResponse
+	if err := rpcResponse.DecodeResult(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Subscribe subscribes to the events matching query, e.g. "tm.event='Tx'",
+// and sends the result of every event to events until the connection is
+// closed. Responses are read by the same goroutine, so events must be
+// consumed promptly.
+func (c *WSRPCClient) Subscribe(query string, events chan<- json.RawMessage) error {
+	rpcResponse, err := c.call("subscribe", map[string]interface{}{
+		"query": query,
+	}, events)
+	if err == nil {
+		err = rpcResponse.DecodeResult(&struct{}{})
+	}
+	if err != nil {
+		c.mutex.Lock()
+		for id, ch := range c.subscriptions {
+			if ch == events {
+				delete(c.subscriptions, id)
+			}
+		}
+		c.mutex.Unlock()
+		return fmt.Errorf("failed to subscribe to %q: %w", query, err)
+	}
+	return nil
+}
+
+// call sends a JSON-RPC request and waits for the matching response. If
+// events is not nil, later messages with the ID of the request are sent to it.
+func (c *WSRPCClient) call(method string, params interface{}, events chan<- json.RawMessage) (*httprpc.JSONRPCResponse, error) {
+	respCh := make(chan *httprpc.JSONRPCResponse, 1)
+
+	c.mutex.Lock()
+	if c.closed {
+		c.mutex.Unlock()
+		return nil, fmt.Errorf("connection closed: %v", c.closeErr)
+	}
+	reqID := c.requestID
+	c.requestID++
+	c.pending[reqID] = respCh
+	if events != nil {
+		c.subscriptions[reqID] = events
+	}
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		delete(c.pending, reqID)
+		c.mutex.Unlock()
+	}()
+
+	request := httprpc.JSONRPCRequest{
+		JSONRPC: "2.0",
+		ID:      reqID,
+		Method:  method,
+		Params:  params,
+	}
+
+	c.writeMtx.Lock()
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	err := c.conn.WriteJSON(request)
+	c.writeMtx.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write request: %w", err)
+	}
+
+	timer := time.NewTimer(requestTimeout)
+	defer timer.Stop()
+
+	select {
+	case resp := <-respCh:
+		return resp, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("%w to %s", httprpc.ErrTimeout, method)
+	case <-c.done:
+		return nil, fmt.Errorf("connection closed: %v", c.closeErr)
+	}
+}
+
+// readLoop dispatches every response to the request waiting for it, and
+// every event to its subscription.
+func (c *WSRPCClient) readLoop() {
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.logger.Debugf("Ignoring malformed message: %v", err)
+			continue
+		}
+		id, isEvent, err := parseMessageID(msg.ID)
+		if err != nil {
+			c.logger.Debugf("Ignoring message with unexpected ID %s", msg.ID)
+			continue
+		}
+
+		// The first message with the ID of a subscription is the response
+		// to the subscribe request, the following ones are events
+		c.mutex.Lock()
+		respCh, isResponse := c.pending[id]
+		if isEvent {
+			isResponse = false
+		} else if isResponse {
+			delete(c.pending, id)
+		}
+		events := c.subscriptions[id]
+		c.mutex.Unlock()
+
+		switch {
+		case isResponse:
+			respCh <- &httprpc.JSONRPCResponse{
+				JSONRPC: "2.0",
+				ID:      id,
+				Result:  msg.Result,
+				Error:   msg.Error,
+			}
+		case events != nil && len(msg.Result) > 0:
+			select {
+			case events <- msg.Result:
+			case <-c.done:
+			}
+		}
+	}
+}
+
+// parseMessageID parses both numeric IDs and the "<id>#event" IDs of events.
+func parseMessageID(raw json.RawMessage) (id int64, isEvent bool, err error) {
+	s := strings.Trim(string(raw), `"`)
+	if before, found := strings.CutSuffix(s, "#event"); found {
+		s, isEvent = before, true
+	}
+	id, err = strconv.ParseInt(s, 10, 64)
+	return id, isEvent, err
+}
+
+func (c *WSRPCClient) shutdown(err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.closeErr = err
+	close(c.done)
+}
+
+// Close closes the underlying WebSocket connection.
+func (c *WSRPCClient) Close() error {
+	c.writeMtx.Lock()
+	c.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(writeTimeout),
+	)
+	c.writeMtx.Unlock()
+
+	c.shutdown(fmt.Errorf("client closed"))
+	return c.conn.Close()
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: orijtech/cosmosloadtester/plugin/v1/plugin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClientFactoryPluginClient is the client API for ClientFactoryPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClientFactoryPluginClient interface {
+	// Lists the client factories the plugin serves. It is called once, after
+	// the handshake.
+	ListClientFactories(ctx context.Context, in *ListClientFactoriesRequest, opts ...grpc.CallOption) (*ListClientFactoriesResponse, error)
+	// Checks that a client factory can generate transactions for a load test.
+	// Maps to ClientFactory.ValidateConfig.
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
+	// Creates a client, for one connection of a load test. Maps to
+	// ClientFactory.NewClient.
+	NewClient(ctx context.Context, in *NewClientRequest, opts ...grpc.CallOption) (*NewClientResponse, error)
+	// Generates the next transaction of a client. Maps to Client.GenerateTx.
+	GenerateTx(ctx context.Context, in *GenerateTxRequest, opts ...grpc.CallOption) (*GenerateTxResponse, error)
+	// Releases a client once its load test is over. Its ID is unknown to
+	// GenerateTx afterwards.
+	ReleaseClient(ctx context.Context, in *ReleaseClientRequest, opts ...grpc.CallOption) (*ReleaseClientResponse, error)
+}
+
+type clientFactoryPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientFactoryPluginClient(cc grpc.ClientConnInterface) ClientFactoryPluginClient {
+	return &clientFactoryPluginClient{cc}
+}
+
+func (c *clientFactoryPluginClient) ListClientFactories(ctx context.Context, in *ListClientFactoriesRequest, opts ...grpc.CallOption) (*ListClientFactoriesResponse, error) {
+	out := new(ListClientFactoriesResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ListClientFactories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientFactoryPluginClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	out := new(ValidateConfigResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ValidateConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientFactoryPluginClient) NewClient(ctx context.Context, in *NewClientRequest, opts ...grpc.CallOption) (*NewClientResponse, error) {
+	out := new(NewClientResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/NewClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientFactoryPluginClient) GenerateTx(ctx context.Context, in *GenerateTxRequest, opts ...grpc.CallOption) (*GenerateTxResponse, error) {
+	out := new(GenerateTxResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/GenerateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientFactoryPluginClient) ReleaseClient(ctx context.Context, in *ReleaseClientRequest, opts ...grpc.CallOption) (*ReleaseClientResponse, error) {
+	out := new(ReleaseClientResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ReleaseClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientFactoryPluginServer is the server API for ClientFactoryPlugin service.
+// All implementations must embed UnimplementedClientFactoryPluginServer
+// for forward compatibility
+type ClientFactoryPluginServer interface {
+	// Lists the client factories the plugin serves. It is called once, after
+	// the handshake.
+	ListClientFactories(context.Context, *ListClientFactoriesRequest) (*ListClientFactoriesResponse, error)
+	// Checks that a client factory can generate transactions for a load test.
+	// Maps to ClientFactory.ValidateConfig.
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
+	// Creates a client, for one connection of a load test. Maps to
+	// ClientFactory.NewClient.
+	NewClient(context.Context, *NewClientRequest) (*NewClientResponse, error)
+	// Generates the next transaction of a client. Maps to Client.GenerateTx.
+	GenerateTx(context.Context, *GenerateTxRequest) (*GenerateTxResponse, error)
+	// Releases a client once its load test is over. Its ID is unknown to
+	// GenerateTx afterwards.
+	ReleaseClient(context.Context, *ReleaseClientRequest) (*ReleaseClientResponse, error)
+	mustEmbedUnimplementedClientFactoryPluginServer()
+}
+
+// UnimplementedClientFactoryPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedClientFactoryPluginServer struct {
+}
+
+func (UnimplementedClientFactoryPluginServer) ListClientFactories(context.Context, *ListClientFactoriesRequest) (*ListClientFactoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientFactories not implemented")
+}
+func (UnimplementedClientFactoryPluginServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
+func (UnimplementedClientFactoryPluginServer) NewClient(context.Context, *NewClientRequest) (*NewClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewClient not implemented")
+}
+func (UnimplementedClientFactoryPluginServer) GenerateTx(context.Context, *GenerateTxRequest) (*GenerateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTx not implemented")
+}
+func (UnimplementedClientFactoryPluginServer) ReleaseClient(context.Context, *ReleaseClientRequest) (*ReleaseClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseClient not implemented")
+}
+func (UnimplementedClientFactoryPluginServer) mustEmbedUnimplementedClientFactoryPluginServer() {}
+
+// UnsafeClientFactoryPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientFactoryPluginServer will
+// result in compilation errors.
+type UnsafeClientFactoryPluginServer interface {
+	mustEmbedUnimplementedClientFactoryPluginServer()
+}
+
+func RegisterClientFactoryPluginServer(s grpc.ServiceRegistrar, srv ClientFactoryPluginServer) {
+	s.RegisterService(&ClientFactoryPlugin_ServiceDesc, srv)
+}
+
+func _ClientFactoryPlugin_ListClientFactories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientFactoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientFactoryPluginServer).ListClientFactories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ListClientFactories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientFactoryPluginServer).ListClientFactories(ctx, req.(*ListClientFactoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientFactoryPlugin_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientFactoryPluginServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ValidateConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientFactoryPluginServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientFactoryPlugin_NewClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientFactoryPluginServer).NewClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/NewClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientFactoryPluginServer).NewClient(ctx, req.(*NewClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientFactoryPlugin_GenerateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientFactoryPluginServer).GenerateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/GenerateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientFactoryPluginServer).GenerateTx(ctx, req.(*GenerateTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientFactoryPlugin_ReleaseClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientFactoryPluginServer).ReleaseClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin/ReleaseClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientFactoryPluginServer).ReleaseClient(ctx, req.(*ReleaseClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientFactoryPlugin_ServiceDesc is the grpc.ServiceDesc for ClientFactoryPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientFactoryPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orijtech.cosmosloadtester.plugin.v1.ClientFactoryPlugin",
+	HandlerType: (*ClientFactoryPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListClientFactories",
+			Handler:    _ClientFactoryPlugin_ListClientFactories_Handler,
+		},
+		{
+			MethodName: "ValidateConfig",
+			Handler:    _ClientFactoryPlugin_ValidateConfig_Handler,
+		},
+		{
+			MethodName: "NewClient",
+			Handler:    _ClientFactoryPlugin_NewClient_Handler,
+		},
+		{
+			MethodName: "GenerateTx",
+			Handler:    _ClientFactoryPlugin_GenerateTx_Handler,
+		},
+		{
+			MethodName: "ReleaseClient",
+			Handler:    _ClientFactoryPlugin_ReleaseClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orijtech/cosmosloadtester/plugin/v1/plugin.proto",
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -131,6 +132,365 @@ func (RunLoadtestRequest_EndpointSelectMethod) EnumDescriptor() ([]byte, []int)
 	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{0, 1}
 }
 
+type RunLoadtestRequest_StatsOutputFormat int32
+
+const (
+	// Default value, the same as STATS_OUTPUT_FORMAT_CSV.
+	RunLoadtestRequest_STATS_OUTPUT_FORMAT_UNSPECIFIED RunLoadtestRequest_StatsOutputFormat = 0
+	// The aggregate statistics in the CSV format of tm-load-test's --stats-output.
+	RunLoadtestRequest_STATS_OUTPUT_FORMAT_CSV RunLoadtestRequest_StatsOutputFormat = 1
+	// The whole RunLoadtestResponse as JSON.
+	RunLoadtestRequest_STATS_OUTPUT_FORMAT_JSON RunLoadtestRequest_StatsOutputFormat = 2
+	// The per-second series of the aggregate and of every endpoint as JSON,
+	// with one array per column.
+	RunLoadtestRequest_STATS_OUTPUT_FORMAT_COLUMNAR RunLoadtestRequest_StatsOutputFormat = 3
+)
+
+// Enum value maps for RunLoadtestRequest_StatsOutputFormat.
+var (
+	RunLoadtestRequest_StatsOutputFormat_name = map[int32]string{
+		0: "STATS_OUTPUT_FORMAT_UNSPECIFIED",
+		1: "STATS_OUTPUT_FORMAT_CSV",
+		2: "STATS_OUTPUT_FORMAT_JSON",
+		3: "STATS_OUTPUT_FORMAT_COLUMNAR",
+	}
+	RunLoadtestRequest_StatsOutputFormat_value = map[string]int32{
+		"STATS_OUTPUT_FORMAT_UNSPECIFIED": 0,
+		"STATS_OUTPUT_FORMAT_CSV":         1,
+		"STATS_OUTPUT_FORMAT_JSON":        2,
+		"STATS_OUTPUT_FORMAT_COLUMNAR":    3,
+	}
+)
+
+func (x RunLoadtestRequest_StatsOutputFormat) Enum() *RunLoadtestRequest_StatsOutputFormat {
+	p := new(RunLoadtestRequest_StatsOutputFormat)
+	*p = x
+	return p
+}
+
+func (x RunLoadtestRequest_StatsOutputFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunLoadtestRequest_StatsOutputFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[2].Descriptor()
+}
+
+func (RunLoadtestRequest_StatsOutputFormat) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[2]
+}
+
+func (x RunLoadtestRequest_StatsOutputFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunLoadtestRequest_StatsOutputFormat.Descriptor instead.
+func (RunLoadtestRequest_StatsOutputFormat) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{0, 2}
+}
+
+type RunLoadtestRequest_ConfirmationMode int32
+
+const (
+	// Default value. Only the broadcast round trip is measured.
+	RunLoadtestRequest_CONFIRMATION_MODE_UNSPECIFIED RunLoadtestRequest_ConfirmationMode = 0
+	// Subscribe to Tx events over the WebSocket RPC of every endpoint.
+	RunLoadtestRequest_CONFIRMATION_MODE_SUBSCRIBE RunLoadtestRequest_ConfirmationMode = 1
+	// Poll the block RPC of every endpoint for new blocks and match the hashes
+	// of their transactions. Transactions count as included at the time of
+	// their block.
+	RunLoadtestRequest_CONFIRMATION_MODE_POLL RunLoadtestRequest_ConfirmationMode = 2
+)
+
+// Enum value maps for RunLoadtestRequest_ConfirmationMode.
+var (
+	RunLoadtestRequest_ConfirmationMode_name = map[int32]string{
+		0: "CONFIRMATION_MODE_UNSPECIFIED",
+		1: "CONFIRMATION_MODE_SUBSCRIBE",
+		2: "CONFIRMATION_MODE_POLL",
+	}
+	RunLoadtestRequest_ConfirmationMode_value = map[string]int32{
+		"CONFIRMATION_MODE_UNSPECIFIED": 0,
+		"CONFIRMATION_MODE_SUBSCRIBE":   1,
+		"CONFIRMATION_MODE_POLL":        2,
+	}
+)
+
+func (x RunLoadtestRequest_ConfirmationMode) Enum() *RunLoadtestRequest_ConfirmationMode {
+	p := new(RunLoadtestRequest_ConfirmationMode)
+	*p = x
+	return p
+}
+
+func (x RunLoadtestRequest_ConfirmationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunLoadtestRequest_ConfirmationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[3].Descriptor()
+}
+
+func (RunLoadtestRequest_ConfirmationMode) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[3]
+}
+
+func (x RunLoadtestRequest_ConfirmationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunLoadtestRequest_ConfirmationMode.Descriptor instead.
+func (RunLoadtestRequest_ConfirmationMode) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{0, 3}
+}
+
+type Stage_Shape int32
+
+const (
+	// Default value. This value is unused.
+	Stage_SHAPE_UNSPECIFIED Stage_Shape = 0
+	// Keep the rate at rate.
+	Stage_SHAPE_HOLD Stage_Shape = 1
+	// Change the rate linearly from from_rate to to_rate.
+	Stage_SHAPE_RAMP Stage_Shape = 2
+	// Step the rate up to rate for the stage, usually a short one.
+	Stage_SHAPE_SPIKE Stage_Shape = 3
+	// Swing the rate by amplitude around rate, once every period.
+	Stage_SHAPE_SINE Stage_Shape = 4
+)
+
+// Enum value maps for Stage_Shape.
+var (
+	Stage_Shape_name = map[int32]string{
+		0: "SHAPE_UNSPECIFIED",
+		1: "SHAPE_HOLD",
+		2: "SHAPE_RAMP",
+		3: "SHAPE_SPIKE",
+		4: "SHAPE_SINE",
+	}
+	Stage_Shape_value = map[string]int32{
+		"SHAPE_UNSPECIFIED": 0,
+		"SHAPE_HOLD":        1,
+		"SHAPE_RAMP":        2,
+		"SHAPE_SPIKE":       3,
+		"SHAPE_SINE":        4,
+	}
+)
+
+func (x Stage_Shape) Enum() *Stage_Shape {
+	p := new(Stage_Shape)
+	*p = x
+	return p
+}
+
+func (x Stage_Shape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Stage_Shape) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[4].Descriptor()
+}
+
+func (Stage_Shape) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[4]
+}
+
+func (x Stage_Shape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Stage_Shape.Descriptor instead.
+func (Stage_Shape) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Arrival_Model int32
+
+const (
+	// Default value. Batches of transactions_per_second transactions every
+	// send_period, each sent once the previous one was answered.
+	Arrival_MODEL_UNSPECIFIED Arrival_Model = 0
+	// transactions_per_second transactions per second, evenly spaced.
+	Arrival_MODEL_CONSTANT Arrival_Model = 1
+	// transactions_per_second transactions per second on average, with
+	// exponentially distributed gaps.
+	Arrival_MODEL_POISSON Arrival_Model = 2
+	// transactions_per_second transactions per second on average, with gaps
+	// uniformly distributed within jitter of the mean gap.
+	Arrival_MODEL_UNIFORM Arrival_Model = 3
+	// The recorded gaps in intervals, cycled through.
+	Arrival_MODEL_REPLAY Arrival_Model = 4
+)
+
+// Enum value maps for Arrival_Model.
+var (
+	Arrival_Model_name = map[int32]string{
+		0: "MODEL_UNSPECIFIED",
+		1: "MODEL_CONSTANT",
+		2: "MODEL_POISSON",
+		3: "MODEL_UNIFORM",
+		4: "MODEL_REPLAY",
+	}
+	Arrival_Model_value = map[string]int32{
+		"MODEL_UNSPECIFIED": 0,
+		"MODEL_CONSTANT":    1,
+		"MODEL_POISSON":     2,
+		"MODEL_UNIFORM":     3,
+		"MODEL_REPLAY":      4,
+	}
+)
+
+func (x Arrival_Model) Enum() *Arrival_Model {
+	p := new(Arrival_Model)
+	*p = x
+	return p
+}
+
+func (x Arrival_Model) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Arrival_Model) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[5].Descriptor()
+}
+
+func (Arrival_Model) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[5]
+}
+
+func (x Arrival_Model) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Arrival_Model.Descriptor instead.
+func (Arrival_Model) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type TxOutcomeCount_Outcome int32
+
+const (
+	// Default value. This value is unused.
+	TxOutcomeCount_OUTCOME_UNSPECIFIED TxOutcomeCount_Outcome = 0
+	// The node accepted the transaction.
+	TxOutcomeCount_OUTCOME_ACCEPTED TxOutcomeCount_Outcome = 1
+	// The node rejected the transaction with a non-zero code, in CheckTx or,
+	// for BROADCAST_TX_METHOD_COMMIT, in DeliverTx.
+	TxOutcomeCount_OUTCOME_REJECTED TxOutcomeCount_Outcome = 2
+	// The node answered with a JSON-RPC error.
+	TxOutcomeCount_OUTCOME_RPC_ERROR TxOutcomeCount_Outcome = 3
+	// The request failed with an HTTP error status or a connection error.
+	TxOutcomeCount_OUTCOME_HTTP_ERROR TxOutcomeCount_Outcome = 4
+	// No response arrived in time.
+	TxOutcomeCount_OUTCOME_TIMEOUT TxOutcomeCount_Outcome = 5
+)
+
+// Enum value maps for TxOutcomeCount_Outcome.
+var (
+	TxOutcomeCount_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_ACCEPTED",
+		2: "OUTCOME_REJECTED",
+		3: "OUTCOME_RPC_ERROR",
+		4: "OUTCOME_HTTP_ERROR",
+		5: "OUTCOME_TIMEOUT",
+	}
+	TxOutcomeCount_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_ACCEPTED":    1,
+		"OUTCOME_REJECTED":    2,
+		"OUTCOME_RPC_ERROR":   3,
+		"OUTCOME_HTTP_ERROR":  4,
+		"OUTCOME_TIMEOUT":     5,
+	}
+)
+
+func (x TxOutcomeCount_Outcome) Enum() *TxOutcomeCount_Outcome {
+	p := new(TxOutcomeCount_Outcome)
+	*p = x
+	return p
+}
+
+func (x TxOutcomeCount_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxOutcomeCount_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[6].Descriptor()
+}
+
+func (TxOutcomeCount_Outcome) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[6]
+}
+
+func (x TxOutcomeCount_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxOutcomeCount_Outcome.Descriptor instead.
+func (TxOutcomeCount_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type Loadtest_State int32
+
+const (
+	// Default value. This value is unused.
+	Loadtest_STATE_UNSPECIFIED Loadtest_State = 0
+	// The load test is sending transactions.
+	Loadtest_STATE_RUNNING Loadtest_State = 1
+	// The load test ran to completion.
+	Loadtest_STATE_SUCCEEDED Loadtest_State = 2
+	// The load test could not be run, see error.
+	Loadtest_STATE_FAILED Loadtest_State = 3
+	// The load test was stopped by CancelLoadtest.
+	Loadtest_STATE_CANCELLED Loadtest_State = 4
+)
+
+// Enum value maps for Loadtest_State.
+var (
+	Loadtest_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_RUNNING",
+		2: "STATE_SUCCEEDED",
+		3: "STATE_FAILED",
+		4: "STATE_CANCELLED",
+	}
+	Loadtest_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_RUNNING":     1,
+		"STATE_SUCCEEDED":   2,
+		"STATE_FAILED":      3,
+		"STATE_CANCELLED":   4,
+	}
+)
+
+func (x Loadtest_State) Enum() *Loadtest_State {
+	p := new(Loadtest_State)
+	*p = x
+	return p
+}
+
+func (x Loadtest_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Loadtest_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[7].Descriptor()
+}
+
+func (Loadtest_State) Type() protoreflect.EnumType {
+	return &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes[7]
+}
+
+func (x Loadtest_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Loadtest_State.Descriptor instead.
+func (Loadtest_State) EnumDescriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
 type RunLoadtestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,9 +538,31 @@ type RunLoadtestRequest struct {
 	// The minimum number of peers to which each peer must be connected before starting the load test.
 	// Maps to --min-peer-connectvity in tm-load-test.
 	MinPeerConnectivityCount int32 `protobuf:"varint,14,opt,name=min_peer_connectivity_count,json=minPeerConnectivityCount,proto3" json:"min_peer_connectivity_count,omitempty"`
-	// Where to store aggregate statistics (in CSV format) for the load test.
-	// Maps to --stats-output in tm-load-test.
+	// Optionally, where to export the statistics of the load test, in stats_output_format.
+	// Nothing is written if empty.
 	StatsOutputFilePath string `protobuf:"bytes,15,opt,name=stats_output_file_path,json=statsOutputFilePath,proto3" json:"stats_output_file_path,omitempty"`
+	// The format in which to write stats_output_file_path.
+	StatsOutputFormat RunLoadtestRequest_StatsOutputFormat `protobuf:"varint,16,opt,name=stats_output_format,json=statsOutputFormat,proto3,enum=orijtech.cosmosloadtester.v1.RunLoadtestRequest_StatsOutputFormat" json:"stats_output_format,omitempty"`
+	// How to learn when the transactions the nodes accepted are included in a block.
+	ConfirmationMode RunLoadtestRequest_ConfirmationMode `protobuf:"varint,17,opt,name=confirmation_mode,json=confirmationMode,proto3,enum=orijtech.cosmosloadtester.v1.RunLoadtestRequest_ConfirmationMode" json:"confirmation_mode,omitempty"`
+	// How long to wait for a transaction to be included before counting it as
+	// dropped from the mempool. Defaults to 30 seconds.
+	ConfirmationTimeout *durationpb.Duration `protobuf:"bytes,18,opt,name=confirmation_timeout,json=confirmationTimeout,proto3" json:"confirmation_timeout,omitempty"`
+	// Optionally, fund one sender account per connection from the client
+	// factory's faucet account before the load test. Only supported by client
+	// factories that sign with funded accounts, such as aiw3defi-bank-send.
+	AccountFunding *AccountFunding `protobuf:"bytes,19,opt,name=account_funding,json=accountFunding,proto3" json:"account_funding,omitempty"`
+	// Optionally, parameters of the client factory by name, which override its
+	// defaults for this load test, e.g. chain_id or gas_price for
+	// aiw3defi-bank-send. Factories that take no parameters reject them.
+	ClientParams map[string]string `protobuf:"bytes,20,rep,name=client_params,json=clientParams,proto3" json:"client_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optionally, send transactions on an open-loop schedule rather than in
+	// batches of transactions_per_second every send_period.
+	Arrival *Arrival `protobuf:"bytes,21,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	// Optionally, stages run one after the other as one continuous run, whose
+	// rates replace transactions_per_second and whose total duration replaces
+	// duration. Each second of the stats is marked with the stage running then.
+	Stages []*Stage `protobuf:"bytes,22,rep,name=stages,proto3" json:"stages,omitempty"`
 }
 
 func (x *RunLoadtestRequest) Reset() {
@@ -320,32 +702,78 @@ func (x *RunLoadtestRequest) GetStatsOutputFilePath() string {
 	return ""
 }
 
-type RunLoadtestResponse struct {
+func (x *RunLoadtestRequest) GetStatsOutputFormat() RunLoadtestRequest_StatsOutputFormat {
+	if x != nil {
+		return x.StatsOutputFormat
+	}
+	return RunLoadtestRequest_STATS_OUTPUT_FORMAT_UNSPECIFIED
+}
+
+func (x *RunLoadtestRequest) GetConfirmationMode() RunLoadtestRequest_ConfirmationMode {
+	if x != nil {
+		return x.ConfirmationMode
+	}
+	return RunLoadtestRequest_CONFIRMATION_MODE_UNSPECIFIED
+}
+
+func (x *RunLoadtestRequest) GetConfirmationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConfirmationTimeout
+	}
+	return nil
+}
+
+func (x *RunLoadtestRequest) GetAccountFunding() *AccountFunding {
+	if x != nil {
+		return x.AccountFunding
+	}
+	return nil
+}
+
+func (x *RunLoadtestRequest) GetClientParams() map[string]string {
+	if x != nil {
+		return x.ClientParams
+	}
+	return nil
+}
+
+func (x *RunLoadtestRequest) GetArrival() *Arrival {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *RunLoadtestRequest) GetStages() []*Stage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+type Stage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The total number of transactions sent.
-	// Corresponds to total_time in tm-load-test.
-	TotalTxs int64 `protobuf:"varint,1,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
-	// The total time taken to send `total_txs` transactions.
-	// Corresponds to total_txs in tm-load-test.
-	TotalTime *durationpb.Duration `protobuf:"bytes,2,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
-	// The cumulative number of bytes sent as transactions.
-	// Corresponds to total_bytes in tm-load-test.
-	TotalBytes int64 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
-	// The rate at which transactions were submitted (tx/sec).
-	// Corresponds to avg_tx_rate in tm-load-test.
-	AvgTxsPerSecond float64 `protobuf:"fixed64,4,opt,name=avg_txs_per_second,json=avgTxsPerSecond,proto3" json:"avg_txs_per_second,omitempty"`
-	// The rate at which data was transmitted in transactions (bytes/sec).
-	// Corresponds to avg_data_rate in tm-load-test.
-	AvgBytesPerSecond float64 `protobuf:"fixed64,5,opt,name=avg_bytes_per_second,json=avgBytesPerSecond,proto3" json:"avg_bytes_per_second,omitempty"`
-	// The respective points per second from 0 until the request's max_time.
-	PerSec []*PerSecond `protobuf:"bytes,6,rep,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
+	// The name the stage is marked with. Defaults to its number and shape,
+	// e.g. "2:ramp".
+	Name     string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shape    Stage_Shape          `protobuf:"varint,2,opt,name=shape,proto3,enum=orijtech.cosmosloadtester.v1.Stage_Shape" json:"shape,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The rate of SHAPE_HOLD and SHAPE_SPIKE, and the mean rate of SHAPE_SINE,
+	// in transactions per second on each connection.
+	Rate int32 `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// The rates SHAPE_RAMP starts and ends at.
+	FromRate int32 `protobuf:"varint,5,opt,name=from_rate,json=fromRate,proto3" json:"from_rate,omitempty"`
+	ToRate   int32 `protobuf:"varint,6,opt,name=to_rate,json=toRate,proto3" json:"to_rate,omitempty"`
+	// How far SHAPE_SINE swings the rate either way, and how long a swing takes.
+	Amplitude int32                `protobuf:"varint,7,opt,name=amplitude,proto3" json:"amplitude,omitempty"`
+	Period    *durationpb.Duration `protobuf:"bytes,8,opt,name=period,proto3" json:"period,omitempty"`
 }
 
-func (x *RunLoadtestResponse) Reset() {
-	*x = RunLoadtestResponse{}
+func (x *Stage) Reset() {
+	*x = Stage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,13 +781,13 @@ func (x *RunLoadtestResponse) Reset() {
 	}
 }
 
-func (x *RunLoadtestResponse) String() string {
+func (x *Stage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunLoadtestResponse) ProtoMessage() {}
+func (*Stage) ProtoMessage() {}
 
-func (x *RunLoadtestResponse) ProtoReflect() protoreflect.Message {
+func (x *Stage) ProtoReflect() protoreflect.Message {
 	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,75 +799,89 @@ func (x *RunLoadtestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunLoadtestResponse.ProtoReflect.Descriptor instead.
-func (*RunLoadtestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
+func (*Stage) Descriptor() ([]byte, []int) {
 	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RunLoadtestResponse) GetTotalTxs() int64 {
+func (x *Stage) GetName() string {
 	if x != nil {
-		return x.TotalTxs
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *RunLoadtestResponse) GetTotalTime() *durationpb.Duration {
+func (x *Stage) GetShape() Stage_Shape {
 	if x != nil {
-		return x.TotalTime
+		return x.Shape
+	}
+	return Stage_SHAPE_UNSPECIFIED
+}
+
+func (x *Stage) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *RunLoadtestResponse) GetTotalBytes() int64 {
+func (x *Stage) GetRate() int32 {
 	if x != nil {
-		return x.TotalBytes
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *RunLoadtestResponse) GetAvgTxsPerSecond() float64 {
+func (x *Stage) GetFromRate() int32 {
 	if x != nil {
-		return x.AvgTxsPerSecond
+		return x.FromRate
 	}
 	return 0
 }
 
-func (x *RunLoadtestResponse) GetAvgBytesPerSecond() float64 {
+func (x *Stage) GetToRate() int32 {
 	if x != nil {
-		return x.AvgBytesPerSecond
+		return x.ToRate
 	}
 	return 0
 }
 
-func (x *RunLoadtestResponse) GetPerSec() []*PerSecond {
+func (x *Stage) GetAmplitude() int32 {
 	if x != nil {
-		return x.PerSec
+		return x.Amplitude
+	}
+	return 0
+}
+
+func (x *Stage) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
 	}
 	return nil
 }
 
-type PerSecond struct {
+type Arrival struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Indicates the ordinal number of the current second e.g. for the 8th second, sec=7, 1st second, sec=0.
-	// Second is creating by using the lower bounds/floor of the second e.g. values at:
-	//    0.74 sec fall within sec=0
-	//    9.94 sec fall within sec=9
-	Sec int64 `protobuf:"varint,1,opt,name=sec,proto3" json:"sec,omitempty"`
-	// Indicates the queries per second captured by stuffing points within a second booundary.
-	Qps float64 `protobuf:"fixed64,2,opt,name=qps,proto3" json:"qps,omitempty"`
-	// Bytes indicates the bytes sent within the time period.
-	BytesSent float64 `protobuf:"fixed64,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
-	// Indicates the aggregated percentile values by bytes.
-	BytesRankings *Ranking `protobuf:"bytes,4,opt,name=bytes_rankings,json=bytesRankings,proto3" json:"bytes_rankings,omitempty"`
-	// Indicates the aggregated percentile values by latency.
-	LatencyRankings *Ranking `protobuf:"bytes,5,opt,name=latency_rankings,json=latencyRankings,proto3" json:"latency_rankings,omitempty"`
+	// When each connection sends transactions. In every model but the default,
+	// transactions are sent whether or not earlier ones were answered, and
+	// latencies are measured from when they were due.
+	Model Arrival_Model `protobuf:"varint,1,opt,name=model,proto3,enum=orijtech.cosmosloadtester.v1.Arrival_Model" json:"model,omitempty"`
+	// The fraction of the mean gap, from 0 to 1, by which gaps vary in
+	// MODEL_UNIFORM. Defaults to 0.5 when unset.
+	Jitter *float64 `protobuf:"fixed64,2,opt,name=jitter,proto3,oneof" json:"jitter,omitempty"`
+	// The recorded gaps between transactions of MODEL_REPLAY.
+	Intervals []*durationpb.Duration `protobuf:"bytes,3,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	// How many broadcasts each connection may have in flight. Defaults to 256,
+	// or to 1 for clients whose transactions carry account sequences, which
+	// could otherwise reach the node out of order.
+	MaxInFlight int32 `protobuf:"varint,4,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
 }
 
-func (x *PerSecond) Reset() {
-	*x = PerSecond{}
+func (x *Arrival) Reset() {
+	*x = Arrival{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,13 +889,13 @@ func (x *PerSecond) Reset() {
 	}
 }
 
-func (x *PerSecond) String() string {
+func (x *Arrival) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerSecond) ProtoMessage() {}
+func (*Arrival) ProtoMessage() {}
 
-func (x *PerSecond) ProtoReflect() protoreflect.Message {
+func (x *Arrival) ProtoReflect() protoreflect.Message {
 	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,63 +907,56 @@ func (x *PerSecond) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerSecond.ProtoReflect.Descriptor instead.
-func (*PerSecond) Descriptor() ([]byte, []int) {
+// Deprecated: Use Arrival.ProtoReflect.Descriptor instead.
+func (*Arrival) Descriptor() ([]byte, []int) {
 	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PerSecond) GetSec() int64 {
-	if x != nil {
-		return x.Sec
-	}
-	return 0
-}
-
-func (x *PerSecond) GetQps() float64 {
+func (x *Arrival) GetModel() Arrival_Model {
 	if x != nil {
-		return x.Qps
+		return x.Model
 	}
-	return 0
+	return Arrival_MODEL_UNSPECIFIED
 }
 
-func (x *PerSecond) GetBytesSent() float64 {
-	if x != nil {
-		return x.BytesSent
+func (x *Arrival) GetJitter() float64 {
+	if x != nil && x.Jitter != nil {
+		return *x.Jitter
 	}
 	return 0
 }
 
-func (x *PerSecond) GetBytesRankings() *Ranking {
+func (x *Arrival) GetIntervals() []*durationpb.Duration {
 	if x != nil {
-		return x.BytesRankings
+		return x.Intervals
 	}
 	return nil
 }
 
-func (x *PerSecond) GetLatencyRankings() *Ranking {
+func (x *Arrival) GetMaxInFlight() int32 {
 	if x != nil {
-		return x.LatencyRankings
+		return x.MaxInFlight
 	}
-	return nil
+	return 0
 }
 
-type Percentile struct {
+type AccountFunding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time relative to the request's start time.
-	StartOffset *durationpb.Duration `protobuf:"bytes,1,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
-	// The time between request send and receipt of a response.
-	Latency *durationpb.Duration `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
-	// The number of bytes sent.
-	BytesSent int64 `protobuf:"varint,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
-	// The human friendly value of the percentile's occurence. It is useful for easy debugging.
-	AtStr string `protobuf:"bytes,4,opt,name=at_str,json=atStr,proto3" json:"at_str,omitempty"`
+	// The coins to send to each sender account, e.g. 1000000uaiw.
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether to send the balances of the sender accounts back to the faucet
+	// account after the load test.
+	Sweep bool `protobuf:"varint,2,opt,name=sweep,proto3" json:"sweep,omitempty"`
+	// How long to wait for the funding and sweep transactions to be included
+	// in a block. Defaults to 30 seconds.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *Percentile) Reset() {
-	*x = Percentile{}
+func (x *AccountFunding) Reset() {
+	*x = AccountFunding{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,13 +964,13 @@ func (x *Percentile) Reset() {
 	}
 }
 
-func (x *Percentile) String() string {
+func (x *AccountFunding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Percentile) ProtoMessage() {}
+func (*AccountFunding) ProtoMessage() {}
 
-func (x *Percentile) ProtoReflect() protoreflect.Message {
+func (x *AccountFunding) ProtoReflect() protoreflect.Message {
 	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,58 +982,79 @@ func (x *Percentile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Percentile.ProtoReflect.Descriptor instead.
-func (*Percentile) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountFunding.ProtoReflect.Descriptor instead.
+func (*AccountFunding) Descriptor() ([]byte, []int) {
 	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Percentile) GetStartOffset() *durationpb.Duration {
-	if x != nil {
-		return x.StartOffset
-	}
-	return nil
-}
-
-func (x *Percentile) GetLatency() *durationpb.Duration {
+func (x *AccountFunding) GetAmount() string {
 	if x != nil {
-		return x.Latency
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *Percentile) GetBytesSent() int64 {
+func (x *AccountFunding) GetSweep() bool {
 	if x != nil {
-		return x.BytesSent
+		return x.Sweep
 	}
-	return 0
+	return false
 }
 
-func (x *Percentile) GetAtStr() string {
+func (x *AccountFunding) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.AtStr
+		return x.Timeout
 	}
-	return ""
+	return nil
 }
 
-type Ranking struct {
+type RunLoadtestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The 50th percentile value aka the median.
-	P50 *Percentile `protobuf:"bytes,1,opt,name=p50,proto3" json:"p50,omitempty"`
-	// The 75th percentile value.
-	P75 *Percentile `protobuf:"bytes,2,opt,name=p75,proto3" json:"p75,omitempty"`
-	// The 90th percentile value.
-	P90 *Percentile `protobuf:"bytes,3,opt,name=p90,proto3" json:"p90,omitempty"`
-	// The 95th percentile value.
-	P95 *Percentile `protobuf:"bytes,4,opt,name=p95,proto3" json:"p95,omitempty"`
-	// The 99th percentile value, useful to identify outliers.
-	P99 *Percentile `protobuf:"bytes,5,opt,name=p99,proto3" json:"p99,omitempty"`
+	// The total number of transactions sent.
+	// Corresponds to total_time in tm-load-test.
+	TotalTxs int64 `protobuf:"varint,1,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	// The total time taken to send `total_txs` transactions.
+	// Corresponds to total_txs in tm-load-test.
+	TotalTime *durationpb.Duration `protobuf:"bytes,2,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	// The cumulative number of bytes sent as transactions.
+	// Corresponds to total_bytes in tm-load-test.
+	TotalBytes int64 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// The rate at which transactions were submitted (tx/sec).
+	// Corresponds to avg_tx_rate in tm-load-test.
+	AvgTxsPerSecond float64 `protobuf:"fixed64,4,opt,name=avg_txs_per_second,json=avgTxsPerSecond,proto3" json:"avg_txs_per_second,omitempty"`
+	// The rate at which data was transmitted in transactions (bytes/sec).
+	// Corresponds to avg_data_rate in tm-load-test.
+	AvgBytesPerSecond float64 `protobuf:"fixed64,5,opt,name=avg_bytes_per_second,json=avgBytesPerSecond,proto3" json:"avg_bytes_per_second,omitempty"`
+	// The respective points per second from 0 until the request's max_time.
+	PerSec []*PerSecond `protobuf:"bytes,6,rep,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
+	// The results of every connection to every endpoint. The fields above
+	// aggregate them.
+	EndpointResults []*EndpointResult `protobuf:"bytes,7,rep,name=endpoint_results,json=endpointResults,proto3" json:"endpoint_results,omitempty"`
+	// The number of transactions the nodes accepted.
+	AcceptedTxs int64 `protobuf:"varint,8,opt,name=accepted_txs,json=acceptedTxs,proto3" json:"accepted_txs,omitempty"`
+	// The number of transactions that failed for any reason, see outcomes.
+	FailedTxs int64 `protobuf:"varint,9,opt,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty"`
+	// The number of transactions by outcome, most frequent first.
+	Outcomes []*TxOutcomeCount `protobuf:"bytes,10,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	// The number of accepted transactions seen in a block, when confirmation_mode is set.
+	IncludedTxs int64 `protobuf:"varint,11,opt,name=included_txs,json=includedTxs,proto3" json:"included_txs,omitempty"`
+	// The number of accepted transactions not seen in a block before the confirmation timeout.
+	DroppedTxs int64 `protobuf:"varint,12,opt,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// included_txs / (included_txs + dropped_txs), from 0 to 1.
+	InclusionRate float64 `protobuf:"fixed64,13,opt,name=inclusion_rate,json=inclusionRate,proto3" json:"inclusion_rate,omitempty"`
+	// Indicates the percentile values of the time between sending a transaction
+	// and seeing it in a block, over the whole run.
+	InclusionLatencyRankings *Ranking `protobuf:"bytes,14,opt,name=inclusion_latency_rankings,json=inclusionLatencyRankings,proto3" json:"inclusion_latency_rankings,omitempty"`
+	// What the chain processed while the load test ran, as opposed to what was
+	// sent to it. Unset if the endpoints could not be polled for blocks.
+	ChainMetrics *ChainMetrics `protobuf:"bytes,15,opt,name=chain_metrics,json=chainMetrics,proto3" json:"chain_metrics,omitempty"`
 }
 
-func (x *Ranking) Reset() {
-	*x = Ranking{}
+func (x *RunLoadtestResponse) Reset() {
+	*x = RunLoadtestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,13 +1062,13 @@ func (x *Ranking) Reset() {
 	}
 }
 
-func (x *Ranking) String() string {
+func (x *RunLoadtestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ranking) ProtoMessage() {}
+func (*RunLoadtestResponse) ProtoMessage() {}
 
-func (x *Ranking) ProtoReflect() protoreflect.Message {
+func (x *RunLoadtestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -624,303 +1080,2760 @@ func (x *Ranking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ranking.ProtoReflect.Descriptor instead.
-func (*Ranking) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunLoadtestResponse.ProtoReflect.Descriptor instead.
+func (*RunLoadtestResponse) Descriptor() ([]byte, []int) {
 	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Ranking) GetP50() *Percentile {
+func (x *RunLoadtestResponse) GetTotalTxs() int64 {
 	if x != nil {
-		return x.P50
+		return x.TotalTxs
 	}
-	return nil
+	return 0
 }
 
-func (x *Ranking) GetP75() *Percentile {
+func (x *RunLoadtestResponse) GetTotalTime() *durationpb.Duration {
 	if x != nil {
-		return x.P75
+		return x.TotalTime
 	}
 	return nil
 }
 
-func (x *Ranking) GetP90() *Percentile {
+func (x *RunLoadtestResponse) GetTotalBytes() int64 {
 	if x != nil {
-		return x.P90
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *RunLoadtestResponse) GetAvgTxsPerSecond() float64 {
+	if x != nil {
+		return x.AvgTxsPerSecond
+	}
+	return 0
+}
+
+func (x *RunLoadtestResponse) GetAvgBytesPerSecond() float64 {
+	if x != nil {
+		return x.AvgBytesPerSecond
+	}
+	return 0
+}
+
+func (x *RunLoadtestResponse) GetPerSec() []*PerSecond {
+	if x != nil {
+		return x.PerSec
 	}
 	return nil
 }
 
-func (x *Ranking) GetP95() *Percentile {
+func (x *RunLoadtestResponse) GetEndpointResults() []*EndpointResult {
 	if x != nil {
-		return x.P95
+		return x.EndpointResults
 	}
 	return nil
 }
 
-func (x *Ranking) GetP99() *Percentile {
+func (x *RunLoadtestResponse) GetAcceptedTxs() int64 {
 	if x != nil {
-		return x.P99
+		return x.AcceptedTxs
+	}
+	return 0
+}
+
+func (x *RunLoadtestResponse) GetFailedTxs() int64 {
+	if x != nil {
+		return x.FailedTxs
+	}
+	return 0
+}
+
+func (x *RunLoadtestResponse) GetOutcomes() []*TxOutcomeCount {
+	if x != nil {
+		return x.Outcomes
 	}
 	return nil
 }
 
-var File_orijtech_cosmosloadtester_v1_loadtest_service_proto protoreflect.FileDescriptor
+func (x *RunLoadtestResponse) GetIncludedTxs() int64 {
+	if x != nil {
+		return x.IncludedTxs
+	}
+	return 0
+}
 
-var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDesc = []byte{
-	0x0a, 0x33, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
-	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe5, 0x09, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x36, 0x0a,
-	0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x13, 0x62, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
-	0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x62, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x16, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x6f, 0x72, 0x69,
-	0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64,
-	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61,
-	0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x14, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70, 0x65,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x13, 0x73, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
-	0x73, 0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x52,
-	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
-	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x1c, 0x0a, 0x18, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f,
-	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45,
-	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
-	0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x54,
-	0x48, 0x4f, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x22, 0xaa, 0x01, 0x0a,
-	0x14, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a,
-	0x1f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
-	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53,
-	0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x49, 0x53,
-	0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x44,
-	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54,
-	0x48, 0x4f, 0x44, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x03, 0x22, 0xad, 0x02, 0x0a, 0x13, 0x52, 0x75,
-	0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x38,
-	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x76, 0x67,
-	0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61, 0x76, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65,
-	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x63, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
-	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0xee, 0x01, 0x0a, 0x09, 0x50, 0x65,
-	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x70, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x61,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x74, 0x53,
-	0x74, 0x72, 0x22, 0xb5, 0x02, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x3a,
-	0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72,
-	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
-	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x37,
-	0x35, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65,
-	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
-	0x65, 0x52, 0x03, 0x70, 0x37, 0x35, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x39, 0x30, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70,
-	0x39, 0x30, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x39, 0x35, 0x12, 0x3a,
-	0x0a, 0x03, 0x70, 0x39, 0x39, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72,
-	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
-	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x39, 0x39, 0x32, 0xa3, 0x01, 0x0a, 0x0f, 0x4c,
-	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8f,
-	0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x12, 0x30,
-	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
-	0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31,
-	0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x72, 0x75, 0x6e, 0x3a, 0x01, 0x2a,
-	0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
-	0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f,
-	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f,
-	0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f,
-	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *RunLoadtestResponse) GetDroppedTxs() int64 {
+	if x != nil {
+		return x.DroppedTxs
+	}
+	return 0
 }
 
-var (
-	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescOnce sync.Once
-	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData = file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDesc
-)
+func (x *RunLoadtestResponse) GetInclusionRate() float64 {
+	if x != nil {
+		return x.InclusionRate
+	}
+	return 0
+}
 
-func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP() []byte {
-	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescOnce.Do(func() {
-		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData)
-	})
-	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData
+func (x *RunLoadtestResponse) GetInclusionLatencyRankings() *Ranking {
+	if x != nil {
+		return x.InclusionLatencyRankings
+	}
+	return nil
 }
 
-var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_goTypes = []interface{}{
-	(RunLoadtestRequest_BroadcastTxMethod)(0),    // 0: orijtech.cosmosloadtester.v1.RunLoadtestRequest.BroadcastTxMethod
-	(RunLoadtestRequest_EndpointSelectMethod)(0), // 1: orijtech.cosmosloadtester.v1.RunLoadtestRequest.EndpointSelectMethod
-	(*RunLoadtestRequest)(nil),                   // 2: orijtech.cosmosloadtester.v1.RunLoadtestRequest
-	(*RunLoadtestResponse)(nil),                  // 3: orijtech.cosmosloadtester.v1.RunLoadtestResponse
-	(*PerSecond)(nil),                            // 4: orijtech.cosmosloadtester.v1.PerSecond
-	(*Percentile)(nil),                           // 5: orijtech.cosmosloadtester.v1.Percentile
-	(*Ranking)(nil),                              // 6: orijtech.cosmosloadtester.v1.Ranking
-	(*durationpb.Duration)(nil),                  // 7: google.protobuf.Duration
+func (x *RunLoadtestResponse) GetChainMetrics() *ChainMetrics {
+	if x != nil {
+		return x.ChainMetrics
+	}
+	return nil
 }
-var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_depIdxs = []int32{
-	7,  // 0: orijtech.cosmosloadtester.v1.RunLoadtestRequest.duration:type_name -> google.protobuf.Duration
-	7,  // 1: orijtech.cosmosloadtester.v1.RunLoadtestRequest.send_period:type_name -> google.protobuf.Duration
-	0,  // 2: orijtech.cosmosloadtester.v1.RunLoadtestRequest.broadcast_tx_method:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.BroadcastTxMethod
-	1,  // 3: orijtech.cosmosloadtester.v1.RunLoadtestRequest.endpoint_select_method:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.EndpointSelectMethod
-	7,  // 4: orijtech.cosmosloadtester.v1.RunLoadtestRequest.peer_connect_timeout:type_name -> google.protobuf.Duration
-	7,  // 5: orijtech.cosmosloadtester.v1.RunLoadtestResponse.total_time:type_name -> google.protobuf.Duration
-	4,  // 6: orijtech.cosmosloadtester.v1.RunLoadtestResponse.per_sec:type_name -> orijtech.cosmosloadtester.v1.PerSecond
-	6,  // 7: orijtech.cosmosloadtester.v1.PerSecond.bytes_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
-	6,  // 8: orijtech.cosmosloadtester.v1.PerSecond.latency_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
-	7,  // 9: orijtech.cosmosloadtester.v1.Percentile.start_offset:type_name -> google.protobuf.Duration
-	7,  // 10: orijtech.cosmosloadtester.v1.Percentile.latency:type_name -> google.protobuf.Duration
-	5,  // 11: orijtech.cosmosloadtester.v1.Ranking.p50:type_name -> orijtech.cosmosloadtester.v1.Percentile
-	5,  // 12: orijtech.cosmosloadtester.v1.Ranking.p75:type_name -> orijtech.cosmosloadtester.v1.Percentile
-	5,  // 13: orijtech.cosmosloadtester.v1.Ranking.p90:type_name -> orijtech.cosmosloadtester.v1.Percentile
-	5,  // 14: orijtech.cosmosloadtester.v1.Ranking.p95:type_name -> orijtech.cosmosloadtester.v1.Percentile
-	5,  // 15: orijtech.cosmosloadtester.v1.Ranking.p99:type_name -> orijtech.cosmosloadtester.v1.Percentile
-	2,  // 16: orijtech.cosmosloadtester.v1.LoadtestService.RunLoadtest:input_type -> orijtech.cosmosloadtester.v1.RunLoadtestRequest
-	3,  // 17: orijtech.cosmosloadtester.v1.LoadtestService.RunLoadtest:output_type -> orijtech.cosmosloadtester.v1.RunLoadtestResponse
-	17, // [17:18] is the sub-list for method output_type
-	16, // [16:17] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+type ChainMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of blocks committed during the load test.
+	Blocks int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// The number of transactions in those blocks, from any sender.
+	CommittedTxs int64 `protobuf:"varint,2,opt,name=committed_txs,json=committedTxs,proto3" json:"committed_txs,omitempty"`
+	// The rate at which transactions were committed (tx/sec).
+	CommittedTxsPerSecond float64 `protobuf:"fixed64,3,opt,name=committed_txs_per_second,json=committedTxsPerSecond,proto3" json:"committed_txs_per_second,omitempty"`
+	// The average time between consecutive blocks.
+	AvgBlockTime *durationpb.Duration `protobuf:"bytes,4,opt,name=avg_block_time,json=avgBlockTime,proto3" json:"avg_block_time,omitempty"`
+	// The average size of the transactions in a block, in bytes.
+	AvgBlockBytes float64 `protobuf:"fixed64,5,opt,name=avg_block_bytes,json=avgBlockBytes,proto3" json:"avg_block_bytes,omitempty"`
+	// The average gas used by the transactions in a block.
+	AvgGasUsed float64 `protobuf:"fixed64,6,opt,name=avg_gas_used,json=avgGasUsed,proto3" json:"avg_gas_used,omitempty"`
+	// The largest number of unconfirmed transactions seen in the mempool of any endpoint.
+	MaxMempoolTxs int64 `protobuf:"varint,7,opt,name=max_mempool_txs,json=maxMempoolTxs,proto3" json:"max_mempool_txs,omitempty"`
+	// The respective points per second, by block time.
+	PerSec []*ChainPerSecond `protobuf:"bytes,8,rep,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
 }
 
-func init() { file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() }
-func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() {
-	if File_orijtech_cosmosloadtester_v1_loadtest_service_proto != nil {
-		return
+func (x *ChainMetrics) Reset() {
+	*x = ChainMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunLoadtestRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunLoadtestResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *ChainMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainMetrics) ProtoMessage() {}
+
+func (x *ChainMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerSecond); i {
-			case 0:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainMetrics.ProtoReflect.Descriptor instead.
+func (*ChainMetrics) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChainMetrics) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetCommittedTxs() int64 {
+	if x != nil {
+		return x.CommittedTxs
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetCommittedTxsPerSecond() float64 {
+	if x != nil {
+		return x.CommittedTxsPerSecond
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetAvgBlockTime() *durationpb.Duration {
+	if x != nil {
+		return x.AvgBlockTime
+	}
+	return nil
+}
+
+func (x *ChainMetrics) GetAvgBlockBytes() float64 {
+	if x != nil {
+		return x.AvgBlockBytes
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetAvgGasUsed() float64 {
+	if x != nil {
+		return x.AvgGasUsed
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetMaxMempoolTxs() int64 {
+	if x != nil {
+		return x.MaxMempoolTxs
+	}
+	return 0
+}
+
+func (x *ChainMetrics) GetPerSec() []*ChainPerSecond {
+	if x != nil {
+		return x.PerSec
+	}
+	return nil
+}
+
+type ChainPerSecond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ordinal number of the second, as in PerSecond.
+	Sec int64 `protobuf:"varint,1,opt,name=sec,proto3" json:"sec,omitempty"`
+	// The number of blocks committed within the second.
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// The number of transactions in those blocks.
+	CommittedTxs int64 `protobuf:"varint,3,opt,name=committed_txs,json=committedTxs,proto3" json:"committed_txs,omitempty"`
+	// The size of the transactions in those blocks, in bytes.
+	BlockBytes int64 `protobuf:"varint,4,opt,name=block_bytes,json=blockBytes,proto3" json:"block_bytes,omitempty"`
+	// The gas used by the transactions in those blocks.
+	GasUsed int64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// The average time between those blocks and the blocks before them.
+	AvgBlockTime *durationpb.Duration `protobuf:"bytes,6,opt,name=avg_block_time,json=avgBlockTime,proto3" json:"avg_block_time,omitempty"`
+	// The largest number of unconfirmed transactions sampled within the second.
+	MempoolTxs int64 `protobuf:"varint,7,opt,name=mempool_txs,json=mempoolTxs,proto3" json:"mempool_txs,omitempty"`
+}
+
+func (x *ChainPerSecond) Reset() {
+	*x = ChainPerSecond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainPerSecond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainPerSecond) ProtoMessage() {}
+
+func (x *ChainPerSecond) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainPerSecond.ProtoReflect.Descriptor instead.
+func (*ChainPerSecond) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChainPerSecond) GetSec() int64 {
+	if x != nil {
+		return x.Sec
+	}
+	return 0
+}
+
+func (x *ChainPerSecond) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *ChainPerSecond) GetCommittedTxs() int64 {
+	if x != nil {
+		return x.CommittedTxs
+	}
+	return 0
+}
+
+func (x *ChainPerSecond) GetBlockBytes() int64 {
+	if x != nil {
+		return x.BlockBytes
+	}
+	return 0
+}
+
+func (x *ChainPerSecond) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ChainPerSecond) GetAvgBlockTime() *durationpb.Duration {
+	if x != nil {
+		return x.AvgBlockTime
+	}
+	return nil
+}
+
+func (x *ChainPerSecond) GetMempoolTxs() int64 {
+	if x != nil {
+		return x.MempoolTxs
+	}
+	return 0
+}
+
+type EndpointResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL of the endpoint the transactions were sent to.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The protocol used to reach the endpoint: ws, wss, http or https.
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// The index of the connection to the endpoint, from 0 to connection_count - 1.
+	ConnectionIndex int32 `protobuf:"varint,3,opt,name=connection_index,json=connectionIndex,proto3" json:"connection_index,omitempty"`
+	// The number of transactions sent over the connection.
+	TotalTxs int64 `protobuf:"varint,4,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	// The total time taken to send `total_txs` transactions.
+	TotalTime *durationpb.Duration `protobuf:"bytes,5,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	// The cumulative number of bytes sent as transactions.
+	TotalBytes int64 `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// The rate at which transactions were submitted (tx/sec).
+	AvgTxsPerSecond float64 `protobuf:"fixed64,7,opt,name=avg_txs_per_second,json=avgTxsPerSecond,proto3" json:"avg_txs_per_second,omitempty"`
+	// The rate at which data was transmitted in transactions (bytes/sec).
+	AvgBytesPerSecond float64 `protobuf:"fixed64,8,opt,name=avg_bytes_per_second,json=avgBytesPerSecond,proto3" json:"avg_bytes_per_second,omitempty"`
+	// The respective points per second of the connection.
+	PerSec []*PerSecond `protobuf:"bytes,9,rep,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
+	// The number of transactions the node accepted.
+	AcceptedTxs int64 `protobuf:"varint,10,opt,name=accepted_txs,json=acceptedTxs,proto3" json:"accepted_txs,omitempty"`
+	// The number of transactions that failed for any reason, see outcomes.
+	FailedTxs int64 `protobuf:"varint,11,opt,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty"`
+	// The number of transactions by outcome, most frequent first.
+	Outcomes []*TxOutcomeCount `protobuf:"bytes,12,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	// The number of accepted transactions seen in a block.
+	IncludedTxs int64 `protobuf:"varint,13,opt,name=included_txs,json=includedTxs,proto3" json:"included_txs,omitempty"`
+	// The number of accepted transactions not seen in a block before the confirmation timeout.
+	DroppedTxs int64 `protobuf:"varint,14,opt,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// included_txs / (included_txs + dropped_txs), from 0 to 1.
+	InclusionRate float64 `protobuf:"fixed64,15,opt,name=inclusion_rate,json=inclusionRate,proto3" json:"inclusion_rate,omitempty"`
+	// Indicates the percentile values of the inclusion latency over the whole run.
+	InclusionLatencyRankings *Ranking `protobuf:"bytes,16,opt,name=inclusion_latency_rankings,json=inclusionLatencyRankings,proto3" json:"inclusion_latency_rankings,omitempty"`
+}
+
+func (x *EndpointResult) Reset() {
+	*x = EndpointResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointResult) ProtoMessage() {}
+
+func (x *EndpointResult) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointResult.ProtoReflect.Descriptor instead.
+func (*EndpointResult) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EndpointResult) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *EndpointResult) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *EndpointResult) GetConnectionIndex() int32 {
+	if x != nil {
+		return x.ConnectionIndex
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetTotalTxs() int64 {
+	if x != nil {
+		return x.TotalTxs
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetTotalTime() *durationpb.Duration {
+	if x != nil {
+		return x.TotalTime
+	}
+	return nil
+}
+
+func (x *EndpointResult) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetAvgTxsPerSecond() float64 {
+	if x != nil {
+		return x.AvgTxsPerSecond
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetAvgBytesPerSecond() float64 {
+	if x != nil {
+		return x.AvgBytesPerSecond
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetPerSec() []*PerSecond {
+	if x != nil {
+		return x.PerSec
+	}
+	return nil
+}
+
+func (x *EndpointResult) GetAcceptedTxs() int64 {
+	if x != nil {
+		return x.AcceptedTxs
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetFailedTxs() int64 {
+	if x != nil {
+		return x.FailedTxs
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetOutcomes() []*TxOutcomeCount {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *EndpointResult) GetIncludedTxs() int64 {
+	if x != nil {
+		return x.IncludedTxs
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetDroppedTxs() int64 {
+	if x != nil {
+		return x.DroppedTxs
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetInclusionRate() float64 {
+	if x != nil {
+		return x.InclusionRate
+	}
+	return 0
+}
+
+func (x *EndpointResult) GetInclusionLatencyRankings() *Ranking {
+	if x != nil {
+		return x.InclusionLatencyRankings
+	}
+	return nil
+}
+
+type StreamLoadtestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*StreamLoadtestResponse_Progress
+	//	*StreamLoadtestResponse_PerSec
+	//	*StreamLoadtestResponse_Summary
+	Event isStreamLoadtestResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *StreamLoadtestResponse) Reset() {
+	*x = StreamLoadtestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLoadtestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLoadtestResponse) ProtoMessage() {}
+
+func (x *StreamLoadtestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLoadtestResponse.ProtoReflect.Descriptor instead.
+func (*StreamLoadtestResponse) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *StreamLoadtestResponse) GetEvent() isStreamLoadtestResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamLoadtestResponse) GetProgress() *TransactorProgress {
+	if x, ok := x.GetEvent().(*StreamLoadtestResponse_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *StreamLoadtestResponse) GetPerSec() *PerSecond {
+	if x, ok := x.GetEvent().(*StreamLoadtestResponse_PerSec); ok {
+		return x.PerSec
+	}
+	return nil
+}
+
+func (x *StreamLoadtestResponse) GetSummary() *RunLoadtestResponse {
+	if x, ok := x.GetEvent().(*StreamLoadtestResponse_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isStreamLoadtestResponse_Event interface {
+	isStreamLoadtestResponse_Event()
+}
+
+type StreamLoadtestResponse_Progress struct {
+	// Periodic progress of one of the transactors.
+	Progress *TransactorProgress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type StreamLoadtestResponse_PerSec struct {
+	// The statistics of a second of the load test that has just elapsed.
+	// Transactions still in flight when it is sent are only counted in the summary.
+	PerSec *PerSecond `protobuf:"bytes,2,opt,name=per_sec,json=perSec,proto3,oneof"`
+}
+
+type StreamLoadtestResponse_Summary struct {
+	// The final result of the load test. This is always the last message.
+	Summary *RunLoadtestResponse `protobuf:"bytes,3,opt,name=summary,proto3,oneof"`
+}
+
+func (*StreamLoadtestResponse_Progress) isStreamLoadtestResponse_Event() {}
+
+func (*StreamLoadtestResponse_PerSec) isStreamLoadtestResponse_Event() {}
+
+func (*StreamLoadtestResponse_Summary) isStreamLoadtestResponse_Event() {}
+
+type TransactorProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the transactor, in the order of the request's endpoints.
+	TransactorId int32 `protobuf:"varint,1,opt,name=transactor_id,json=transactorId,proto3" json:"transactor_id,omitempty"`
+	// The endpoint the transactor is sending transactions to.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The number of transactions sent so far.
+	TotalTxs int64 `protobuf:"varint,3,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	// The cumulative number of bytes sent so far.
+	TotalBytes int64 `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// The time elapsed since the load test started.
+	Elapsed *durationpb.Duration `protobuf:"bytes,5,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (x *TransactorProgress) Reset() {
+	*x = TransactorProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactorProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactorProgress) ProtoMessage() {}
+
+func (x *TransactorProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactorProgress.ProtoReflect.Descriptor instead.
+func (*TransactorProgress) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransactorProgress) GetTransactorId() int32 {
+	if x != nil {
+		return x.TransactorId
+	}
+	return 0
+}
+
+func (x *TransactorProgress) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *TransactorProgress) GetTotalTxs() int64 {
+	if x != nil {
+		return x.TotalTxs
+	}
+	return 0
+}
+
+func (x *TransactorProgress) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *TransactorProgress) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+type PerSecond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicates the ordinal number of the current second e.g. for the 8th second, sec=7, 1st second, sec=0.
+	// Second is creating by using the lower bounds/floor of the second e.g. values at:
+	//    0.74 sec fall within sec=0
+	//    9.94 sec fall within sec=9
+	Sec int64 `protobuf:"varint,1,opt,name=sec,proto3" json:"sec,omitempty"`
+	// Indicates the queries per second captured by stuffing points within a second booundary.
+	Qps float64 `protobuf:"fixed64,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	// Bytes indicates the bytes sent within the time period.
+	BytesSent float64 `protobuf:"fixed64,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// Indicates the aggregated percentile values by bytes.
+	BytesRankings *Ranking `protobuf:"bytes,4,opt,name=bytes_rankings,json=bytesRankings,proto3" json:"bytes_rankings,omitempty"`
+	// Indicates the aggregated percentile values by latency, over every
+	// broadcast. Failed ones count with the time until they failed.
+	LatencyRankings *Ranking `protobuf:"bytes,5,opt,name=latency_rankings,json=latencyRankings,proto3" json:"latency_rankings,omitempty"`
+	// The number of transactions sent within the second that the node accepted.
+	AcceptedTxs int64 `protobuf:"varint,6,opt,name=accepted_txs,json=acceptedTxs,proto3" json:"accepted_txs,omitempty"`
+	// The number of transactions sent within the second that failed.
+	FailedTxs int64 `protobuf:"varint,7,opt,name=failed_txs,json=failedTxs,proto3" json:"failed_txs,omitempty"`
+	// The number of transactions sent within the second by outcome.
+	Outcomes []*TxOutcomeCount `protobuf:"bytes,8,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	// The number of transactions sent within the second that were seen in a block.
+	// Streamed per-second statistics only count the ones included by the end of the second.
+	IncludedTxs int64 `protobuf:"varint,9,opt,name=included_txs,json=includedTxs,proto3" json:"included_txs,omitempty"`
+	// The number of transactions sent within the second that were dropped.
+	DroppedTxs int64 `protobuf:"varint,10,opt,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// Indicates the aggregated percentile values of the time between sending a
+	// transaction and seeing it in a block.
+	InclusionLatencyRankings *Ranking `protobuf:"bytes,11,opt,name=inclusion_latency_rankings,json=inclusionLatencyRankings,proto3" json:"inclusion_latency_rankings,omitempty"`
+	// The name of the stage running at the start of the second, when the load
+	// test has stages.
+	Stage string `protobuf:"bytes,12,opt,name=stage,proto3" json:"stage,omitempty"`
+}
+
+func (x *PerSecond) Reset() {
+	*x = PerSecond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerSecond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerSecond) ProtoMessage() {}
+
+func (x *PerSecond) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerSecond.ProtoReflect.Descriptor instead.
+func (*PerSecond) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PerSecond) GetSec() int64 {
+	if x != nil {
+		return x.Sec
+	}
+	return 0
+}
+
+func (x *PerSecond) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+func (x *PerSecond) GetBytesSent() float64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *PerSecond) GetBytesRankings() *Ranking {
+	if x != nil {
+		return x.BytesRankings
+	}
+	return nil
+}
+
+func (x *PerSecond) GetLatencyRankings() *Ranking {
+	if x != nil {
+		return x.LatencyRankings
+	}
+	return nil
+}
+
+func (x *PerSecond) GetAcceptedTxs() int64 {
+	if x != nil {
+		return x.AcceptedTxs
+	}
+	return 0
+}
+
+func (x *PerSecond) GetFailedTxs() int64 {
+	if x != nil {
+		return x.FailedTxs
+	}
+	return 0
+}
+
+func (x *PerSecond) GetOutcomes() []*TxOutcomeCount {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *PerSecond) GetIncludedTxs() int64 {
+	if x != nil {
+		return x.IncludedTxs
+	}
+	return 0
+}
+
+func (x *PerSecond) GetDroppedTxs() int64 {
+	if x != nil {
+		return x.DroppedTxs
+	}
+	return 0
+}
+
+func (x *PerSecond) GetInclusionLatencyRankings() *Ranking {
+	if x != nil {
+		return x.InclusionLatencyRankings
+	}
+	return nil
+}
+
+func (x *PerSecond) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+type TxOutcomeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome TxOutcomeCount_Outcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=orijtech.cosmosloadtester.v1.TxOutcomeCount_Outcome" json:"outcome,omitempty"`
+	// The code the transactions were rejected with, for OUTCOME_REJECTED.
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The codespace of the code e.g. sdk.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// The number of transactions.
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TxOutcomeCount) Reset() {
+	*x = TxOutcomeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOutcomeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOutcomeCount) ProtoMessage() {}
+
+func (x *TxOutcomeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOutcomeCount.ProtoReflect.Descriptor instead.
+func (*TxOutcomeCount) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TxOutcomeCount) GetOutcome() TxOutcomeCount_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TxOutcomeCount_OUTCOME_UNSPECIFIED
+}
+
+func (x *TxOutcomeCount) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TxOutcomeCount) GetCodespace() string {
+	if x != nil {
+		return x.Codespace
+	}
+	return ""
+}
+
+func (x *TxOutcomeCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Percentile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time relative to the request's start time.
+	StartOffset *durationpb.Duration `protobuf:"bytes,1,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	// The time between request send and receipt of a response.
+	Latency *durationpb.Duration `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The number of bytes sent.
+	BytesSent int64 `protobuf:"varint,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// The human friendly value of the percentile's occurence. It is useful for easy debugging.
+	AtStr string `protobuf:"bytes,4,opt,name=at_str,json=atStr,proto3" json:"at_str,omitempty"`
+}
+
+func (x *Percentile) Reset() {
+	*x = Percentile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Percentile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percentile) ProtoMessage() {}
+
+func (x *Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percentile.ProtoReflect.Descriptor instead.
+func (*Percentile) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Percentile) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *Percentile) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *Percentile) GetBytesSent() int64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *Percentile) GetAtStr() string {
+	if x != nil {
+		return x.AtStr
+	}
+	return ""
+}
+
+type Ranking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 50th percentile value aka the median.
+	P50 *Percentile `protobuf:"bytes,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	// The 75th percentile value.
+	P75 *Percentile `protobuf:"bytes,2,opt,name=p75,proto3" json:"p75,omitempty"`
+	// The 90th percentile value.
+	P90 *Percentile `protobuf:"bytes,3,opt,name=p90,proto3" json:"p90,omitempty"`
+	// The 95th percentile value.
+	P95 *Percentile `protobuf:"bytes,4,opt,name=p95,proto3" json:"p95,omitempty"`
+	// The 99th percentile value, useful to identify outliers.
+	P99 *Percentile `protobuf:"bytes,5,opt,name=p99,proto3" json:"p99,omitempty"`
+}
+
+func (x *Ranking) Reset() {
+	*x = Ranking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ranking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ranking) ProtoMessage() {}
+
+func (x *Ranking) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ranking.ProtoReflect.Descriptor instead.
+func (*Ranking) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Ranking) GetP50() *Percentile {
+	if x != nil {
+		return x.P50
+	}
+	return nil
+}
+
+func (x *Ranking) GetP75() *Percentile {
+	if x != nil {
+		return x.P75
+	}
+	return nil
+}
+
+func (x *Ranking) GetP90() *Percentile {
+	if x != nil {
+		return x.P90
+	}
+	return nil
+}
+
+func (x *Ranking) GetP95() *Percentile {
+	if x != nil {
+		return x.P95
+	}
+	return nil
+}
+
+func (x *Ranking) GetP99() *Percentile {
+	if x != nil {
+		return x.P99
+	}
+	return nil
+}
+
+type StartLoadtestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID with which to query the load test.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StartLoadtestResponse) Reset() {
+	*x = StartLoadtestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartLoadtestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLoadtestResponse) ProtoMessage() {}
+
+func (x *StartLoadtestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLoadtestResponse.ProtoReflect.Descriptor instead.
+func (*StartLoadtestResponse) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartLoadtestResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Loadtest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the load test.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The current state of the load test.
+	State Loadtest_State `protobuf:"varint,2,opt,name=state,proto3,enum=orijtech.cosmosloadtester.v1.Loadtest_State" json:"state,omitempty"`
+	// The request the load test was started with.
+	Request *RunLoadtestRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// The result of the load test. While it is running this holds the
+	// statistics of the seconds that have elapsed so far.
+	// Left empty by ListLoadtests.
+	Result *RunLoadtestResponse `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	// Why the load test failed, if it did.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// When the load test was started.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When the load test finished, if it has.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The number of transactions and bytes sent by each transactor, one per endpoint.
+	EndpointTotals []*TransactorProgress `protobuf:"bytes,8,rep,name=endpoint_totals,json=endpointTotals,proto3" json:"endpoint_totals,omitempty"`
+}
+
+func (x *Loadtest) Reset() {
+	*x = Loadtest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loadtest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loadtest) ProtoMessage() {}
+
+func (x *Loadtest) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loadtest.ProtoReflect.Descriptor instead.
+func (*Loadtest) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Loadtest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Loadtest) GetState() Loadtest_State {
+	if x != nil {
+		return x.State
+	}
+	return Loadtest_STATE_UNSPECIFIED
+}
+
+func (x *Loadtest) GetRequest() *RunLoadtestRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Loadtest) GetResult() *RunLoadtestResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Loadtest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Loadtest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Loadtest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Loadtest) GetEndpointTotals() []*TransactorProgress {
+	if x != nil {
+		return x.EndpointTotals
+	}
+	return nil
+}
+
+type GetLoadtestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID returned by StartLoadtest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLoadtestRequest) Reset() {
+	*x = GetLoadtestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoadtestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadtestRequest) ProtoMessage() {}
+
+func (x *GetLoadtestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadtestRequest.ProtoReflect.Descriptor instead.
+func (*GetLoadtestRequest) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetLoadtestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListLoadtestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of load tests to return. Defaults to 20, and values
+	// above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous ListLoadtests call, to get the next page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListLoadtestsRequest) Reset() {
+	*x = ListLoadtestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoadtestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadtestsRequest) ProtoMessage() {}
+
+func (x *ListLoadtestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadtestsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoadtestsRequest) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListLoadtestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoadtestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLoadtestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The load tests, most recently started first.
+	Loadtests []*Loadtest `protobuf:"bytes,1,rep,name=loadtests,proto3" json:"loadtests,omitempty"`
+	// Pass this as page_token to get the next page. Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListLoadtestsResponse) Reset() {
+	*x = ListLoadtestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoadtestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadtestsResponse) ProtoMessage() {}
+
+func (x *ListLoadtestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadtestsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoadtestsResponse) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListLoadtestsResponse) GetLoadtests() []*Loadtest {
+	if x != nil {
+		return x.Loadtests
+	}
+	return nil
+}
+
+func (x *ListLoadtestsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelLoadtestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID returned by StartLoadtest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelLoadtestRequest) Reset() {
+	*x = CancelLoadtestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLoadtestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoadtestRequest) ProtoMessage() {}
+
+func (x *CancelLoadtestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoadtestRequest.ProtoReflect.Descriptor instead.
+func (*CancelLoadtestRequest) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelLoadtestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompareLoadtestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the load test to compare against.
+	BaselineId string `protobuf:"bytes,1,opt,name=baseline_id,json=baselineId,proto3" json:"baseline_id,omitempty"`
+	// The ID of the load test to compare.
+	CandidateId string `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	// How much worse than the baseline the candidate may do before it counts
+	// as a regression. Defaults to 5% lower TPS and bytes and 10% higher
+	// latencies, with a significance of 0.05.
+	Tolerances *CompareTolerances `protobuf:"bytes,3,opt,name=tolerances,proto3" json:"tolerances,omitempty"`
+}
+
+func (x *CompareLoadtestsRequest) Reset() {
+	*x = CompareLoadtestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareLoadtestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareLoadtestsRequest) ProtoMessage() {}
+
+func (x *CompareLoadtestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareLoadtestsRequest.ProtoReflect.Descriptor instead.
+func (*CompareLoadtestsRequest) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompareLoadtestsRequest) GetBaselineId() string {
+	if x != nil {
+		return x.BaselineId
+	}
+	return ""
+}
+
+func (x *CompareLoadtestsRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *CompareLoadtestsRequest) GetTolerances() *CompareTolerances {
+	if x != nil {
+		return x.Tolerances
+	}
+	return nil
+}
+
+// CompareTolerances are in percent of the baseline.
+type CompareTolerances struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How much lower the TPS may be.
+	Tps float64 `protobuf:"fixed64,1,opt,name=tps,proto3" json:"tps,omitempty"`
+	// How much lower the bytes per second may be.
+	Bytes float64 `protobuf:"fixed64,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// How much higher the latency percentiles may be.
+	Latency float64 `protobuf:"fixed64,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The p-value below which a difference is significant. Defaults to 0.05.
+	Significance float64 `protobuf:"fixed64,4,opt,name=significance,proto3" json:"significance,omitempty"`
+}
+
+func (x *CompareTolerances) Reset() {
+	*x = CompareTolerances{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareTolerances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareTolerances) ProtoMessage() {}
+
+func (x *CompareTolerances) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareTolerances.ProtoReflect.Descriptor instead.
+func (*CompareTolerances) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompareTolerances) GetTps() float64 {
+	if x != nil {
+		return x.Tps
+	}
+	return 0
+}
+
+func (x *CompareTolerances) GetBytes() float64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *CompareTolerances) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *CompareTolerances) GetSignificance() float64 {
+	if x != nil {
+		return x.Significance
+	}
+	return 0
+}
+
+type CompareLoadtestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baseline  *Loadtest `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Candidate *Loadtest `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// The per-second series of both load tests compared over the seconds they
+	// both have: TPS and bytes first, then every latency percentile.
+	Series []*SeriesComparison `protobuf:"bytes,4,rep,name=series,proto3" json:"series,omitempty"`
+	// The TPS and P99 latency of every second both load tests have.
+	PerSec []*AlignedSecond `protobuf:"bytes,5,rep,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
+}
+
+func (x *CompareLoadtestsResponse) Reset() {
+	*x = CompareLoadtestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareLoadtestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareLoadtestsResponse) ProtoMessage() {}
+
+func (x *CompareLoadtestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareLoadtestsResponse.ProtoReflect.Descriptor instead.
+func (*CompareLoadtestsResponse) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CompareLoadtestsResponse) GetBaseline() *Loadtest {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *CompareLoadtestsResponse) GetCandidate() *Loadtest {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *CompareLoadtestsResponse) GetSeries() []*SeriesComparison {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CompareLoadtestsResponse) GetPerSec() []*AlignedSecond {
+	if x != nil {
+		return x.PerSec
+	}
+	return nil
+}
+
+type SeriesComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the series e.g. tps or p99_latency.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unit of the series e.g. tx/s or ms.
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Whether a higher value is better, as for TPS, rather than worse, as for
+	// latencies.
+	HigherIsBetter bool `protobuf:"varint,3,opt,name=higher_is_better,json=higherIsBetter,proto3" json:"higher_is_better,omitempty"`
+	// The number of seconds compared, those in which both load tests have a
+	// value.
+	Seconds int32 `protobuf:"varint,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// The means of the series.
+	Baseline  float64 `protobuf:"fixed64,5,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Candidate float64 `protobuf:"fixed64,6,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// candidate - baseline.
+	Delta float64 `protobuf:"fixed64,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The delta as a percentage of the baseline, or 0 if the baseline is 0.
+	DeltaPercent float64 `protobuf:"fixed64,8,opt,name=delta_percent,json=deltaPercent,proto3" json:"delta_percent,omitempty"`
+	// The two-sided p-value of a Mann-Whitney U test of the two series, -1 if
+	// either has fewer than 3 seconds.
+	PValue      float64 `protobuf:"fixed64,9,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	Significant bool    `protobuf:"varint,10,opt,name=significant,proto3" json:"significant,omitempty"`
+	// Whether the candidate did worse than the tolerance allows, and the
+	// difference is significant or could not be tested.
+	Regression bool `protobuf:"varint,11,opt,name=regression,proto3" json:"regression,omitempty"`
+	// Whether the candidate did significantly better than the tolerance.
+	Improvement bool `protobuf:"varint,12,opt,name=improvement,proto3" json:"improvement,omitempty"`
+	// The number of seconds in which only the other load test has a value,
+	// such as seconds without answered transactions for latencies, which are
+	// left out.
+	BaselineMissing  int32 `protobuf:"varint,13,opt,name=baseline_missing,json=baselineMissing,proto3" json:"baseline_missing,omitempty"`
+	CandidateMissing int32 `protobuf:"varint,14,opt,name=candidate_missing,json=candidateMissing,proto3" json:"candidate_missing,omitempty"`
+}
+
+func (x *SeriesComparison) Reset() {
+	*x = SeriesComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesComparison) ProtoMessage() {}
+
+func (x *SeriesComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesComparison.ProtoReflect.Descriptor instead.
+func (*SeriesComparison) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SeriesComparison) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeriesComparison) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *SeriesComparison) GetHigherIsBetter() bool {
+	if x != nil {
+		return x.HigherIsBetter
+	}
+	return false
+}
+
+func (x *SeriesComparison) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetBaseline() float64 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetCandidate() float64 {
+	if x != nil {
+		return x.Candidate
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetDeltaPercent() float64 {
+	if x != nil {
+		return x.DeltaPercent
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+func (x *SeriesComparison) GetRegression() bool {
+	if x != nil {
+		return x.Regression
+	}
+	return false
+}
+
+func (x *SeriesComparison) GetImprovement() bool {
+	if x != nil {
+		return x.Improvement
+	}
+	return false
+}
+
+func (x *SeriesComparison) GetBaselineMissing() int32 {
+	if x != nil {
+		return x.BaselineMissing
+	}
+	return 0
+}
+
+func (x *SeriesComparison) GetCandidateMissing() int32 {
+	if x != nil {
+		return x.CandidateMissing
+	}
+	return 0
+}
+
+type AlignedSecond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sec          int64   `protobuf:"varint,1,opt,name=sec,proto3" json:"sec,omitempty"`
+	BaselineTps  float64 `protobuf:"fixed64,2,opt,name=baseline_tps,json=baselineTps,proto3" json:"baseline_tps,omitempty"`
+	CandidateTps float64 `protobuf:"fixed64,3,opt,name=candidate_tps,json=candidateTps,proto3" json:"candidate_tps,omitempty"`
+	// P99 latencies, 0 if no transaction was answered in the second.
+	BaselineP99  *durationpb.Duration `protobuf:"bytes,4,opt,name=baseline_p99,json=baselineP99,proto3" json:"baseline_p99,omitempty"`
+	CandidateP99 *durationpb.Duration `protobuf:"bytes,5,opt,name=candidate_p99,json=candidateP99,proto3" json:"candidate_p99,omitempty"`
+}
+
+func (x *AlignedSecond) Reset() {
+	*x = AlignedSecond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlignedSecond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlignedSecond) ProtoMessage() {}
+
+func (x *AlignedSecond) ProtoReflect() protoreflect.Message {
+	mi := &file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlignedSecond.ProtoReflect.Descriptor instead.
+func (*AlignedSecond) Descriptor() ([]byte, []int) {
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AlignedSecond) GetSec() int64 {
+	if x != nil {
+		return x.Sec
+	}
+	return 0
+}
+
+func (x *AlignedSecond) GetBaselineTps() float64 {
+	if x != nil {
+		return x.BaselineTps
+	}
+	return 0
+}
+
+func (x *AlignedSecond) GetCandidateTps() float64 {
+	if x != nil {
+		return x.CandidateTps
+	}
+	return 0
+}
+
+func (x *AlignedSecond) GetBaselineP99() *durationpb.Duration {
+	if x != nil {
+		return x.BaselineP99
+	}
+	return nil
+}
+
+func (x *AlignedSecond) GetCandidateP99() *durationpb.Duration {
+	if x != nil {
+		return x.CandidateP99
+	}
+	return nil
+}
+
+var File_orijtech_cosmosloadtester_v1_loadtest_service_proto protoreflect.FileDescriptor
+
+var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xa2, 0x11, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x36,
+	0x0a, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x13, 0x62, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x16, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x6f, 0x72,
+	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f,
+	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x14, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70,
+	0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x72, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x42, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x6e, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x55, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x67,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52,
+	0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x1f,
+	0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54,
+	0x58, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x58, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x22, 0xaa,
+	0x01, 0x0a, 0x14, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x4e, 0x44, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x23, 0x0a, 0x1f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45,
+	0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45,
+	0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x03, 0x22, 0x95, 0x01, 0x0a, 0x11,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x55, 0x54,
+	0x50, 0x55, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x41,
+	0x52, 0x10, 0x03, 0x22, 0x72, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x02, 0x22, 0x8f, 0x03, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52,
+	0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x74, 0x6f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6d, 0x70, 0x6c,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x5f, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x52, 0x41, 0x4d, 0x50, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x53, 0x50, 0x49, 0x4b, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41,
+	0x50, 0x45, 0x5f, 0x53, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x22, 0xbd, 0x02, 0x0a, 0x07, 0x41, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x6a, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4d,
+	0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f,
+	0x50, 0x4f, 0x49, 0x53, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44,
+	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x49, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x04, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x0e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x65, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x73, 0x77, 0x65, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xb3,
+	0x06, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x78, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x12, 0x61, 0x76, 0x67, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x54,
+	0x78, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x61,
+	0x76, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61, 0x76, 0x67, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c,
+	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x57,
+	0x0a, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72,
+	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63,
+	0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x69,
+	0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x22, 0xfe, 0x02, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x61,
+	0x76, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x61, 0x76, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x61, 0x76, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x67, 0x47,
+	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x45,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x54, 0x78, 0x73, 0x22, 0xe7, 0x05, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x76, 0x67, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x61, 0x76, 0x67, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x61, 0x76, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x54, 0x78, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x1a, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x84, 0x02, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f,
+	0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f,
+	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72,
+	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x4d,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x07, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x64, 0x22, 0xb9, 0x04, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x71, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69,
+	0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x50, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69,
+	0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x78, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54, 0x78,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x12, 0x63, 0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0xbd, 0x02,
+	0x0a, 0x0e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x4e, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x07, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x55, 0x54,
+	0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x50, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x22, 0xb5, 0x01,
+	0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x61, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x74, 0x53, 0x74, 0x72, 0x22, 0xb5, 0x02, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x3a, 0x0a,
+	0x03, 0x70, 0x37, 0x35, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x69,
+	0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x37, 0x35, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x39, 0x30,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x52, 0x03, 0x70, 0x39, 0x30, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x39,
+	0x35, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x39, 0x39, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x70, 0x39, 0x39, 0x22, 0x27, 0x0a,
+	0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc7, 0x04, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x74,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x22, 0x6d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x09, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x17,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x0a, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x0a, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x11,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x74, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xc1, 0x02, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72,
+	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xc8, 0x03, 0x0a, 0x10,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x69, 0x67, 0x68,
+	0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x72, 0x49, 0x73, 0x42, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x72, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x70, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x70, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70,
+	0x39, 0x39, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x39, 0x39,
+	0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x39,
+	0x39, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x39, 0x39,
+	0x32, 0xa4, 0x08, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x3a, 0x72, 0x75, 0x6e, 0x12, 0x9a, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a,
+	0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x72,
+	0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01,
+	0x2a, 0x30, 0x01, 0x12, 0x90, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x61,
+	0x64, 0x74, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x61, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f,
+	0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x32,
+	0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x93,
+	0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73,
+	0x74, 0x12, 0x33, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x35, 0x2e, 0x6f, 0x72, 0x69, 0x6a,
+	0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x73, 0x3a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x69, 0x6a, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x6c, 0x6f, 0x61, 0x64, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescOnce sync.Once
+	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData = file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDesc
+)
+
+func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescGZIP() []byte {
+	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescOnce.Do(func() {
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData)
+	})
+	return file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDescData
+}
+
+var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_goTypes = []interface{}{
+	(RunLoadtestRequest_BroadcastTxMethod)(0),    // 0: orijtech.cosmosloadtester.v1.RunLoadtestRequest.BroadcastTxMethod
+	(RunLoadtestRequest_EndpointSelectMethod)(0), // 1: orijtech.cosmosloadtester.v1.RunLoadtestRequest.EndpointSelectMethod
+	(RunLoadtestRequest_StatsOutputFormat)(0),    // 2: orijtech.cosmosloadtester.v1.RunLoadtestRequest.StatsOutputFormat
+	(RunLoadtestRequest_ConfirmationMode)(0),     // 3: orijtech.cosmosloadtester.v1.RunLoadtestRequest.ConfirmationMode
+	(Stage_Shape)(0),                             // 4: orijtech.cosmosloadtester.v1.Stage.Shape
+	(Arrival_Model)(0),                           // 5: orijtech.cosmosloadtester.v1.Arrival.Model
+	(TxOutcomeCount_Outcome)(0),                  // 6: orijtech.cosmosloadtester.v1.TxOutcomeCount.Outcome
+	(Loadtest_State)(0),                          // 7: orijtech.cosmosloadtester.v1.Loadtest.State
+	(*RunLoadtestRequest)(nil),                   // 8: orijtech.cosmosloadtester.v1.RunLoadtestRequest
+	(*Stage)(nil),                                // 9: orijtech.cosmosloadtester.v1.Stage
+	(*Arrival)(nil),                              // 10: orijtech.cosmosloadtester.v1.Arrival
+	(*AccountFunding)(nil),                       // 11: orijtech.cosmosloadtester.v1.AccountFunding
+	(*RunLoadtestResponse)(nil),                  // 12: orijtech.cosmosloadtester.v1.RunLoadtestResponse
+	(*ChainMetrics)(nil),                         // 13: orijtech.cosmosloadtester.v1.ChainMetrics
+	(*ChainPerSecond)(nil),                       // 14: orijtech.cosmosloadtester.v1.ChainPerSecond
+	(*EndpointResult)(nil),                       // 15: orijtech.cosmosloadtester.v1.EndpointResult
+	(*StreamLoadtestResponse)(nil),               // 16: orijtech.cosmosloadtester.v1.StreamLoadtestResponse
+	(*TransactorProgress)(nil),                   // 17: orijtech.cosmosloadtester.v1.TransactorProgress
+	(*PerSecond)(nil),                            // 18: orijtech.cosmosloadtester.v1.PerSecond
+	(*TxOutcomeCount)(nil),                       // 19: orijtech.cosmosloadtester.v1.TxOutcomeCount
+	(*Percentile)(nil),                           // 20: orijtech.cosmosloadtester.v1.Percentile
+	(*Ranking)(nil),                              // 21: orijtech.cosmosloadtester.v1.Ranking
+	(*StartLoadtestResponse)(nil),                // 22: orijtech.cosmosloadtester.v1.StartLoadtestResponse
+	(*Loadtest)(nil),                             // 23: orijtech.cosmosloadtester.v1.Loadtest
+	(*GetLoadtestRequest)(nil),                   // 24: orijtech.cosmosloadtester.v1.GetLoadtestRequest
+	(*ListLoadtestsRequest)(nil),                 // 25: orijtech.cosmosloadtester.v1.ListLoadtestsRequest
+	(*ListLoadtestsResponse)(nil),                // 26: orijtech.cosmosloadtester.v1.ListLoadtestsResponse
+	(*CancelLoadtestRequest)(nil),                // 27: orijtech.cosmosloadtester.v1.CancelLoadtestRequest
+	(*CompareLoadtestsRequest)(nil),              // 28: orijtech.cosmosloadtester.v1.CompareLoadtestsRequest
+	(*CompareTolerances)(nil),                    // 29: orijtech.cosmosloadtester.v1.CompareTolerances
+	(*CompareLoadtestsResponse)(nil),             // 30: orijtech.cosmosloadtester.v1.CompareLoadtestsResponse
+	(*SeriesComparison)(nil),                     // 31: orijtech.cosmosloadtester.v1.SeriesComparison
+	(*AlignedSecond)(nil),                        // 32: orijtech.cosmosloadtester.v1.AlignedSecond
+	nil,                                          // 33: orijtech.cosmosloadtester.v1.RunLoadtestRequest.ClientParamsEntry
+	(*durationpb.Duration)(nil),                  // 34: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                // 35: google.protobuf.Timestamp
+}
+var file_orijtech_cosmosloadtester_v1_loadtest_service_proto_depIdxs = []int32{
+	34, // 0: orijtech.cosmosloadtester.v1.RunLoadtestRequest.duration:type_name -> google.protobuf.Duration
+	34, // 1: orijtech.cosmosloadtester.v1.RunLoadtestRequest.send_period:type_name -> google.protobuf.Duration
+	0,  // 2: orijtech.cosmosloadtester.v1.RunLoadtestRequest.broadcast_tx_method:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.BroadcastTxMethod
+	1,  // 3: orijtech.cosmosloadtester.v1.RunLoadtestRequest.endpoint_select_method:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.EndpointSelectMethod
+	34, // 4: orijtech.cosmosloadtester.v1.RunLoadtestRequest.peer_connect_timeout:type_name -> google.protobuf.Duration
+	2,  // 5: orijtech.cosmosloadtester.v1.RunLoadtestRequest.stats_output_format:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.StatsOutputFormat
+	3,  // 6: orijtech.cosmosloadtester.v1.RunLoadtestRequest.confirmation_mode:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.ConfirmationMode
+	34, // 7: orijtech.cosmosloadtester.v1.RunLoadtestRequest.confirmation_timeout:type_name -> google.protobuf.Duration
+	11, // 8: orijtech.cosmosloadtester.v1.RunLoadtestRequest.account_funding:type_name -> orijtech.cosmosloadtester.v1.AccountFunding
+	33, // 9: orijtech.cosmosloadtester.v1.RunLoadtestRequest.client_params:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest.ClientParamsEntry
+	10, // 10: orijtech.cosmosloadtester.v1.RunLoadtestRequest.arrival:type_name -> orijtech.cosmosloadtester.v1.Arrival
+	9,  // 11: orijtech.cosmosloadtester.v1.RunLoadtestRequest.stages:type_name -> orijtech.cosmosloadtester.v1.Stage
+	4,  // 12: orijtech.cosmosloadtester.v1.Stage.shape:type_name -> orijtech.cosmosloadtester.v1.Stage.Shape
+	34, // 13: orijtech.cosmosloadtester.v1.Stage.duration:type_name -> google.protobuf.Duration
+	34, // 14: orijtech.cosmosloadtester.v1.Stage.period:type_name -> google.protobuf.Duration
+	5,  // 15: orijtech.cosmosloadtester.v1.Arrival.model:type_name -> orijtech.cosmosloadtester.v1.Arrival.Model
+	34, // 16: orijtech.cosmosloadtester.v1.Arrival.intervals:type_name -> google.protobuf.Duration
+	34, // 17: orijtech.cosmosloadtester.v1.AccountFunding.timeout:type_name -> google.protobuf.Duration
+	34, // 18: orijtech.cosmosloadtester.v1.RunLoadtestResponse.total_time:type_name -> google.protobuf.Duration
+	18, // 19: orijtech.cosmosloadtester.v1.RunLoadtestResponse.per_sec:type_name -> orijtech.cosmosloadtester.v1.PerSecond
+	15, // 20: orijtech.cosmosloadtester.v1.RunLoadtestResponse.endpoint_results:type_name -> orijtech.cosmosloadtester.v1.EndpointResult
+	19, // 21: orijtech.cosmosloadtester.v1.RunLoadtestResponse.outcomes:type_name -> orijtech.cosmosloadtester.v1.TxOutcomeCount
+	21, // 22: orijtech.cosmosloadtester.v1.RunLoadtestResponse.inclusion_latency_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
+	13, // 23: orijtech.cosmosloadtester.v1.RunLoadtestResponse.chain_metrics:type_name -> orijtech.cosmosloadtester.v1.ChainMetrics
+	34, // 24: orijtech.cosmosloadtester.v1.ChainMetrics.avg_block_time:type_name -> google.protobuf.Duration
+	14, // 25: orijtech.cosmosloadtester.v1.ChainMetrics.per_sec:type_name -> orijtech.cosmosloadtester.v1.ChainPerSecond
+	34, // 26: orijtech.cosmosloadtester.v1.ChainPerSecond.avg_block_time:type_name -> google.protobuf.Duration
+	34, // 27: orijtech.cosmosloadtester.v1.EndpointResult.total_time:type_name -> google.protobuf.Duration
+	18, // 28: orijtech.cosmosloadtester.v1.EndpointResult.per_sec:type_name -> orijtech.cosmosloadtester.v1.PerSecond
+	19, // 29: orijtech.cosmosloadtester.v1.EndpointResult.outcomes:type_name -> orijtech.cosmosloadtester.v1.TxOutcomeCount
+	21, // 30: orijtech.cosmosloadtester.v1.EndpointResult.inclusion_latency_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
+	17, // 31: orijtech.cosmosloadtester.v1.StreamLoadtestResponse.progress:type_name -> orijtech.cosmosloadtester.v1.TransactorProgress
+	18, // 32: orijtech.cosmosloadtester.v1.StreamLoadtestResponse.per_sec:type_name -> orijtech.cosmosloadtester.v1.PerSecond
+	12, // 33: orijtech.cosmosloadtester.v1.StreamLoadtestResponse.summary:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestResponse
+	34, // 34: orijtech.cosmosloadtester.v1.TransactorProgress.elapsed:type_name -> google.protobuf.Duration
+	21, // 35: orijtech.cosmosloadtester.v1.PerSecond.bytes_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
+	21, // 36: orijtech.cosmosloadtester.v1.PerSecond.latency_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
+	19, // 37: orijtech.cosmosloadtester.v1.PerSecond.outcomes:type_name -> orijtech.cosmosloadtester.v1.TxOutcomeCount
+	21, // 38: orijtech.cosmosloadtester.v1.PerSecond.inclusion_latency_rankings:type_name -> orijtech.cosmosloadtester.v1.Ranking
+	6,  // 39: orijtech.cosmosloadtester.v1.TxOutcomeCount.outcome:type_name -> orijtech.cosmosloadtester.v1.TxOutcomeCount.Outcome
+	34, // 40: orijtech.cosmosloadtester.v1.Percentile.start_offset:type_name -> google.protobuf.Duration
+	34, // 41: orijtech.cosmosloadtester.v1.Percentile.latency:type_name -> google.protobuf.Duration
+	20, // 42: orijtech.cosmosloadtester.v1.Ranking.p50:type_name -> orijtech.cosmosloadtester.v1.Percentile
+	20, // 43: orijtech.cosmosloadtester.v1.Ranking.p75:type_name -> orijtech.cosmosloadtester.v1.Percentile
+	20, // 44: orijtech.cosmosloadtester.v1.Ranking.p90:type_name -> orijtech.cosmosloadtester.v1.Percentile
+	20, // 45: orijtech.cosmosloadtester.v1.Ranking.p95:type_name -> orijtech.cosmosloadtester.v1.Percentile
+	20, // 46: orijtech.cosmosloadtester.v1.Ranking.p99:type_name -> orijtech.cosmosloadtester.v1.Percentile
+	7,  // 47: orijtech.cosmosloadtester.v1.Loadtest.state:type_name -> orijtech.cosmosloadtester.v1.Loadtest.State
+	8,  // 48: orijtech.cosmosloadtester.v1.Loadtest.request:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestRequest
+	12, // 49: orijtech.cosmosloadtester.v1.Loadtest.result:type_name -> orijtech.cosmosloadtester.v1.RunLoadtestResponse
+	35, // 50: orijtech.cosmosloadtester.v1.Loadtest.start_time:type_name -> google.protobuf.Timestamp
+	35, // 51: orijtech.cosmosloadtester.v1.Loadtest.end_time:type_name -> google.protobuf.Timestamp
+	17, // 52: orijtech.cosmosloadtester.v1.Loadtest.endpoint_totals:type_name -> orijtech.cosmosloadtester.v1.TransactorProgress
+	23, // 53: orijtech.cosmosloadtester.v1.ListLoadtestsResponse.loadtests:type_name -> orijtech.cosmosloadtester.v1.Loadtest
+	29, // 54: orijtech.cosmosloadtester.v1.CompareLoadtestsRequest.tolerances:type_name -> orijtech.cosmosloadtester.v1.CompareTolerances
+	23, // 55: orijtech.cosmosloadtester.v1.CompareLoadtestsResponse.baseline:type_name -> orijtech.cosmosloadtester.v1.Loadtest
+	23, // 56: orijtech.cosmosloadtester.v1.CompareLoadtestsResponse.candidate:type_name -> orijtech.cosmosloadtester.v1.Loadtest
+	31, // 57: orijtech.cosmosloadtester.v1.CompareLoadtestsResponse.series:type_name -> orijtech.cosmosloadtester.v1.SeriesComparison
+	32, // 58: orijtech.cosmosloadtester.v1.CompareLoadtestsResponse.per_sec:type_name -> orijtech.cosmosloadtester.v1.AlignedSecond
+	34, // 59: orijtech.cosmosloadtester.v1.AlignedSecond.baseline_p99:type_name -> google.protobuf.Duration
+	34, // 60: orijtech.cosmosloadtester.v1.AlignedSecond.candidate_p99:type_name -> google.protobuf.Duration
+	8,  // 61: orijtech.cosmosloadtester.v1.LoadtestService.RunLoadtest:input_type -> orijtech.cosmosloadtester.v1.RunLoadtestRequest
+	8,  // 62: orijtech.cosmosloadtester.v1.LoadtestService.StreamLoadtest:input_type -> orijtech.cosmosloadtester.v1.RunLoadtestRequest
+	8,  // 63: orijtech.cosmosloadtester.v1.LoadtestService.StartLoadtest:input_type -> orijtech.cosmosloadtester.v1.RunLoadtestRequest
+	24, // 64: orijtech.cosmosloadtester.v1.LoadtestService.GetLoadtest:input_type -> orijtech.cosmosloadtester.v1.GetLoadtestRequest
+	25, // 65: orijtech.cosmosloadtester.v1.LoadtestService.ListLoadtests:input_type -> orijtech.cosmosloadtester.v1.ListLoadtestsRequest
+	27, // 66: orijtech.cosmosloadtester.v1.LoadtestService.CancelLoadtest:input_type -> orijtech.cosmosloadtester.v1.CancelLoadtestRequest
+	28, // 67: orijtech.cosmosloadtester.v1.LoadtestService.CompareLoadtests:input_type -> orijtech.cosmosloadtester.v1.CompareLoadtestsRequest
+	12, // 68: orijtech.cosmosloadtester.v1.LoadtestService.RunLoadtest:output_type -> orijtech.cosmosloadtester.v1.RunLoadtestResponse
+	16, // 69: orijtech.cosmosloadtester.v1.LoadtestService.StreamLoadtest:output_type -> orijtech.cosmosloadtester.v1.StreamLoadtestResponse
+	22, // 70: orijtech.cosmosloadtester.v1.LoadtestService.StartLoadtest:output_type -> orijtech.cosmosloadtester.v1.StartLoadtestResponse
+	23, // 71: orijtech.cosmosloadtester.v1.LoadtestService.GetLoadtest:output_type -> orijtech.cosmosloadtester.v1.Loadtest
+	26, // 72: orijtech.cosmosloadtester.v1.LoadtestService.ListLoadtests:output_type -> orijtech.cosmosloadtester.v1.ListLoadtestsResponse
+	23, // 73: orijtech.cosmosloadtester.v1.LoadtestService.CancelLoadtest:output_type -> orijtech.cosmosloadtester.v1.Loadtest
+	30, // 74: orijtech.cosmosloadtester.v1.LoadtestService.CompareLoadtests:output_type -> orijtech.cosmosloadtester.v1.CompareLoadtestsResponse
+	68, // [68:75] is the sub-list for method output_type
+	61, // [61:68] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() }
+func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() {
+	if File_orijtech_cosmosloadtester_v1_loadtest_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLoadtestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Arrival); i {
+			case 0:
 				return &v.state
 			case 1:
 				return &v.sizeCache
@@ -931,7 +3844,7 @@ func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() {
 			}
 		}
 		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Percentile); i {
+			switch v := v.(*AccountFunding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -943,6 +3856,114 @@ func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() {
 			}
 		}
 		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLoadtestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainPerSecond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndpointResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLoadtestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactorProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerSecond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOutcomeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Percentile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Ranking); i {
 			case 0:
 				return &v.state
@@ -954,14 +3975,152 @@ func file_orijtech_cosmosloadtester_v1_loadtest_service_proto_init() {
 				return nil
 			}
 		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartLoadtestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loadtest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadtestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoadtestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoadtestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelLoadtestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareLoadtestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareTolerances); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareLoadtestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeriesComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlignedSecond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_orijtech_cosmosloadtester_v1_loadtest_service_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*StreamLoadtestResponse_Progress)(nil),
+		(*StreamLoadtestResponse_PerSec)(nil),
+		(*StreamLoadtestResponse_Summary)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_orijtech_cosmosloadtester_v1_loadtest_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      8,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
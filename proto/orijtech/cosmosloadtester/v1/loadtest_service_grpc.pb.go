@@ -23,6 +23,21 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LoadtestServiceClient interface {
 	RunLoadtest(ctx context.Context, in *RunLoadtestRequest, opts ...grpc.CallOption) (*RunLoadtestResponse, error)
+	// Runs a load test like RunLoadtest, streaming progress and per-second statistics
+	// while it runs. The final message holds the same summary RunLoadtest returns.
+	StreamLoadtest(ctx context.Context, in *RunLoadtestRequest, opts ...grpc.CallOption) (LoadtestService_StreamLoadtestClient, error)
+	// Starts a load test in the background and returns its ID right away. Unlike
+	// RunLoadtest, the load test keeps running if the client goes away.
+	StartLoadtest(ctx context.Context, in *RunLoadtestRequest, opts ...grpc.CallOption) (*StartLoadtestResponse, error)
+	// Returns the status and the partial or final result of a load test. Every
+	// load test is recorded, whichever RPC started it.
+	GetLoadtest(ctx context.Context, in *GetLoadtestRequest, opts ...grpc.CallOption) (*Loadtest, error)
+	// Lists load tests, most recently started first.
+	ListLoadtests(ctx context.Context, in *ListLoadtestsRequest, opts ...grpc.CallOption) (*ListLoadtestsResponse, error)
+	// Stops a running load test. The result gathered so far is kept.
+	CancelLoadtest(ctx context.Context, in *CancelLoadtestRequest, opts ...grpc.CallOption) (*Loadtest, error)
+	// Compares the results of two finished load tests.
+	CompareLoadtests(ctx context.Context, in *CompareLoadtestsRequest, opts ...grpc.CallOption) (*CompareLoadtestsResponse, error)
 }
 
 type loadtestServiceClient struct {
@@ -42,11 +57,103 @@ func (c *loadtestServiceClient) RunLoadtest(ctx context.Context, in *RunLoadtest
 	return out, nil
 }
 
+func (c *loadtestServiceClient) StreamLoadtest(ctx context.Context, in *RunLoadtestRequest, opts ...grpc.CallOption) (LoadtestService_StreamLoadtestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LoadtestService_ServiceDesc.Streams[0], "/orijtech.cosmosloadtester.v1.LoadtestService/StreamLoadtest", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &loadtestServiceStreamLoadtestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LoadtestService_StreamLoadtestClient interface {
+	Recv() (*StreamLoadtestResponse, error)
+	grpc.ClientStream
+}
+
+type loadtestServiceStreamLoadtestClient struct {
+	grpc.ClientStream
+}
+
+func (x *loadtestServiceStreamLoadtestClient) Recv() (*StreamLoadtestResponse, error) {
+	m := new(StreamLoadtestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *loadtestServiceClient) StartLoadtest(ctx context.Context, in *RunLoadtestRequest, opts ...grpc.CallOption) (*StartLoadtestResponse, error) {
+	out := new(StartLoadtestResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.v1.LoadtestService/StartLoadtest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadtestServiceClient) GetLoadtest(ctx context.Context, in *GetLoadtestRequest, opts ...grpc.CallOption) (*Loadtest, error) {
+	out := new(Loadtest)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.v1.LoadtestService/GetLoadtest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadtestServiceClient) ListLoadtests(ctx context.Context, in *ListLoadtestsRequest, opts ...grpc.CallOption) (*ListLoadtestsResponse, error) {
+	out := new(ListLoadtestsResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.v1.LoadtestService/ListLoadtests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadtestServiceClient) CancelLoadtest(ctx context.Context, in *CancelLoadtestRequest, opts ...grpc.CallOption) (*Loadtest, error) {
+	out := new(Loadtest)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.v1.LoadtestService/CancelLoadtest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadtestServiceClient) CompareLoadtests(ctx context.Context, in *CompareLoadtestsRequest, opts ...grpc.CallOption) (*CompareLoadtestsResponse, error) {
+	out := new(CompareLoadtestsResponse)
+	err := c.cc.Invoke(ctx, "/orijtech.cosmosloadtester.v1.LoadtestService/CompareLoadtests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoadtestServiceServer is the server API for LoadtestService service.
 // All implementations must embed UnimplementedLoadtestServiceServer
 // for forward compatibility
 type LoadtestServiceServer interface {
 	RunLoadtest(context.Context, *RunLoadtestRequest) (*RunLoadtestResponse, error)
+	// Runs a load test like RunLoadtest, streaming progress and per-second statistics
+	// while it runs. The final message holds the same summary RunLoadtest returns.
+	StreamLoadtest(*RunLoadtestRequest, LoadtestService_StreamLoadtestServer) error
+	// Starts a load test in the background and returns its ID right away. Unlike
+	// RunLoadtest, the load test keeps running if the client goes away.
+	StartLoadtest(context.Context, *RunLoadtestRequest) (*StartLoadtestResponse, error)
+	// Returns the status and the partial or final result of a load test. Every
+	// load test is recorded, whichever RPC started it.
+	GetLoadtest(context.Context, *GetLoadtestRequest) (*Loadtest, error)
+	// Lists load tests, most recently started first.
+	ListLoadtests(context.Context, *ListLoadtestsRequest) (*ListLoadtestsResponse, error)
+	// Stops a running load test. The result gathered so far is kept.
+	CancelLoadtest(context.Context, *CancelLoadtestRequest) (*Loadtest, error)
+	// Compares the results of two finished load tests.
+	CompareLoadtests(context.Context, *CompareLoadtestsRequest) (*CompareLoadtestsResponse, error)
 	mustEmbedUnimplementedLoadtestServiceServer()
 }
 
@@ -57,6 +164,24 @@ type UnimplementedLoadtestServiceServer struct {
 func (UnimplementedLoadtestServiceServer) RunLoadtest(context.Context, *RunLoadtestRequest) (*RunLoadtestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunLoadtest not implemented")
 }
+func (UnimplementedLoadtestServiceServer) StreamLoadtest(*RunLoadtestRequest, LoadtestService_StreamLoadtestServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLoadtest not implemented")
+}
+func (UnimplementedLoadtestServiceServer) StartLoadtest(context.Context, *RunLoadtestRequest) (*StartLoadtestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLoadtest not implemented")
+}
+func (UnimplementedLoadtestServiceServer) GetLoadtest(context.Context, *GetLoadtestRequest) (*Loadtest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadtest not implemented")
+}
+func (UnimplementedLoadtestServiceServer) ListLoadtests(context.Context, *ListLoadtestsRequest) (*ListLoadtestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoadtests not implemented")
+}
+func (UnimplementedLoadtestServiceServer) CancelLoadtest(context.Context, *CancelLoadtestRequest) (*Loadtest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoadtest not implemented")
+}
+func (UnimplementedLoadtestServiceServer) CompareLoadtests(context.Context, *CompareLoadtestsRequest) (*CompareLoadtestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareLoadtests not implemented")
+}
 func (UnimplementedLoadtestServiceServer) mustEmbedUnimplementedLoadtestServiceServer() {}
 
 // UnsafeLoadtestServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +213,117 @@ func _LoadtestService_RunLoadtest_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoadtestService_StreamLoadtest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunLoadtestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LoadtestServiceServer).StreamLoadtest(m, &loadtestServiceStreamLoadtestServer{stream})
+}
+
+type LoadtestService_StreamLoadtestServer interface {
+	Send(*StreamLoadtestResponse) error
+	grpc.ServerStream
+}
+
+type loadtestServiceStreamLoadtestServer struct {
+	grpc.ServerStream
+}
+
+func (x *loadtestServiceStreamLoadtestServer) Send(m *StreamLoadtestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LoadtestService_StartLoadtest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunLoadtestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadtestServiceServer).StartLoadtest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.v1.LoadtestService/StartLoadtest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadtestServiceServer).StartLoadtest(ctx, req.(*RunLoadtestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadtestService_GetLoadtest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadtestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadtestServiceServer).GetLoadtest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.v1.LoadtestService/GetLoadtest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadtestServiceServer).GetLoadtest(ctx, req.(*GetLoadtestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadtestService_ListLoadtests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoadtestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadtestServiceServer).ListLoadtests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.v1.LoadtestService/ListLoadtests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadtestServiceServer).ListLoadtests(ctx, req.(*ListLoadtestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadtestService_CancelLoadtest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoadtestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadtestServiceServer).CancelLoadtest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.v1.LoadtestService/CancelLoadtest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadtestServiceServer).CancelLoadtest(ctx, req.(*CancelLoadtestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadtestService_CompareLoadtests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareLoadtestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadtestServiceServer).CompareLoadtests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orijtech.cosmosloadtester.v1.LoadtestService/CompareLoadtests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadtestServiceServer).CompareLoadtests(ctx, req.(*CompareLoadtestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoadtestService_ServiceDesc is the grpc.ServiceDesc for LoadtestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,7 +335,33 @@ var LoadtestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunLoadtest",
 			Handler:    _LoadtestService_RunLoadtest_Handler,
 		},
+		{
+			MethodName: "StartLoadtest",
+			Handler:    _LoadtestService_StartLoadtest_Handler,
+		},
+		{
+			MethodName: "GetLoadtest",
+			Handler:    _LoadtestService_GetLoadtest_Handler,
+		},
+		{
+			MethodName: "ListLoadtests",
+			Handler:    _LoadtestService_ListLoadtests_Handler,
+		},
+		{
+			MethodName: "CancelLoadtest",
+			Handler:    _LoadtestService_CancelLoadtest_Handler,
+		},
+		{
+			MethodName: "CompareLoadtests",
+			Handler:    _LoadtestService_CompareLoadtests_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLoadtest",
+			Handler:       _LoadtestService_StreamLoadtest_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "orijtech/cosmosloadtester/v1/loadtest_service.proto",
 }
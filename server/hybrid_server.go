@@ -3,33 +3,103 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
+	"sync"
 	"time"
 
 	tmloadtest "github.com/informalsystems/tm-load-test/pkg/loadtest"
 	"github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	"github.com/orijtech/cosmosloadtester/pkg/results"
 	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // HybridServer extends the original server with HTTPS protocol support
 type HybridServer struct {
 	*Server
 	transactorFactory *loadtest.TransactorFactory
+	jobs              *jobManager
 }
 
-// NewHybridServer creates a new server that supports both WebSocket and HTTP(S) protocols
-func NewHybridServer() *HybridServer {
+// NewHybridServer creates a new server that supports both WebSocket and HTTP(S) protocols.
+// Every load test it runs is recorded in store.
+func NewHybridServer(store results.Store) *HybridServer {
 	return &HybridServer{
 		Server:            NewServer(),
 		transactorFactory: loadtest.NewTransactorFactory(),
+		jobs:              newJobManager(store),
 	}
 }
 
+// hybridLoadTest is a validated load test request.
+type hybridLoadTest struct {
+	config       *tmloadtest.Config
+	confirmation loadtest.ConfirmationConfig
+	funding      loadtest.FundingConfig
+	clientParams loadtest.ClientParams
+	arrival      loadtest.ArrivalConfig
+	stages       loadtest.Stages
+}
+
 // RunLoadtest runs a load test with hybrid protocol support
 func (s *HybridServer) RunLoadtest(ctx context.Context, req *loadtestpb.RunLoadtestRequest) (*loadtestpb.RunLoadtestResponse, error) {
+	lt, err := s.prepareHybridLoadTest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	j, jobCtx, err := s.jobs.start(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create and run hybrid load test
+	return s.runJob(jobCtx, j, lt, nil)
+}
+
+// StreamLoadtest runs a load test like RunLoadtest, streaming transactor
+// progress and per-second stats while it runs and the summary at the end.
+func (s *HybridServer) StreamLoadtest(req *loadtestpb.RunLoadtestRequest, stream loadtestpb.LoadtestService_StreamLoadtestServer) error {
+	lt, err := s.prepareHybridLoadTest(req)
+	if err != nil {
+		return err
+	}
+
+	// Progress callbacks fire from the transactors' goroutines, so sends have
+	// to be serialized.
+	var sendMtx sync.Mutex
+	emit := func(event *loadtestpb.StreamLoadtestResponse) {
+		sendMtx.Lock()
+		defer sendMtx.Unlock()
+		if err := stream.Send(event); err != nil {
+			logrus.Debugf("Failed to send load test event: %v", err)
+		}
+	}
+
+	// The load test is cancelled along with the stream if the client goes away.
+	j, jobCtx, err := s.jobs.start(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	res, err := s.runJob(jobCtx, j, lt, emit)
+	if err != nil {
+		return err
+	}
+
+	sendMtx.Lock()
+	defer sendMtx.Unlock()
+	return stream.Send(&loadtestpb.StreamLoadtestResponse{
+		Event: &loadtestpb.StreamLoadtestResponse_Summary{Summary: res},
+	})
+}
+
+// prepareHybridLoadTest validates the request and builds the configuration
+// shared by RunLoadtest and StreamLoadtest.
+func (s *HybridServer) prepareHybridLoadTest(req *loadtestpb.RunLoadtestRequest) (*hybridLoadTest, error) {
 	logrus.Info("Starting hybrid load test with protocol auto-detection")
 
 	// Validate and convert endpoints
@@ -49,15 +119,46 @@ func (s *HybridServer) RunLoadtest(ctx context.Context, req *loadtestpb.RunLoadt
 		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
 	}
 
+	// Stages set the duration, and the rate client factories validate
+	stages, err := stagesFromProto(req.Stages)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
+	}
+	if len(stages) > 0 {
+		config.Time = int(math.Ceil(stages.Duration().Seconds()))
+		config.Rate = stages.PeakRate()
+	}
+
 	// Validate that all client factories support the requested configuration
+	clientParams := loadtest.ClientParams(req.ClientParams)
 	for _, clientFactoryName := range []string{config.ClientFactory} {
-		if err := s.validateClientFactory(clientFactoryName, *config); err != nil {
+		if err := s.validateClientFactory(clientFactoryName, *config, clientParams); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "client factory validation failed: %v", err)
 		}
 	}
 
-	// Create and run hybrid load test
-	return s.runHybridLoadTest(ctx, config)
+	confirmationMode, err := mapConfirmationMode(req.ConfirmationMode)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
+	}
+	confirmation := loadtest.ConfirmationConfig{
+		Mode:    confirmationMode,
+		Timeout: req.ConfirmationTimeout.AsDuration(),
+	}
+
+	arrival, err := arrivalConfig(req.Arrival)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
+	}
+
+	return &hybridLoadTest{
+		config:       config,
+		confirmation: confirmation,
+		funding:      accountFunding(req.AccountFunding),
+		clientParams: clientParams,
+		arrival:      arrival,
+		stages:       stages,
+	}, nil
 }
 
 func (s *HybridServer) buildHybridConfig(req *loadtestpb.RunLoadtestRequest) (*tmloadtest.Config, error) {
@@ -86,26 +187,54 @@ func (s *HybridServer) buildHybridConfig(req *loadtestpb.RunLoadtestRequest) (*t
 		MaxEndpoints:         int(req.MaxEndpointCount),
 		MinConnectivity:      int(req.MinPeerConnectivityCount),
 		PeerConnectTimeout:   int(req.PeerConnectTimeout.GetSeconds()),
-		StatsOutputFile:      req.StatsOutputFilePath,
 		NoTrapInterrupts:     true,
 	}
 
 	return config, nil
 }
 
-func (s *HybridServer) validateClientFactory(factoryName string, config tmloadtest.Config) error {
-	// This would validate that the client factory exists and supports the configuration
-	// For now, we'll do basic validation
+func (s *HybridServer) validateClientFactory(factoryName string, config tmloadtest.Config, params loadtest.ClientParams) error {
 	if strings.TrimSpace(factoryName) == "" {
 		return fmt.Errorf("client factory name cannot be empty")
 	}
-	return nil
+	return loadtest.ValidateClientConfig(config, params)
 }
 
-func (s *HybridServer) runHybridLoadTest(ctx context.Context, config *tmloadtest.Config) (*loadtestpb.RunLoadtestResponse, error) {
+// runHybridLoadTest runs the load test to completion. If emit is not nil it
+// also receives progress and per-second events while the test runs.
+func (s *HybridServer) runHybridLoadTest(ctx context.Context, lt *hybridLoadTest, emit func(*loadtestpb.StreamLoadtestResponse)) (*loadtestpb.RunLoadtestResponse, error) {
+	config := lt.config
 	logrus.Infof("Running hybrid load test with %d endpoints", len(config.Endpoints))
 
+	// Report progress more often when someone is watching live
+	progressInterval := 5 * time.Second
+	if emit != nil {
+		progressInterval = time.Second
+	}
+
+	// Fund the sender accounts before the transactors create their clients
+	teardown, err := loadtest.FundAccounts(*config, lt.clientParams, lt.funding)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fund accounts: %v", err)
+	}
+	defer func() {
+		if err := teardown(); err != nil {
+			logrus.Errorf("Failed to sweep funded accounts: %v", err)
+		}
+	}()
+
+	release, err := loadtest.Prepare(*config, lt.clientParams)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to prepare client factory: %v", err)
+	}
+	defer func() {
+		if err := release(); err != nil {
+			logrus.Errorf("Failed to release clients: %v", err)
+		}
+	}()
+
 	// Create transactors for each endpoint using the factory
+	var startTime time.Time
 	var transactors []loadtest.TransactorInterface
 	for i, endpoint := range config.Endpoints {
 		transactor, err := s.transactorFactory.CreateTransactor(endpoint, config)
@@ -118,70 +247,410 @@ func (s *HybridServer) runHybridLoadTest(ctx context.Context, config *tmloadtest
 			}
 			return nil, status.Errorf(codes.Internal, "failed to create transactor for endpoint %s: %v", endpoint, err)
 		}
+		transactor.SetConfirmation(lt.confirmation)
+		transactor.SetClientParams(lt.clientParams)
+		transactor.SetArrival(lt.arrival)
+		transactor.SetStages(lt.stages)
 
 		// Set progress callback
-		transactor.SetProgressCallback(i, 5*time.Second, func(id int, txCount int, txBytes int64) {
+		transactor.SetProgressCallback(i, progressInterval, func(id int, txCount int, txBytes int64) {
 			logrus.Infof("Transactor %d progress: %d transactions, %d bytes", id, txCount, txBytes)
+			if emit != nil {
+				emit(&loadtestpb.StreamLoadtestResponse{
+					Event: &loadtestpb.StreamLoadtestResponse_Progress{Progress: &loadtestpb.TransactorProgress{
+						TransactorId: int32(id),
+						Endpoint:     endpoint,
+						TotalTxs:     int64(txCount),
+						TotalBytes:   txBytes,
+						Elapsed:      durationpb.New(time.Since(startTime)),
+					}},
+				})
+			}
 		})
 
 		transactors = append(transactors, transactor)
 	}
 
+	// Follow what the chain commits while the load test runs. Nodes that
+	// don't serve the block RPCs don't keep the load test from running.
+	collector, err := loadtest.NewChainCollector(config.Endpoints)
+	if err == nil {
+		err = collector.Start()
+	}
+	if err != nil {
+		logrus.Warnf("Not collecting chain metrics: %v", err)
+		collector = nil
+	}
+
 	// Start all transactors
+	startTime = time.Now()
 	for i, transactor := range transactors {
 		transactor.Start()
 		logrus.Infof("Started transactor %d", i)
 	}
 
-	// Wait for the test duration
-	testDuration := time.Duration(config.Time) * time.Second
-	logrus.Infof("Running load test for %v", testDuration)
+	// Wait for the test duration. A duration of 0 sets no limit: the test
+	// then runs until every transactor stops sending or it is cancelled.
+	var testTimeout <-chan time.Time
+	if config.Time > 0 {
+		testDuration := time.Duration(config.Time) * time.Second
+		logrus.Infof("Running load test for %v", testDuration)
+		testTimer := time.NewTimer(testDuration)
+		defer testTimer.Stop()
+		testTimeout = testTimer.C
+	} else {
+		logrus.Info("Running load test without a time limit")
+	}
+
+	// Transactors stop sending on their own once they reach the transaction
+	// count or fail, so the test need not wait out its duration.
+	allSent := make(chan struct{})
+	go func() {
+		for _, transactor := range transactors {
+			transactor.WaitSent()
+		}
+		close(allSent)
+	}()
+
+	// Only stream per-second stats when someone is listening
+	var perSecondTick <-chan time.Time
+	var liveStats *loadtest.LiveStats
+	if emit != nil {
+		perSecondTicker := time.NewTicker(time.Second)
+		defer perSecondTicker.Stop()
+		perSecondTick = perSecondTicker.C
+		liveStats = loadtest.NewLiveStats(startTime, transactors)
+	}
 
-	select {
-	case <-time.After(testDuration):
-		logrus.Info("Load test duration completed")
-	case <-ctx.Done():
-		logrus.Info("Load test cancelled by context")
+wait:
+	for {
+		select {
+		case now := <-perSecondTick:
+			perSecond := liveStats.Collect(now)
+			lt.stages.Mark(perSecond)
+			for _, ps := range perSecond {
+				emit(&loadtestpb.StreamLoadtestResponse{
+					Event: &loadtestpb.StreamLoadtestResponse_PerSec{PerSec: perSecondToProto(ps)},
+				})
+			}
+		case <-testTimeout:
+			logrus.Info("Load test duration completed")
+			break wait
+		case <-allSent:
+			logrus.Info("All transactors stopped sending")
+			break wait
+		case <-ctx.Done():
+			logrus.Info("Load test cancelled by context")
+			break wait
+		}
 	}
 
-	// Cancel all transactors and collect stats
-	var totalTxCount int
-	var totalTxBytes int64
-	var avgTxRate float64
+	// Cancel all transactors and collect stats. The load test ends when they
+	// stop sending, before the transactions in flight are confirmed.
+	for _, transactor := range transactors {
+		transactor.Cancel()
+	}
+	for _, transactor := range transactors {
+		transactor.WaitSent()
+	}
+	totalTime := time.Since(startTime)
 
+	var samples []loadtest.TxSample
 	for i, transactor := range transactors {
-		transactor.Cancel()
 		if err := transactor.Wait(); err != nil {
 			logrus.Errorf("Error waiting for transactor %d: %v", i, err)
 		}
 
-		txCount := transactor.GetTxCount()
-		txBytes := transactor.GetTxBytes()
-		txRate := transactor.GetTxRate()
-		totalTxCount += txCount
-		totalTxBytes += txBytes
-		avgTxRate += txRate
-
 		logrus.Infof("Transactor %d final stats: %d transactions, %d bytes, %.2f tx/s", 
-			i, txCount, txBytes, txRate)
+			i, transactor.GetTxCount(), transactor.GetTxBytes(), transactor.GetTxRate())
 	}
 
-	if len(transactors) > 0 {
-		avgTxRate = avgTxRate / float64(len(transactors))
+	var chainStats *loadtest.ChainStats
+	if collector != nil {
+		collector.Stop()
+		chainStats = collector.Stats(startTime, totalTime)
 	}
 
+	// Break the stats down by endpoint and connection, each transactor
+	// serving one endpoint over config.Connections connections
+	var endpointResults []*loadtestpb.EndpointResult
+	for i, transactor := range transactors {
+		transactorSamples := transactor.GetTxSamples()
+		samples = append(samples, transactorSamples...)
+
+		byConnection := make([][]loadtest.TxSample, config.Connections)
+		for _, sample := range transactorSamples {
+			byConnection[sample.Connection] = append(byConnection[sample.Connection], sample)
+		}
+		for connID, connSamples := range byConnection {
+			connStats := loadtest.ComputeStats(startTime, totalTime, connSamples)
+			lt.stages.Mark(connStats.PerSecond)
+			endpointResults = append(endpointResults, statsToEndpointResult(config.Endpoints[i], connID, connStats))
+		}
+	}
+
+	stats := loadtest.ComputeStats(startTime, totalTime, samples)
+	lt.stages.Mark(stats.PerSecond)
 	logrus.Infof("Hybrid load test completed: %d total transactions, %d total bytes, %.2f avg tx/s", 
-		totalTxCount, totalTxBytes, avgTxRate)
+		stats.TotalTxs, stats.TotalBytes, stats.AvgTxsPerSecond)
+
+	res := statsToProtoResponse(stats)
+	res.EndpointResults = endpointResults
+	if chainStats != nil {
+		logrus.Infof("Chain committed %d transactions in %d blocks, %.2f committed tx/s",
+			chainStats.CommittedTxs, chainStats.Blocks, chainStats.CommittedTxsPerSecond)
+		res.ChainMetrics = chainStatsToProto(chainStats)
+	}
+	return res, nil
+}
+
+// statsToProtoResponse converts the stats recorded by the hybrid transactors
+// into the same response that RunLoadtest builds from tm-load-test's stats.
+func statsToProtoResponse(stats *loadtest.Stats) *loadtestpb.RunLoadtestResponse {
+	res := &loadtestpb.RunLoadtestResponse{
+		TotalTxs:          int64(stats.TotalTxs),
+		TotalTime:         durationpb.New(stats.TotalTime),
+		TotalBytes:        stats.TotalBytes,
+		AvgTxsPerSecond:   stats.AvgTxsPerSecond,
+		AvgBytesPerSecond: stats.AvgBytesPerSecond,
+		AcceptedTxs:       int64(stats.AcceptedTxs),
+		FailedTxs:         int64(stats.FailedTxs),
+		Outcomes:          outcomesToProto(stats.Outcomes),
+		IncludedTxs:       int64(stats.IncludedTxs),
+		DroppedTxs:        int64(stats.DroppedTxs),
+		InclusionRate:     stats.InclusionRate,
+
+		InclusionLatencyRankings: rankingToProtoRanking(0, stats.InclusionLatencyRankings, true),
+	}
+	for _, ps := range stats.PerSecond {
+		res.PerSec = append(res.PerSec, perSecondToProto(ps))
+	}
+	return res
+}
+
+// statsToEndpointResult converts the stats of one connection to an endpoint.
+func statsToEndpointResult(endpoint string, connID int, stats *loadtest.Stats) *loadtestpb.EndpointResult {
+	res := &loadtestpb.EndpointResult{
+		Endpoint:          endpoint,
+		Protocol:          endpointProtocol(endpoint),
+		ConnectionIndex:   int32(connID),
+		TotalTxs:          int64(stats.TotalTxs),
+		TotalTime:         durationpb.New(stats.TotalTime),
+		TotalBytes:        stats.TotalBytes,
+		AvgTxsPerSecond:   stats.AvgTxsPerSecond,
+		AvgBytesPerSecond: stats.AvgBytesPerSecond,
+		AcceptedTxs:       int64(stats.AcceptedTxs),
+		FailedTxs:         int64(stats.FailedTxs),
+		Outcomes:          outcomesToProto(stats.Outcomes),
+		IncludedTxs:       int64(stats.IncludedTxs),
+		DroppedTxs:        int64(stats.DroppedTxs),
+		InclusionRate:     stats.InclusionRate,
+
+		InclusionLatencyRankings: rankingToProtoRanking(0, stats.InclusionLatencyRankings, true),
+	}
+	for _, ps := range stats.PerSecond {
+		res.PerSec = append(res.PerSec, perSecondToProto(ps))
+	}
+	return res
+}
+
+func chainStatsToProto(stats *loadtest.ChainStats) *loadtestpb.ChainMetrics {
+	ret := &loadtestpb.ChainMetrics{
+		Blocks:                int64(stats.Blocks),
+		CommittedTxs:          int64(stats.CommittedTxs),
+		CommittedTxsPerSecond: stats.CommittedTxsPerSecond,
+		AvgBlockTime:          durationpb.New(stats.AvgBlockTime),
+		AvgBlockBytes:         stats.AvgBlockBytes,
+		AvgGasUsed:            stats.AvgGasUsed,
+		MaxMempoolTxs:         int64(stats.MaxMempoolTxs),
+	}
+	for _, ps := range stats.PerSecond {
+		ret.PerSec = append(ret.PerSec, &loadtestpb.ChainPerSecond{
+			Sec:          int64(ps.Sec),
+			Blocks:       int64(ps.Blocks),
+			CommittedTxs: int64(ps.CommittedTxs),
+			BlockBytes:   ps.BlockBytes,
+			GasUsed:      ps.GasUsed,
+			AvgBlockTime: durationpb.New(ps.AvgBlockTime),
+			MempoolTxs:   int64(ps.MempoolTxs),
+		})
+	}
+	return ret
+}
+
+func perSecondToProto(ps *loadtest.PerSecondStats) *loadtestpb.PerSecond {
+	return &loadtestpb.PerSecond{
+		Sec:             int64(ps.Sec),
+		Qps:             float64(ps.QPS),
+		BytesSent:       float64(ps.Bytes),
+		LatencyRankings: rankingToProtoRanking(ps.Sec, ps.LatencyRankings, true),
+		BytesRankings:   rankingToProtoRanking(ps.Sec, ps.BytesRankings, false),
+		AcceptedTxs:     int64(ps.AcceptedTxs),
+		FailedTxs:       int64(ps.FailedTxs),
+		Outcomes:        outcomesToProto(ps.Outcomes),
+		IncludedTxs:     int64(ps.IncludedTxs),
+		DroppedTxs:      int64(ps.DroppedTxs),
+		Stage:           ps.Stage,
 
-	// Build response
-	response := &loadtestpb.RunLoadtestResponse{
-		TotalTxs:           int64(totalTxCount),
-		TotalBytes:         totalTxBytes,
-		AvgTxsPerSecond:    avgTxRate,
-		AvgBytesPerSecond:  float64(totalTxBytes) / float64(config.Time),
+		InclusionLatencyRankings: rankingToProtoRanking(ps.Sec, ps.InclusionLatencyRankings, true),
 	}
+}
+
+func outcomesToProto(outcomes []*loadtest.OutcomeCount) []*loadtestpb.TxOutcomeCount {
+	var ret []*loadtestpb.TxOutcomeCount
+	for _, oc := range outcomes {
+		ret = append(ret, &loadtestpb.TxOutcomeCount{
+			Outcome:   mapTxOutcome(oc.Outcome),
+			Code:      uint32(oc.Code),
+			Codespace: oc.Codespace,
+			Count:     int64(oc.Count),
+		})
+	}
+	return ret
+}
+
+func mapTxOutcome(o loadtest.TxOutcome) loadtestpb.TxOutcomeCount_Outcome {
+	switch o {
+	case loadtest.TxAccepted:
+		return loadtestpb.TxOutcomeCount_OUTCOME_ACCEPTED
+	case loadtest.TxRejected:
+		return loadtestpb.TxOutcomeCount_OUTCOME_REJECTED
+	case loadtest.TxRPCError:
+		return loadtestpb.TxOutcomeCount_OUTCOME_RPC_ERROR
+	case loadtest.TxHTTPError:
+		return loadtestpb.TxOutcomeCount_OUTCOME_HTTP_ERROR
+	case loadtest.TxTimeout:
+		return loadtestpb.TxOutcomeCount_OUTCOME_TIMEOUT
+	}
+	return loadtestpb.TxOutcomeCount_OUTCOME_UNSPECIFIED
+}
 
-	return response, nil
+func mapConfirmationMode(m loadtestpb.RunLoadtestRequest_ConfirmationMode) (loadtest.ConfirmationMode, error) {
+	switch m {
+	case loadtestpb.RunLoadtestRequest_CONFIRMATION_MODE_UNSPECIFIED:
+		return loadtest.ConfirmNone, nil
+	case loadtestpb.RunLoadtestRequest_CONFIRMATION_MODE_SUBSCRIBE:
+		return loadtest.ConfirmSubscribe, nil
+	case loadtestpb.RunLoadtestRequest_CONFIRMATION_MODE_POLL:
+		return loadtest.ConfirmPoll, nil
+	}
+	return loadtest.ConfirmNone, fmt.Errorf("unsupported confirmation_mode: %v", m)
+}
+
+// accountFunding converts the request's account funding, which is off if nil.
+func accountFunding(funding *loadtestpb.AccountFunding) loadtest.FundingConfig {
+	if funding == nil {
+		return loadtest.FundingConfig{}
+	}
+	return loadtest.FundingConfig{
+		Amount:  funding.Amount,
+		Sweep:   funding.Sweep,
+		Timeout: funding.Timeout.AsDuration(),
+	}
+}
+
+// arrivalConfig converts and validates the request's arrival schedule, which
+// is the default batches if nil.
+func arrivalConfig(arrival *loadtestpb.Arrival) (loadtest.ArrivalConfig, error) {
+	if arrival == nil {
+		return loadtest.ArrivalConfig{}, nil
+	}
+	model, err := mapArrivalModel(arrival.Model)
+	if err != nil {
+		return loadtest.ArrivalConfig{}, err
+	}
+	config := loadtest.ArrivalConfig{
+		Model:       model,
+		Jitter:      arrival.Jitter,
+		MaxInFlight: int(arrival.MaxInFlight),
+	}
+	for _, interval := range arrival.Intervals {
+		config.Intervals = append(config.Intervals, interval.AsDuration())
+	}
+	return config, config.Validate()
+}
+
+func mapArrivalModel(m loadtestpb.Arrival_Model) (loadtest.ArrivalModel, error) {
+	switch m {
+	case loadtestpb.Arrival_MODEL_UNSPECIFIED:
+		return loadtest.ArrivalBatch, nil
+	case loadtestpb.Arrival_MODEL_CONSTANT:
+		return loadtest.ArrivalConstant, nil
+	case loadtestpb.Arrival_MODEL_POISSON:
+		return loadtest.ArrivalPoisson, nil
+	case loadtestpb.Arrival_MODEL_UNIFORM:
+		return loadtest.ArrivalUniform, nil
+	case loadtestpb.Arrival_MODEL_REPLAY:
+		return loadtest.ArrivalReplay, nil
+	}
+	return loadtest.ArrivalBatch, fmt.Errorf("unsupported arrival model: %v", m)
+}
+
+// stagesFromProto converts and validates the request's stages.
+func stagesFromProto(stages []*loadtestpb.Stage) (loadtest.Stages, error) {
+	var ret loadtest.Stages
+	for _, stage := range stages {
+		shape, err := mapStageShape(stage.Shape)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, loadtest.Stage{
+			Name:      stage.Name,
+			Shape:     shape,
+			Duration:  stage.Duration.AsDuration(),
+			Rate:      int(stage.Rate),
+			From:      int(stage.FromRate),
+			To:        int(stage.ToRate),
+			Amplitude: int(stage.Amplitude),
+			Period:    stage.Period.AsDuration(),
+		})
+	}
+	return ret, ret.Validate()
+}
+
+func mapStageShape(s loadtestpb.Stage_Shape) (loadtest.StageShape, error) {
+	switch s {
+	case loadtestpb.Stage_SHAPE_HOLD:
+		return loadtest.StageHold, nil
+	case loadtestpb.Stage_SHAPE_RAMP:
+		return loadtest.StageRamp, nil
+	case loadtestpb.Stage_SHAPE_SPIKE:
+		return loadtest.StageSpike, nil
+	case loadtestpb.Stage_SHAPE_SINE:
+		return loadtest.StageSine, nil
+	}
+	return "", fmt.Errorf("unsupported stage shape: %v", s)
+}
+
+// rankingToProtoRanking is the hybrid counterpart of tmRankingToProtoRanking.
+func rankingToProtoRanking(sec int, ranking *loadtest.Ranking, latency bool) *loadtestpb.Ranking {
+	if ranking == nil {
+		return nil
+	}
+	return &loadtestpb.Ranking{
+		P50: percentileToProtoPercentile(sec, ranking.P50, latency),
+		P75: percentileToProtoPercentile(sec, ranking.P75, latency),
+		P90: percentileToProtoPercentile(sec, ranking.P90, latency),
+		P95: percentileToProtoPercentile(sec, ranking.P95, latency),
+		P99: percentileToProtoPercentile(sec, ranking.P99, latency),
+	}
+}
+
+// percentileToProtoPercentile is the hybrid counterpart of tmPercentileToProtoPercentile.
+func percentileToProtoPercentile(sec int, percentile *loadtest.Percentile, latency bool) *loadtestpb.Percentile {
+	if percentile == nil {
+		return nil
+	}
+	ret := &loadtestpb.Percentile{
+		StartOffset: durationpb.New(time.Duration(sec) * time.Second),
+		AtStr:       percentile.AtStr,
+	}
+	if latency {
+		ret.Latency = durationpb.New(percentile.Latency)
+	} else {
+		ret.BytesSent = int64(percentile.Bytes)
+	}
+	return ret
 }
 
 func detectProtocol(endpoint string) string {
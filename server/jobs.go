@@ -0,0 +1,387 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/orijtech/cosmosloadtester/pkg/results"
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+const (
+	defaultListPageSize = 20
+	maxListPageSize     = 100
+)
+
+// jobManager records every load test in the results store and keeps track of
+// the ones that are still running.
+type jobManager struct {
+	store results.Store
+
+	mu      sync.RWMutex
+	running map[string]*job
+}
+
+func newJobManager(store results.Store) *jobManager {
+	return &jobManager{
+		store:   store,
+		running: make(map[string]*job),
+	}
+}
+
+// start records a new job for req. The job is cancelled along with parent,
+// or by CancelLoadtest through the returned context.
+func (m *jobManager) start(parent context.Context, req *loadtestpb.RunLoadtestRequest) (*job, context.Context, error) {
+	id, err := newJobID()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to generate load test ID: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	j := &job{
+		id:        id,
+		request:   req,
+		startTime: time.Now(),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		state:     loadtestpb.Loadtest_STATE_RUNNING,
+		result:    &loadtestpb.RunLoadtestResponse{},
+		progress:  make(map[int32]*loadtestpb.TransactorProgress),
+	}
+
+	m.mu.Lock()
+	m.running[id] = j
+	m.mu.Unlock()
+
+	m.save(j)
+	return j, ctx, nil
+}
+
+// finish records the outcome of a job, which then is only available from the
+// store. ctx is the job's context: once it is done, the job was cancelled by
+// CancelLoadtest or because the client that started it went away.
+func (m *jobManager) finish(ctx context.Context, j *job, res *loadtestpb.RunLoadtestResponse, err error) {
+	if ctx.Err() != nil {
+		j.mu.Lock()
+		j.cancelled = true
+		j.mu.Unlock()
+	}
+	j.finish(res, err)
+	m.save(j)
+
+	m.mu.Lock()
+	delete(m.running, j.id)
+	m.mu.Unlock()
+}
+
+// save records the job in the store. The load test goes on if that fails.
+func (m *jobManager) save(j *job) {
+	if err := m.store.SaveLoadtest(context.Background(), j.toProto(true)); err != nil {
+		logrus.Errorf("Failed to save load test %s: %v", j.id, err)
+	}
+}
+
+func (m *jobManager) getRunning(id string) (*job, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	j, ok := m.running[id]
+	return j, ok
+}
+
+// get returns the live state of a running job, or the stored one otherwise.
+func (m *jobManager) get(ctx context.Context, id string) (*loadtestpb.Loadtest, error) {
+	if j, ok := m.getRunning(id); ok {
+		return j.toProto(true), nil
+	}
+	lt, err := m.store.GetLoadtest(ctx, id)
+	if errors.Is(err, results.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "load test %q not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get load test %q: %v", id, err)
+	}
+	return lt, nil
+}
+
+// job is a load test that is running.
+type job struct {
+	id        string
+	request   *loadtestpb.RunLoadtestRequest
+	startTime time.Time
+	cancel    context.CancelFunc
+	done      chan struct{}
+
+	mu        sync.Mutex
+	state     loadtestpb.Loadtest_State
+	cancelled bool
+	result    *loadtestpb.RunLoadtestResponse
+	progress  map[int32]*loadtestpb.TransactorProgress
+	err       string
+	endTime   time.Time
+}
+
+func newJobID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// observe builds up the partial result of the job from the events of the
+// running load test.
+func (j *job) observe(event *loadtestpb.StreamLoadtestResponse) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	switch event := event.Event.(type) {
+	case *loadtestpb.StreamLoadtestResponse_Progress:
+		j.progress[event.Progress.TransactorId] = event.Progress
+	case *loadtestpb.StreamLoadtestResponse_PerSec:
+		j.result.PerSec = append(j.result.PerSec, event.PerSec)
+		j.result.AcceptedTxs += event.PerSec.AcceptedTxs
+		j.result.FailedTxs += event.PerSec.FailedTxs
+		j.result.IncludedTxs += event.PerSec.IncludedTxs
+		j.result.DroppedTxs += event.PerSec.DroppedTxs
+	default:
+		return
+	}
+
+	var totalTime time.Duration
+	j.result.TotalTxs, j.result.TotalBytes = 0, 0
+	for _, progress := range j.progress {
+		j.result.TotalTxs += progress.TotalTxs
+		j.result.TotalBytes += progress.TotalBytes
+		if elapsed := progress.Elapsed.AsDuration(); elapsed > totalTime {
+			totalTime = elapsed
+		}
+	}
+	j.result.TotalTime = durationpb.New(totalTime)
+	if secs := totalTime.Seconds(); secs > 0 {
+		j.result.AvgTxsPerSecond = float64(j.result.TotalTxs) / secs
+		j.result.AvgBytesPerSecond = float64(j.result.TotalBytes) / secs
+	}
+}
+
+func (j *job) finish(res *loadtestpb.RunLoadtestResponse, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.endTime = time.Now()
+	switch {
+	case err != nil:
+		j.state = loadtestpb.Loadtest_STATE_FAILED
+		j.err = status.Convert(err).Message()
+	case j.cancelled:
+		j.state = loadtestpb.Loadtest_STATE_CANCELLED
+	default:
+		j.state = loadtestpb.Loadtest_STATE_SUCCEEDED
+	}
+	if res != nil {
+		j.result = res
+	}
+	close(j.done)
+}
+
+// toProto returns a snapshot of the job, including its result if withResult
+// is set.
+func (j *job) toProto(withResult bool) *loadtestpb.Loadtest {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	ret := &loadtestpb.Loadtest{
+		Id:        j.id,
+		State:     j.state,
+		Request:   j.request,
+		Error:     j.err,
+		StartTime: timestamppb.New(j.startTime),
+	}
+	if !j.endTime.IsZero() {
+		ret.EndTime = timestamppb.New(j.endTime)
+	}
+	for _, progress := range j.progress {
+		ret.EndpointTotals = append(ret.EndpointTotals, progress)
+	}
+	sort.Slice(ret.EndpointTotals, func(a, b int) bool {
+		return ret.EndpointTotals[a].TransactorId < ret.EndpointTotals[b].TransactorId
+	})
+	if withResult {
+		// The result keeps growing while the job runs
+		ret.Result = proto.Clone(j.result).(*loadtestpb.RunLoadtestResponse)
+	}
+	return ret
+}
+
+// runJob runs the load test of a job to completion and records its outcome.
+// If emit is not nil it also receives the events of the load test.
+func (s *HybridServer) runJob(ctx context.Context, j *job, lt *hybridLoadTest, emit func(*loadtestpb.StreamLoadtestResponse)) (*loadtestpb.RunLoadtestResponse, error) {
+	observe := j.observe
+	if emit != nil {
+		observe = func(event *loadtestpb.StreamLoadtestResponse) {
+			j.observe(event)
+			emit(event)
+		}
+	}
+
+	res, err := s.runHybridLoadTest(ctx, lt, observe)
+	if err == nil {
+		err = exportStats(j.request, res)
+	}
+	if err != nil {
+		logrus.Errorf("Load test %s failed: %v", j.id, err)
+	}
+	s.jobs.finish(ctx, j, res, err)
+	return res, err
+}
+
+// StartLoadtest starts a load test that runs independently of the request.
+func (s *HybridServer) StartLoadtest(ctx context.Context, req *loadtestpb.RunLoadtestRequest) (*loadtestpb.StartLoadtestResponse, error) {
+	lt, err := s.prepareHybridLoadTest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The job must outlive the request that started it
+	j, jobCtx, err := s.jobs.start(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	go s.runJob(jobCtx, j, lt, nil)
+
+	logrus.Infof("Started load test %s", j.id)
+	return &loadtestpb.StartLoadtestResponse{Id: j.id}, nil
+}
+
+// GetLoadtest returns the current state of a load test.
+func (s *HybridServer) GetLoadtest(ctx context.Context, req *loadtestpb.GetLoadtestRequest) (*loadtestpb.Loadtest, error) {
+	return s.jobs.get(ctx, req.Id)
+}
+
+// ListLoadtests pages through the recorded load tests.
+func (s *HybridServer) ListLoadtests(ctx context.Context, req *loadtestpb.ListLoadtestsRequest) (*loadtestpb.ListLoadtestsResponse, error) {
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultListPageSize
+	case pageSize > maxListPageSize:
+		pageSize = maxListPageSize
+	}
+
+	var after *results.Cursor
+	if req.PageToken != "" {
+		var err error
+		if after, err = results.ParseCursor(req.PageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token %q", req.PageToken)
+		}
+	}
+
+	loadtests, more, err := s.jobs.store.ListLoadtests(ctx, after, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list load tests: %v", err)
+	}
+	res := &loadtestpb.ListLoadtestsResponse{Loadtests: loadtests}
+	if more && len(loadtests) > 0 {
+		res.NextPageToken = results.CursorOf(loadtests[len(loadtests)-1]).Token()
+	}
+	return res, nil
+}
+
+// CancelLoadtest cancels every transactor of a running load test and waits for
+// them to stop.
+func (s *HybridServer) CancelLoadtest(ctx context.Context, req *loadtestpb.CancelLoadtestRequest) (*loadtestpb.Loadtest, error) {
+	j, ok := s.jobs.getRunning(req.Id)
+	if !ok {
+		// It has already finished, if it exists at all
+		return s.jobs.get(ctx, req.Id)
+	}
+
+	j.mu.Lock()
+	if j.state == loadtestpb.Loadtest_STATE_RUNNING {
+		j.cancelled = true
+		j.cancel()
+	}
+	j.mu.Unlock()
+
+	select {
+	case <-j.done:
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return j.toProto(true), nil
+}
+
+// CompareLoadtests compares the results of two finished load tests.
+func (s *HybridServer) CompareLoadtests(ctx context.Context, req *loadtestpb.CompareLoadtestsRequest) (*loadtestpb.CompareLoadtestsResponse, error) {
+	baseline, err := s.jobs.get(ctx, req.BaselineId)
+	if err != nil {
+		return nil, err
+	}
+	candidate, err := s.jobs.get(ctx, req.CandidateId)
+	if err != nil {
+		return nil, err
+	}
+	for _, lt := range []*loadtestpb.Loadtest{baseline, candidate} {
+		if lt.State == loadtestpb.Loadtest_STATE_RUNNING {
+			return nil, status.Errorf(codes.FailedPrecondition, "load test %q is still running", lt.Id)
+		}
+		if lt.Result == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "load test %q has no result", lt.Id)
+		}
+	}
+
+	tolerances := results.DefaultTolerances
+	if t := req.Tolerances; t != nil {
+		tolerances = results.Tolerances{TPS: t.Tps, Bytes: t.Bytes, Latency: t.Latency, Significance: t.Significance}
+		if err := tolerances.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tolerances: %v", err)
+		}
+	}
+	report := results.NewReport(baseline.Id, baseline.Result, candidate.Id, candidate.Result, tolerances)
+
+	res := &loadtestpb.CompareLoadtestsResponse{
+		Baseline:  baseline,
+		Candidate: candidate,
+	}
+	for _, series := range report.Series {
+		res.Series = append(res.Series, &loadtestpb.SeriesComparison{
+			Name:             series.Name,
+			Unit:             series.Unit,
+			HigherIsBetter:   series.HigherIsBetter,
+			Seconds:          int32(series.Seconds),
+			BaselineMissing:  int32(series.BaselineMissing),
+			CandidateMissing: int32(series.CandidateMissing),
+			Baseline:         series.Baseline,
+			Candidate:        series.Candidate,
+			Delta:            series.Delta,
+			DeltaPercent:     series.DeltaPercent,
+			PValue:           series.PValue,
+			Significant:      series.Significant,
+			Regression:       series.Regression,
+			Improvement:      series.Improvement,
+		})
+	}
+	for _, sec := range report.PerSecond {
+		res.PerSec = append(res.PerSec, &loadtestpb.AlignedSecond{
+			Sec:          sec.Sec,
+			BaselineTps:  sec.BaselineTPS,
+			CandidateTps: sec.CandidateTPS,
+			BaselineP99:  durationpb.New(time.Duration(sec.BaselineP99 * float64(time.Millisecond))),
+			CandidateP99: durationpb.New(time.Duration(sec.CandidateP99 * float64(time.Millisecond))),
+		})
+	}
+	return res, nil
+}
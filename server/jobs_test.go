@@ -0,0 +1,296 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/orijtech/cosmosloadtester/pkg/results"
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+func newTestJob(id string) *job {
+	return &job{
+		id:        id,
+		startTime: time.Now(),
+		cancel:    func() {},
+		done:      make(chan struct{}),
+		state:     loadtestpb.Loadtest_STATE_RUNNING,
+		result:    &loadtestpb.RunLoadtestResponse{},
+		progress:  make(map[int32]*loadtestpb.TransactorProgress),
+	}
+}
+
+func TestListLoadtestsPaging(t *testing.T) {
+	s := NewHybridServer(results.NewMemoryStore())
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 25; i++ {
+		err := s.jobs.store.SaveLoadtest(context.Background(), &loadtestpb.Loadtest{
+			Id:        strconv.Itoa(i),
+			State:     loadtestpb.Loadtest_STATE_SUCCEEDED,
+			StartTime: timestamppb.New(start.Add(time.Duration(i) * time.Minute)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The 20th most recent load test ends the first page
+	next := (&results.Cursor{StartTime: start.Add(5 * time.Minute), ID: "5"}).Token()
+	tests := []struct {
+		name      string
+		pageSize  int32
+		pageToken string
+		wantCount int
+		wantNext  string
+		wantCode  codes.Code
+	}{
+		{"default page size", 0, "", defaultListPageSize, next, codes.OK},
+		{"last page", 10, next, 5, "", codes.OK},
+		{"page size capped", maxListPageSize + 1, "", 25, "", codes.OK},
+		{"negative page size", -1, "", 0, "", codes.InvalidArgument},
+		{"malformed token", 10, "abc", 0, "", codes.InvalidArgument},
+		{"offset token", 10, "20", 0, "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.ListLoadtests(context.Background(), &loadtestpb.ListLoadtestsRequest{
+				PageSize:  tt.pageSize,
+				PageToken: tt.pageToken,
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("ListLoadtests() code = %s, want %s (err %v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if len(res.Loadtests) != tt.wantCount || res.NextPageToken != tt.wantNext {
+				t.Errorf("ListLoadtests() = %d load tests, next %q, want %d, next %q",
+					len(res.Loadtests), res.NextPageToken, tt.wantCount, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestListLoadtestsPagingWhileStarting(t *testing.T) {
+	s := NewHybridServer(results.NewMemoryStore())
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	save := func(i int) {
+		err := s.jobs.store.SaveLoadtest(context.Background(), &loadtestpb.Loadtest{
+			Id:        strconv.Itoa(i),
+			State:     loadtestpb.Loadtest_STATE_SUCCEEDED,
+			StartTime: timestamppb.New(start.Add(time.Duration(i) * time.Minute)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		save(i)
+	}
+
+	first, err := s.ListLoadtests(context.Background(), &loadtestpb.ListLoadtestsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A load test started between pages doesn't shift the next one
+	save(4)
+	second, err := s.ListLoadtests(context.Background(), &loadtestpb.ListLoadtestsRequest{PageSize: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, lt := range append(first.Loadtests, second.Loadtests...) {
+		ids = append(ids, lt.Id)
+	}
+	if want := []string{"3", "2", "1", "0"}; !slices.Equal(ids, want) {
+		t.Errorf("pages = %v, want %v", ids, want)
+	}
+}
+
+func TestJobFinish(t *testing.T) {
+	tests := []struct {
+		name      string
+		cancelled bool
+		err       error
+		wantState loadtestpb.Loadtest_State
+		wantErr   string
+	}{
+		{"succeeded", false, nil, loadtestpb.Loadtest_STATE_SUCCEEDED, ""},
+		{"cancelled", true, nil, loadtestpb.Loadtest_STATE_CANCELLED, ""},
+		{"failed", false, status.Error(codes.Internal, "boom"), loadtestpb.Loadtest_STATE_FAILED, "boom"},
+		{"failed while cancelling", true, errors.New("boom"), loadtestpb.Loadtest_STATE_FAILED, "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := newTestJob("job")
+			j.cancelled = tt.cancelled
+			j.finish(&loadtestpb.RunLoadtestResponse{TotalTxs: 7}, tt.err)
+
+			select {
+			case <-j.done:
+			default:
+				t.Fatal("finish did not close done")
+			}
+			got := j.toProto(true)
+			if got.State != tt.wantState || got.Error != tt.wantErr {
+				t.Errorf("finished job = %s %q, want %s %q", got.State, got.Error, tt.wantState, tt.wantErr)
+			}
+			if got.EndTime == nil || got.Result.GetTotalTxs() != 7 {
+				t.Errorf("finished job has end time %v and %d txs, want an end time and 7 txs", got.EndTime, got.Result.GetTotalTxs())
+			}
+		})
+	}
+}
+
+func TestJobManagerRecordsJobs(t *testing.T) {
+	ctx := context.Background()
+	store := results.NewMemoryStore()
+	m := newJobManager(store)
+
+	j, jobCtx, err := m.start(ctx, &loadtestpb.RunLoadtestRequest{ClientFactory: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := store.GetLoadtest(ctx, j.id)
+	if err != nil || stored.State != loadtestpb.Loadtest_STATE_RUNNING {
+		t.Fatalf("stored job = %v, %v, want a running load test", stored, err)
+	}
+	if _, ok := m.getRunning(j.id); !ok {
+		t.Error("started job is not running")
+	}
+
+	m.finish(jobCtx, j, &loadtestpb.RunLoadtestResponse{TotalTxs: 3}, nil)
+	if _, ok := m.getRunning(j.id); ok {
+		t.Error("finished job is still running")
+	}
+	got, err := m.get(ctx, j.id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != loadtestpb.Loadtest_STATE_SUCCEEDED || got.Result.GetTotalTxs() != 3 {
+		t.Errorf("finished job = %s with %d txs, want %s with 3 txs", got.State, got.Result.GetTotalTxs(), loadtestpb.Loadtest_STATE_SUCCEEDED)
+	}
+
+	if _, err := m.get(ctx, "missing"); status.Code(err) != codes.NotFound {
+		t.Errorf("get(missing) = %v, want NotFound", err)
+	}
+}
+
+func TestJobCancelledWithClient(t *testing.T) {
+	m := newJobManager(results.NewMemoryStore())
+	ctx, cancel := context.WithCancel(context.Background())
+	j, jobCtx, err := m.start(ctx, &loadtestpb.RunLoadtestRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client goes away and the load test stops without an error
+	cancel()
+	<-jobCtx.Done()
+	m.finish(jobCtx, j, &loadtestpb.RunLoadtestResponse{TotalTxs: 3}, nil)
+
+	got, err := m.get(context.Background(), j.id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != loadtestpb.Loadtest_STATE_CANCELLED {
+		t.Errorf("job of a disconnected client = %s, want %s", got.State, loadtestpb.Loadtest_STATE_CANCELLED)
+	}
+}
+
+func TestCancelLoadtest(t *testing.T) {
+	s := NewHybridServer(results.NewMemoryStore())
+	j, jobCtx, err := s.jobs.start(context.Background(), &loadtestpb.RunLoadtestRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Like runJob, finish once the load test has stopped
+	go func() {
+		<-jobCtx.Done()
+		s.jobs.finish(jobCtx, j, nil, nil)
+	}()
+
+	got, err := s.CancelLoadtest(context.Background(), &loadtestpb.CancelLoadtestRequest{Id: j.id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != loadtestpb.Loadtest_STATE_CANCELLED {
+		t.Errorf("cancelled job state = %s, want %s", got.State, loadtestpb.Loadtest_STATE_CANCELLED)
+	}
+
+	// Cancelling a finished job returns its record
+	got, err = s.CancelLoadtest(context.Background(), &loadtestpb.CancelLoadtestRequest{Id: j.id})
+	if err != nil || got.State != loadtestpb.Loadtest_STATE_CANCELLED {
+		t.Errorf("cancelling again = %v, %v, want the cancelled record", got, err)
+	}
+
+	_, err = s.CancelLoadtest(context.Background(), &loadtestpb.CancelLoadtestRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("cancelling a missing job = %v, want NotFound", err)
+	}
+}
+
+func TestCompareLoadtests(t *testing.T) {
+	ctx := context.Background()
+	store := results.NewMemoryStore()
+	s := NewHybridServer(store)
+	perSec := func(qps ...float64) []*loadtestpb.PerSecond {
+		var ret []*loadtestpb.PerSecond
+		for i, q := range qps {
+			ret = append(ret, &loadtestpb.PerSecond{Sec: int64(i), Qps: q, BytesSent: 100 * q})
+		}
+		return ret
+	}
+	for id, res := range map[string]*loadtestpb.RunLoadtestResponse{
+		"baseline":  {PerSec: perSec(100, 101, 99, 100, 102)},
+		"candidate": {PerSec: perSec(50, 51, 49, 50, 52)},
+	} {
+		lt := &loadtestpb.Loadtest{Id: id, State: loadtestpb.Loadtest_STATE_SUCCEEDED, StartTime: timestamppb.Now(), Result: res}
+		if err := store.SaveLoadtest(ctx, lt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := s.CompareLoadtests(ctx, &loadtestpb.CompareLoadtestsRequest{BaselineId: "baseline", CandidateId: "candidate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.PerSec) != 5 || got.PerSec[0].BaselineTps != 100 || got.PerSec[0].CandidateTps != 50 {
+		t.Errorf("aligned seconds = %v, want 5 starting at 100 and 50 tx/s", got.PerSec)
+	}
+	if len(got.Series) == 0 || got.Series[0].Name != "tps" || !got.Series[0].Regression {
+		t.Fatalf("series = %v, want a TPS regression first", got.Series)
+	}
+
+	// A tolerance above the drop lets it pass
+	got, err = s.CompareLoadtests(ctx, &loadtestpb.CompareLoadtestsRequest{
+		BaselineId:  "baseline",
+		CandidateId: "candidate",
+		Tolerances:  &loadtestpb.CompareTolerances{Tps: 60, Bytes: 60},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, series := range got.Series {
+		if series.Regression {
+			t.Errorf("%s is a regression with a 60%% tolerance", series.Name)
+		}
+	}
+
+	_, err = s.CompareLoadtests(ctx, &loadtestpb.CompareLoadtestsRequest{
+		BaselineId:  "baseline",
+		CandidateId: "candidate",
+		Tolerances:  &loadtestpb.CompareTolerances{Tps: -1},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("comparing with a negative tolerance = %v, want InvalidArgument", err)
+	}
+}
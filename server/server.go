@@ -2,19 +2,20 @@ package server
 
 import (
 	"context"
-	"encoding/csv"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strconv"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/informalsystems/tm-load-test/pkg/loadtest"
+	hybridloadtest "github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	"github.com/orijtech/cosmosloadtester/pkg/results"
 	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -37,23 +38,6 @@ func (s *Server) RunLoadtest(ctx context.Context, req *loadtestpb.RunLoadtestReq
 		return nil, err
 	}
 
-	statsOutputFilePath := req.StatsOutputFilePath
-	if strings.TrimSpace(statsOutputFilePath) == "" {
-		tmpFile, err := os.CreateTemp("", "tm-load-test-output.txt")
-		if err != nil {
-			return nil, fmt.Errorf("failed to create temporary file for stats output: %w", err)
-		}
-		defer func() {
-			if err := tmpFile.Close(); err != nil {
-				logrus.WithError(err).Errorf("failed to close temporary stats output file at %s", tmpFile.Name())
-			}
-			if err := os.Remove(tmpFile.Name()); err != nil {
-				logrus.WithError(err).Errorf("failed to remove temporary stats output file at %s", tmpFile.Name())
-			}
-		}()
-		statsOutputFilePath = tmpFile.Name()
-	}
-
 	cfg := loadtest.Config{
 		ClientFactory:        req.ClientFactory,
 		Connections:          int(req.ConnectionCount),
@@ -69,92 +53,133 @@ func (s *Server) RunLoadtest(ctx context.Context, req *loadtestpb.RunLoadtestReq
 		MaxEndpoints:         int(req.MaxEndpointCount),
 		MinConnectivity:      int(req.MinPeerConnectivityCount),
 		PeerConnectTimeout:   int(req.PeerConnectTimeout.GetSeconds()),
-		StatsOutputFile:      statsOutputFilePath,
 		NoTrapInterrupts:     false,
 	}
 	if err := cfg.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
 	}
-
-	psL, err := loadtest.ExecuteStandaloneWithStats(cfg)
-	if err != nil {
-		return nil, err
+	// tm-load-test creates its clients with the factories' defaults
+	if len(req.ClientParams) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "client_params are only supported by the hybrid server")
 	}
 
-	// TODO: Send over the actual values of psL to the UI
-	// instead of the CSV parsing down below.
-	blob, err := json.MarshalIndent(psL, "", "  ")
+	teardown, err := hybridloadtest.FundAccounts(cfg, nil, accountFunding(req.AccountFunding))
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fund accounts: %v", err)
 	}
-	println(string(blob))
-
-	f, err := os.Open(statsOutputFilePath)
+	release, err := hybridloadtest.Prepare(cfg, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open stats output file: %w", err)
+		if sweepErr := teardown(); sweepErr != nil {
+			logrus.Errorf("Failed to sweep funded accounts: %v", sweepErr)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to prepare client factory: %v", err)
+	}
+	psL, err := loadtest.ExecuteStandaloneWithStats(cfg)
+	if releaseErr := release(); releaseErr != nil {
+		logrus.Errorf("Failed to release clients: %v", releaseErr)
+	}
+	if sweepErr := teardown(); sweepErr != nil {
+		logrus.Errorf("Failed to sweep funded accounts: %v", sweepErr)
 	}
-	defer f.Close()
-	csvR := csv.NewReader(f)
-	records, err := csvR.ReadAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read stats: %w", err)
+		return nil, err
 	}
 
 	res := &loadtestpb.RunLoadtestResponse{}
-	for i := 1; i < len(records); i++ {
-		record := records[i]
-		switch fieldName, value := record[0], record[1]; fieldName {
-		case "total_time":
-			totalTime, err := strconv.ParseFloat(value, 64)
-			if err != nil {
-				return nil, fmt.Errorf("invalid %s in stats file: %s", fieldName, value)
-			}
-			res.TotalTime = durationpb.New(time.Duration(totalTime * float64(time.Second)))
-		case "total_txs":
-			totalTxs, err := strconv.ParseInt(value, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("invalid %s in stats file: %s", fieldName, value)
-			}
-			res.TotalTxs = totalTxs
-		case "total_bytes":
-			totalBytes, err := strconv.ParseInt(value, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("invalid %s in stats file: %s", fieldName, value)
-			}
-			res.TotalBytes = totalBytes
-		case "avg_tx_rate":
-			avgTxRate, err := strconv.ParseFloat(value, 64)
-			if err != nil {
-				return nil, fmt.Errorf("invalid %s in stats file: %s", fieldName, value)
-			}
-			res.AvgTxsPerSecond = avgTxRate
-		case "avg_data_rate":
-			avgDataRate, err := strconv.ParseFloat(value, 64)
-			if err != nil {
-				return nil, fmt.Errorf("invalid %s in stats file: %s", fieldName, value)
-			}
-			res.AvgBytesPerSecond = avgDataRate
-
-		default:
-			logrus.Warnf("unexpected stats field: %s", fieldName)
+
+	// tm-load-test creates cfg.Connections transactors per endpoint, in the
+	// order of the endpoints. Discovered endpoints can't be told apart though.
+	knownEndpoints := len(psL) == len(cfg.Endpoints)*cfg.Connections
+	perSec := make(map[int64]*loadtestpb.PerSecond)
+	for i, stats := range psL {
+		endpointResult := &loadtestpb.EndpointResult{
+			ConnectionIndex:   int32(i % cfg.Connections),
+			TotalTxs:          int64(stats.TotalTxs),
+			TotalTime:         durationpb.New(stats.TotalTime),
+			TotalBytes:        stats.TotalBytes,
+			AvgTxsPerSecond:   stats.AvgTxPerSecond,
+			AvgBytesPerSecond: stats.AvgBytesPerSecond,
+		}
+		if knownEndpoints {
+			endpointResult.Endpoint = cfg.Endpoints[i/cfg.Connections]
+			endpointResult.Protocol = endpointProtocol(endpointResult.Endpoint)
+		}
+		for _, ps := range stats.PerSecond {
+			tmPerSec := tmPerSecondToProto(ps)
+			endpointResult.PerSec = append(endpointResult.PerSec, tmPerSec)
+			perSec[tmPerSec.Sec] = mergePerSecond(perSec[tmPerSec.Sec], tmPerSec)
+		}
+		res.EndpointResults = append(res.EndpointResults, endpointResult)
+
+		// The transactors run concurrently, so the load test took as long
+		// as the slowest of them.
+		res.TotalTxs += int64(stats.TotalTxs)
+		res.TotalBytes += stats.TotalBytes
+		if stats.TotalTime > res.TotalTime.AsDuration() {
+			res.TotalTime = durationpb.New(stats.TotalTime)
 		}
 	}
+	if secs := res.TotalTime.AsDuration().Seconds(); secs > 0 {
+		res.AvgTxsPerSecond = float64(res.TotalTxs) / secs
+		res.AvgBytesPerSecond = float64(res.TotalBytes) / secs
+	}
 
-	// TODO: once the proto is updated to support multiple transactors, range over psL.
-	stats := psL[0]
-	for _, ps := range stats.PerSecond {
-		res.PerSec = append(res.PerSec, &loadtestpb.PerSecond{
-			Sec:             int64(ps.Sec),
-			Qps:             float64(ps.QPS),
-			BytesSent:       float64(ps.Bytes),
-			LatencyRankings: tmRankingToProtoRanking(ps, ps.LatencyRankings, true),
-			BytesRankings:   tmRankingToProtoRanking(ps, ps.BytesRankings, false),
-		})
+	for _, ps := range perSec {
+		res.PerSec = append(res.PerSec, ps)
 	}
+	sort.Slice(res.PerSec, func(i, j int) bool { return res.PerSec[i].Sec < res.PerSec[j].Sec })
 
+	if err := exportStats(req, res); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
+// exportStats writes the statistics to the request's stats_output_file_path, if any.
+func exportStats(req *loadtestpb.RunLoadtestRequest, res *loadtestpb.RunLoadtestResponse) error {
+	if strings.TrimSpace(req.StatsOutputFilePath) == "" {
+		return nil
+	}
+	if err := results.ExportStats(req.StatsOutputFilePath, req.StatsOutputFormat, res); err != nil {
+		return status.Errorf(codes.Internal, "failed to export stats: %v", err)
+	}
+	return nil
+}
+
+func tmPerSecondToProto(ps *loadtest.BucketizedBySecond) *loadtestpb.PerSecond {
+	return &loadtestpb.PerSecond{
+		Sec:             int64(ps.Sec),
+		Qps:             float64(ps.QPS),
+		BytesSent:       float64(ps.Bytes),
+		LatencyRankings: tmRankingToProtoRanking(ps, ps.LatencyRankings, true),
+		BytesRankings:   tmRankingToProtoRanking(ps, ps.BytesRankings, false),
+	}
+}
+
+// mergePerSecond adds up the same second of two transactors. tm-load-test only
+// reports their percentiles, which can't be combined, so the rankings of a
+// second that several transactors share are left out: each endpoint result
+// still has its own.
+func mergePerSecond(a, b *loadtestpb.PerSecond) *loadtestpb.PerSecond {
+	if a == nil {
+		return proto.Clone(b).(*loadtestpb.PerSecond)
+	}
+	a.Qps += b.Qps
+	a.BytesSent += b.BytesSent
+	a.LatencyRankings = nil
+	a.BytesRankings = nil
+	return a
+}
+
+// endpointProtocol returns the scheme of the endpoint's URL e.g. ws or https.
+func endpointProtocol(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme
+}
+
 // tmRankingToProtoRanking creates a loadtestpb ranking from the supplied buckets and ranking.
 // If latency = true, it will populate Latency in the percentiles. Otherwise, it will populate BytesSent.
 func tmRankingToProtoRanking(bucketized *loadtest.BucketizedBySecond, ranking *loadtest.ProcessedStats, latency bool) *loadtestpb.Ranking {
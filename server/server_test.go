@@ -0,0 +1,78 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/orijtech/cosmosloadtester/pkg/loadtest"
+	loadtestpb "github.com/orijtech/cosmosloadtester/proto/orijtech/cosmosloadtester/v1"
+)
+
+func TestEndpointProtocol(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"ws://localhost:26657/websocket", "ws"},
+		{"wss://rpc.example.com/websocket", "wss"},
+		{"http://localhost:26657", "http"},
+		{"https://rpc.example.com", "https"},
+		{"://bad", ""},
+	}
+	for _, tt := range tests {
+		if got := endpointProtocol(tt.endpoint); got != tt.want {
+			t.Errorf("endpointProtocol(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestStatsToEndpointResult(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats := loadtest.ComputeStats(start, 2*time.Second, []loadtest.TxSample{
+		{SentAt: start, Latency: time.Millisecond, Bytes: 10, Connection: 1},
+		{SentAt: start.Add(1500 * time.Millisecond), Latency: 3 * time.Millisecond, Bytes: 30, Connection: 1},
+	})
+
+	got := statsToEndpointResult("https://rpc.example.com", 1, stats)
+	if got.Endpoint != "https://rpc.example.com" || got.Protocol != "https" || got.ConnectionIndex != 1 {
+		t.Errorf("endpoint result is for %s %s connection %d, want https://rpc.example.com https connection 1",
+			got.Endpoint, got.Protocol, got.ConnectionIndex)
+	}
+	if got.TotalTxs != 2 || got.TotalBytes != 40 || got.AvgTxsPerSecond != 1 || got.TotalTime.AsDuration() != 2*time.Second {
+		t.Errorf("endpoint result totals = %d txs, %d bytes, %g tx/s over %s, want 2 txs, 40 bytes, 1 tx/s over 2s",
+			got.TotalTxs, got.TotalBytes, got.AvgTxsPerSecond, got.TotalTime.AsDuration())
+	}
+	if len(got.PerSec) != 2 || got.PerSec[1].LatencyRankings.P50.Latency.AsDuration() != 3*time.Millisecond {
+		t.Errorf("endpoint result per second = %v, want 2 seconds with a p50 of 3ms in the second", got.PerSec)
+	}
+}
+
+func TestMergePerSecond(t *testing.T) {
+	rankings := func(p50 time.Duration) *loadtestpb.Ranking {
+		return &loadtestpb.Ranking{P50: &loadtestpb.Percentile{Latency: durationpb.New(p50)}}
+	}
+	a := &loadtestpb.PerSecond{Sec: 1, Qps: 10, BytesSent: 100, LatencyRankings: rankings(time.Millisecond)}
+	b := &loadtestpb.PerSecond{Sec: 1, Qps: 5, BytesSent: 50, LatencyRankings: rankings(time.Second)}
+
+	merged := mergePerSecond(nil, a)
+	if merged == a {
+		t.Fatal("mergePerSecond(nil, a) returned a instead of a copy")
+	}
+	// A second of a single transactor keeps its rankings
+	if merged.LatencyRankings.GetP50().GetLatency().AsDuration() != time.Millisecond {
+		t.Errorf("single transactor second has rankings %v, want a p50 of 1ms", merged.LatencyRankings)
+	}
+	merged = mergePerSecond(merged, b)
+	if merged.Sec != 1 || merged.Qps != 15 || merged.BytesSent != 150 {
+		t.Errorf("merged second = %v, want second 1 with 15 txs and 150 bytes", merged)
+	}
+	// The percentiles of two transactors can't be combined
+	if merged.LatencyRankings != nil || merged.BytesRankings != nil {
+		t.Errorf("merged second has rankings %v and %v, want none", merged.LatencyRankings, merged.BytesRankings)
+	}
+	if a.Qps != 10 {
+		t.Errorf("merging changed the first transactor's second to %v", a)
+	}
+}
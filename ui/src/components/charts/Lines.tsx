@@ -7,6 +7,12 @@ type Scaler = d3.ScaleContinuousNumeric<any, any, any>;
 type groupEl = d3.Selection<SVGGElement, unknown, null, undefined>;
 type divEl = d3.Selection<HTMLDivElement, unknown, null, undefined>;
 
+// Marker is a labelled vertical line at x, e.g. where a stage starts.
+export interface Marker {
+    x: number;
+    label: string;
+};
+
 export interface LinePoint {
     getX: () => number;
     getY: () => number;
@@ -27,6 +33,7 @@ interface LinesProp {
     xAxisShortTitle: string;
     yAxisShortTitle: string;
     yScale: any;
+    markers?: Marker[];
 }
 
 export default function Lines(props: LinesProp) {
@@ -43,6 +50,7 @@ export default function Lines(props: LinesProp) {
         xAxisShortTitle,
         yAxisShortTitle,
         yScale,
+        markers,
     } = props;
 
     React.useEffect(() => {
@@ -168,6 +176,26 @@ export default function Lines(props: LinesProp) {
                 });
         };
     
+        const markerLines = (g: groupEl) => {
+            const marker = g.selectAll('g')
+                .data(markers ?? [])
+                .join('g')
+                .attr('transform', (d) => `translate(${x(d.x)}, 0)`);
+            marker.append('line')
+                .attr('y1', margin.top)
+                .attr('y2', height - margin.bottom)
+                .attr('stroke', 'currentColor')
+                .attr('stroke-opacity', 0.4)
+                .attr('stroke-dasharray', '4,3');
+            marker.append('text')
+                .attr('y', margin.top - 4)
+                .attr('fill', 'currentColor')
+                .attr('opacity', 0.6)
+                .attr('text-anchor', 'start')
+                .style('font', '10px sans-serif')
+                .text((d) => d.label);
+        };
+    
         const createTooltip = (el: divEl) => {
             el
                 .style("position", "absolute")
@@ -246,11 +274,12 @@ export default function Lines(props: LinesProp) {
     
         svg.append('g').call(yAxis, y);
         svg.append('g').call(xAxis);
+        svg.append('g').call(markerLines);
         svg.append('g').call(paths, y);
         svg.append('g').call(dots, y);
     }, [
         target, width, height, yScale, nameToHighlight, dimensionToColorMap,
-        data, yAxisShortTitle, yAxisTitle, xAxisShortTitle, xAxisTitle,
+        data, yAxisShortTitle, yAxisTitle, xAxisShortTitle, xAxisTitle, markers,
     ]);
 
     return null;
@@ -6,7 +6,7 @@ import Box from '@mui/material/Box';
 
 import { ColorMap, scaleType } from 'src/types';
 import { COLORS, /** PERCENTILE_KEYS */ } from 'src/constants';
-import LineChart, { LinePoint } from 'src/components/charts/Lines';
+import LineChart, { LinePoint, Marker } from 'src/components/charts/Lines';
 import { useDivSize } from 'src/components/hooks/useDivSize';
 
 import { LoadTestOutput } from "./data";
@@ -64,6 +64,19 @@ export default function Graphs(props: GraphProps) {
         return t;
     });
 
+    const [stageMarkers] = React.useState<Marker[]>(() => {
+        // mark the seconds at which the load test moves on to another stage
+        const m: Marker[] = [];
+        data.forEach((d) => {
+            let stage: string | undefined;
+            d.per_sec.forEach((p) => {
+                if (p.stage && p.stage !== stage) m.push({ x: p.sec, label: p.stage });
+                stage = p.stage;
+            });
+        });
+        return m;
+    });
+
     const [timeToBytesDimension, onHighlightBTimeToytesDimension] = React.useState<null | string>(null);
 
     const [dimensionToColorMap] = React.useState<ColorMap>({
@@ -102,6 +115,7 @@ export default function Graphs(props: GraphProps) {
                                     nameToHighlight={null}
                                     onHighlightName={() => { }}
                                     yScale={yScale}
+                                    markers={stageMarkers}
                                     xAxisShortTitle={'s'}
                                     xAxisTitle={'Time (Seconds)'}
                                     yAxisShortTitle={'Tx'}
@@ -127,6 +141,7 @@ export default function Graphs(props: GraphProps) {
                                     nameToHighlight={timeToBytesDimension}
                                     onHighlightName={onHighlightName}
                                     yScale={yScale}
+                                    markers={stageMarkers}
                                     xAxisShortTitle={'t'}
                                     xAxisTitle={'Time (seconds)'}
                                     yAxisShortTitle={'B'}
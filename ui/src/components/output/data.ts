@@ -21,6 +21,8 @@ type PerSecPoint = {
     bytes: number;
     bytes_rankings: PercentileRanking<PercentilePerBytesSize>,
     latency_rankings: PercentileRanking<PercentilePerLatency>,
+    // the stage running at the start of the second, when the load test has stages
+    stage?: string;
 };
 
 type LoadOutputPoint = {